@@ -0,0 +1,23 @@
+# examples/data-sources/groundcover_alert_routing/data-source.tf
+
+# Simulate a critical payments alert in production.
+data "groundcover_alert_routing" "payments_critical" {
+  labels = {
+    env  = "prod"
+    team = "payments"
+  }
+  severity = "S1"
+}
+
+output "payments_critical_apps" {
+  description = "Names of the connected apps a critical payments alert would notify."
+  value       = [for app in data.groundcover_alert_routing.payments_critical.connected_apps : app.name]
+}
+
+# Fail the run when the alerting topology stops paging for this alert.
+check "payments_critical_pages" {
+  assert {
+    condition     = contains([for app in data.groundcover_alert_routing.payments_critical.connected_apps : app.type], "pagerduty")
+    error_message = "A critical payments alert in prod would not notify any PagerDuty connected app."
+  }
+}
@@ -0,0 +1,49 @@
+# examples/data-sources/groundcover_alertmanager_config/data-source.tf
+
+# Port an existing Alertmanager routing tree: every supported receiver
+# integration becomes a connected app, and every route a notification route.
+data "groundcover_alertmanager_config" "migrated" {
+  config = file("${path.module}/alertmanager.yml")
+}
+
+locals {
+  alertmanager_apps   = { for app in data.groundcover_alertmanager_config.migrated.connected_apps : app.name => app }
+  alertmanager_routes = { for route in data.groundcover_alertmanager_config.migrated.notification_routes : route.name => route }
+}
+
+resource "groundcover_connected_app" "migrated" {
+  # The app data holds credentials and is sensitive, so iterate over the names.
+  for_each = toset(keys(local.alertmanager_apps))
+
+  name = each.key
+  type = local.alertmanager_apps[each.key].type
+  data = local.alertmanager_apps[each.key].data
+}
+
+resource "groundcover_notification_route" "migrated" {
+  for_each = local.alertmanager_routes
+
+  name  = each.key
+  query = each.value.query
+
+  routes = [
+    for rule in each.value.routes : {
+      status = rule.status
+      connected_apps = [
+        for app in rule.connected_apps : {
+          type = app.type
+          id   = groundcover_connected_app.migrated[app.name].id
+        }
+      ]
+    }
+  ]
+
+  notification_settings = each.value.renotification_interval == null ? null : {
+    renotification_interval = each.value.renotification_interval
+  }
+}
+
+output "alertmanager_conversion_warnings" {
+  description = "Parts of the Alertmanager configuration that need a manual review."
+  value       = data.groundcover_alertmanager_config.migrated.warnings
+}
@@ -0,0 +1,21 @@
+# examples/data-sources/groundcover_apikey/data-source.tf
+
+# Look up an API key created outside this workspace by name.
+data "groundcover_apikey" "ci" {
+  name = "ci-pipeline-key"
+}
+
+output "ci_key_service_account" {
+  description = "The service account the CI key authenticates as."
+  value       = data.groundcover_apikey.ci.service_account_name
+}
+
+output "ci_key_expires_at" {
+  description = "When the CI key expires, if it has an expiry."
+  value       = data.groundcover_apikey.ci.expired_at
+}
+
+output "ci_key_policies" {
+  description = "Names of the policies granted to the CI key."
+  value       = [for p in data.groundcover_apikey.ci.policies : p.name]
+}
@@ -0,0 +1,25 @@
+# examples/data-sources/groundcover_connected_app/data-source.tf
+
+# Route to a PagerDuty app owned by the on-call team's workspace without
+# hard-coding its ID.
+data "groundcover_connected_app" "oncall" {
+  name = "oncall-pagerduty"
+  type = "pagerduty"
+}
+
+resource "groundcover_notification_route" "critical" {
+  name  = "critical-to-oncall"
+  query = "severity:critical"
+
+  routes = [
+    {
+      status = ["Alerting", "Resolved"]
+      connected_apps = [
+        {
+          type = data.groundcover_connected_app.oncall.type
+          id   = data.groundcover_connected_app.oncall.id
+        }
+      ]
+    }
+  ]
+}
@@ -0,0 +1,36 @@
+# examples/data-sources/groundcover_connected_app_usage/data-source.tf
+
+resource "groundcover_connected_app" "slack" {
+  name = "alerts-slack"
+  type = "slack-webhook"
+  data = {
+    url = "https://hooks.slack.com/services/T000/B000/XXXX"
+  }
+}
+
+# List what still delivers to the connected app.
+data "groundcover_connected_app_usage" "slack" {
+  connected_app_id = groundcover_connected_app.slack.id
+}
+
+output "slack_app_routes" {
+  description = "Names of the notification routes that deliver to the Slack app."
+  value       = [for r in data.groundcover_connected_app_usage.slack.notification_routes : r.name]
+}
+
+variable "retire_slack_app" {
+  type        = bool
+  description = "Set to true before removing the Slack connected app."
+  default     = false
+}
+
+# Guard a retirement: fail the plan with a readable message while the app is
+# still referenced, instead of the API rejecting the delete with a conflict.
+resource "terraform_data" "slack_retirement_guard" {
+  lifecycle {
+    precondition {
+      condition     = !var.retire_slack_app || !data.groundcover_connected_app_usage.slack.in_use
+      error_message = "alerts-slack is still used by: ${join(", ", concat([for r in data.groundcover_connected_app_usage.slack.notification_routes : "route ${r.name}"], [for w in data.groundcover_connected_app_usage.slack.workflows : "workflow ${w.name}"]))}"
+    }
+  }
+}
@@ -0,0 +1,23 @@
+# examples/data-sources/groundcover_dashboards/data-source.tf
+
+# List the payments team's service dashboards.
+data "groundcover_dashboards" "payments" {
+  team        = "payments"
+  name_prefix = "svc-"
+}
+
+# Key the dashboards by UUID, ready for for_each in downstream modules.
+locals {
+  payments_dashboards = { for d in data.groundcover_dashboards.payments.dashboards : d.uuid => d }
+}
+
+output "payments_dashboard_inventory" {
+  description = "Name, owner, and revision of every payments service dashboard."
+  value = {
+    for uuid, d in local.payments_dashboards : uuid => {
+      name     = d.name
+      owner    = d.owner
+      revision = d.revision_number
+    }
+  }
+}
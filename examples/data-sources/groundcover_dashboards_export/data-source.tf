@@ -0,0 +1,36 @@
+# examples/data-sources/groundcover_dashboards_export/data-source.tf
+
+# Snapshot every dashboard into an S3 bucket on each scheduled run, one object
+# per dashboard, for disaster recovery.
+data "groundcover_dashboards_export" "all" {}
+
+resource "aws_s3_object" "dashboard_backup" {
+  for_each = data.groundcover_dashboards_export.all.dashboards
+
+  bucket       = "observability-backups"
+  key          = "groundcover/dashboards/${each.key}.json"
+  content      = each.value
+  content_type = "application/json"
+}
+
+# Promote the platform team's staging dashboards to the production tenant.
+provider "groundcover" {
+  alias = "production"
+}
+
+data "groundcover_dashboards" "staging_platform" {
+  team = "platform"
+}
+
+data "groundcover_dashboards_export" "staging_platform" {
+  team = "platform"
+}
+
+resource "groundcover_dashboard" "promoted" {
+  provider = groundcover.production
+  for_each = { for d in data.groundcover_dashboards.staging_platform.dashboards : d.uuid => d }
+
+  name   = each.value.name
+  team   = each.value.team
+  preset = data.groundcover_dashboards_export.staging_platform.dashboards[each.key]
+}
@@ -0,0 +1,21 @@
+# examples/data-sources/groundcover_ingestion_keys/data-source.tf
+
+# List every sensor ingestion key to audit key sprawl.
+data "groundcover_ingestion_keys" "sensor" {
+  type = "sensor"
+}
+
+# Fail the plan when sensor keys pile up beyond the expected budget.
+check "sensor_key_budget" {
+  assert {
+    condition     = length(data.groundcover_ingestion_keys.sensor.ingestion_keys) <= 10
+    error_message = "More than 10 sensor ingestion keys exist; revoke unused ones."
+  }
+}
+
+output "sensor_keys_by_creator" {
+  description = "Sensor ingestion key names, grouped by the user who created them."
+  value = {
+    for k in data.groundcover_ingestion_keys.sensor.ingestion_keys : k.created_by => k.name...
+  }
+}
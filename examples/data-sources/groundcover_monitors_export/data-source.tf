@@ -0,0 +1,19 @@
+# examples/data-sources/groundcover_monitors_export/data-source.tf
+
+# Snapshot every monitor into an S3 bucket on each scheduled run, one object
+# per monitor, for disaster recovery.
+data "groundcover_monitors_export" "all" {}
+
+resource "aws_s3_object" "monitor_backup" {
+  for_each = data.groundcover_monitors_export.all.monitors
+
+  bucket       = "observability-backups"
+  key          = "groundcover/monitors/${each.key}.yaml"
+  content      = each.value
+  content_type = "application/yaml"
+}
+
+# Export only the production monitors.
+data "groundcover_monitors_export" "production" {
+  title_prefix = "[prod]"
+}
@@ -0,0 +1,31 @@
+# examples/data-sources/groundcover_prometheus_rule/data-source.tf
+
+# Convert every alerting rule in an existing Prometheus rule file into a
+# groundcover_monitor, keyed by alert name.
+locals {
+  prometheus_rules = {
+    for rule in flatten([for group in yamldecode(file("${path.module}/rules.yml")).groups : group.rules]) :
+    rule.alert => rule if can(rule.alert)
+  }
+}
+
+data "groundcover_prometheus_rule" "migrated" {
+  for_each = local.prometheus_rules
+
+  alert       = each.value.alert
+  expr        = each.value.expr
+  for         = try(each.value["for"], null)
+  labels      = try(each.value.labels, null)
+  annotations = try(each.value.annotations, null)
+}
+
+resource "groundcover_monitor" "migrated" {
+  for_each = data.groundcover_prometheus_rule.migrated
+
+  monitor_yaml = each.value.monitor_yaml
+}
+
+output "prometheus_rule_conversion_warnings" {
+  description = "Rules whose conversion needs a review, with the reasons."
+  value       = { for name, rule in data.groundcover_prometheus_rule.migrated : name => rule.warnings if length(rule.warnings) > 0 }
+}
@@ -0,0 +1,36 @@
+# examples/data-sources/groundcover_query_validation/data-source.tf
+
+# Reject a malformed route query, or one filtering on a key issues do not
+# have, at plan time instead of routing nothing.
+data "groundcover_query_validation" "critical_prod" {
+  query     = "severity:critical AND env:(prod OR production) AND NOT workload:canary"
+  data_type = "issues"
+}
+
+resource "groundcover_notification_route" "critical_prod" {
+  name  = "critical-prod"
+  query = data.groundcover_query_validation.critical_prod.query
+
+  routes = [
+    {
+      status = ["Alerting", "Resolved"]
+      connected_apps = [
+        {
+          type = "slack-webhook"
+          id   = "your-slack-app-id"
+        }
+      ]
+    }
+  ]
+
+  lifecycle {
+    precondition {
+      condition     = data.groundcover_query_validation.critical_prod.valid
+      error_message = "Invalid route query: ${join("; ", data.groundcover_query_validation.critical_prod.errors)}"
+    }
+  }
+}
+
+output "critical_prod_query_keys" {
+  value = data.groundcover_query_validation.critical_prod.keys
+}
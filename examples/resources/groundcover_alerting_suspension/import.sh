@@ -0,0 +1,2 @@
+# Import by the ID of the silence the suspension created; other silences are rejected
+terraform import groundcover_alerting_suspension.break_glass "<silence-id>"
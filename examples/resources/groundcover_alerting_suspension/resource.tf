@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    groundcover = {
+      source = "registry.terraform.io/groundcover-com/groundcover"
+    }
+  }
+}
+
+provider "groundcover" {
+  api_key    = var.groundcover_api_key
+  backend_id = var.groundcover_backend_id
+}
+
+variable "groundcover_api_key" {
+  type        = string
+  description = "groundcover API Key"
+  sensitive   = true
+}
+
+variable "groundcover_backend_id" {
+  type        = string
+  description = "groundcover Backend ID"
+}
+
+# Keep the suspension in configuration, disabled. During a major incident,
+# commit `enabled = true` to stop all alert notifications; revert the commit to
+# restore them. auto_expire resumes notifications even if the revert is missed.
+resource "groundcover_alerting_suspension" "break_glass" {
+  enabled     = false
+  reason      = "INC-1234: region-wide outage, paging suppressed by incident commander"
+  auto_expire = "4h"
+}
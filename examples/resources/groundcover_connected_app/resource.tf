@@ -46,6 +46,11 @@ resource "groundcover_connected_app" "slack" {
   data = {
     url = var.slack_webhook_url
   }
+
+  # Bump after rotating the webhook to re-send it in place, keeping the app ID.
+  rotation_trigger = {
+    rotated_at = "2024-06-01"
+  }
 }
 
 resource "groundcover_connected_app" "ms_teams" {
@@ -0,0 +1,2 @@
+# Import format: type:id (e.g., cloudwatch:abc123)
+terraform import groundcover_data_integration.example "<type>:<id>"
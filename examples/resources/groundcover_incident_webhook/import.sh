@@ -0,0 +1 @@
+terraform import groundcover_incident_webhook.example "<id>"
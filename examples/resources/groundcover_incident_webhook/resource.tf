@@ -0,0 +1,64 @@
+terraform {
+  required_providers {
+    groundcover = {
+      source = "registry.terraform.io/groundcover-com/groundcover"
+    }
+  }
+}
+
+provider "groundcover" {
+  api_key    = var.groundcover_api_key
+  backend_id = var.groundcover_backend_id
+}
+
+variable "groundcover_api_key" {
+  type        = string
+  description = "groundcover API Key"
+  sensitive   = true
+}
+
+variable "groundcover_backend_id" {
+  type        = string
+  description = "groundcover Backend ID"
+}
+
+variable "incident_api_token" {
+  type        = string
+  description = "Token the in-house incident API expects"
+  sensitive   = true
+}
+
+resource "groundcover_incident_webhook" "incidents" {
+  name         = "incident-manager"
+  url          = "https://incidents.internal.example.com/api/v1/alerts"
+  bearer_token = var.incident_api_token
+
+  headers = {
+    X-Incident-Source = "groundcover"
+  }
+
+  # Rendered by groundcover for each alert as a Jinja2 template. See the
+  # groundcover webhook documentation for the alert fields available.
+  payload_template = jsonencode({
+    title    = "{{ alert.title }}"
+    severity = "{{ alert.severity }}"
+    status   = "{{ alert.status }}"
+    link     = "{{ alert.url }}"
+  })
+}
+
+resource "groundcover_notification_route" "incidents" {
+  name  = "incidents"
+  query = "*"
+  routes = [
+    {
+      status = ["Alerting", "Resolved"]
+      connected_apps = [
+        {
+          type = "webhook"
+          id   = groundcover_incident_webhook.incidents.id
+        }
+      ]
+    }
+  ]
+}
@@ -1 +1,5 @@
-terraform import groundcover_metricspipeline.example any
+# Metrics pipeline is a singleton resource, so the import ID is not used for lookups.
+# Any value works; "default" is the conventional choice. Importing adopts the
+# configuration that already exists (e.g. one created in the UI) without
+# overwriting it.
+terraform import groundcover_metricspipeline.example default
@@ -1 +1,6 @@
+# Import by ID
 terraform import groundcover_notification_route.example "<id>"
+
+# Route IDs are not shown in the UI, so a route can also be imported by its
+# exact name. Names shared by several routes must be imported by ID.
+terraform import groundcover_notification_route.example "name=<route name>"
@@ -0,0 +1,41 @@
+# Manage a tenant's policies as a unit: if any policy fails to be created or
+# updated, the changes already made by the apply are rolled back.
+resource "groundcover_policy_bundle" "tenant_acme" {
+  name = "acme"
+
+  policies = [
+    {
+      name        = "acme-readers"
+      description = "Read access to the acme namespaces."
+      claim_role  = "acme-readers"
+      role = {
+        read = "read"
+      }
+      data_scope = {
+        simple = {
+          operator = "and"
+          conditions = [
+            {
+              key    = "namespace"
+              origin = "root"
+              type   = "string"
+              filters = [
+                {
+                  op    = "match"
+                  value = "acme"
+                }
+              ]
+            }
+          ]
+        }
+      }
+    },
+    {
+      name       = "acme-admins"
+      claim_role = "acme-admins"
+      role = {
+        admin = "admin"
+      }
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import groundcover_retention_policy.logs logs
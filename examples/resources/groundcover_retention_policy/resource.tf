@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    groundcover = {
+      source = "groundcover-com/groundcover"
+    }
+  }
+}
+
+# Keep logs for 30 days, except debug logs of the staging namespace.
+resource "groundcover_retention_policy" "logs" {
+  data_type = "logs"
+  retention = "30d"
+
+  custom_rules = [
+    {
+      name      = "staging-debug"
+      filters   = "namespace:staging level:debug"
+      retention = "3d"
+    },
+  ]
+}
+
+resource "groundcover_retention_policy" "traces" {
+  data_type = "traces"
+  retention = "14d"
+}
+
+output "logs_retention_version" {
+  value = groundcover_retention_policy.logs.version
+}
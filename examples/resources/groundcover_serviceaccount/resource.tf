@@ -57,4 +57,9 @@ output "serviceaccount_example_id" {
 output "serviceaccount_example_email" {
   description = "The email of the example Service Account."
   value       = groundcover_serviceaccount.example.email
-} 
\ No newline at end of file
+}
+
+output "serviceaccount_example_last_active" {
+  description = "When an API key of the example Service Account was last used (null if never), to flag dormant accounts."
+  value       = groundcover_serviceaccount.example.last_active
+}
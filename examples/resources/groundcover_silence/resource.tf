@@ -103,6 +103,41 @@ resource "groundcover_silence" "non_production_silence" {
   ]
 }
 
+# Example 5: Maintenance windows that clean up after themselves
+# Windows that have ended are filtered out of for_each, and expire_behavior
+# drops a silence from state once it has ended, so neither refresh nor plan
+# keeps tracking expired silences.
+variable "maintenance_windows" {
+  type = map(object({
+    starts_at = string
+    ends_at   = string
+    service   = string
+  }))
+  default = {
+    db-upgrade = {
+      starts_at = "2030-04-01T02:00:00Z"
+      ends_at   = "2030-04-01T04:00:00Z"
+      service   = "postgres"
+    }
+  }
+}
+
+resource "groundcover_silence" "maintenance" {
+  for_each = { for name, window in var.maintenance_windows : name => window if timecmp(window.ends_at, plantimestamp()) > 0 }
+
+  starts_at       = each.value.starts_at
+  ends_at         = each.value.ends_at
+  comment         = "Maintenance: ${each.key}"
+  expire_behavior = "remove_from_state"
+
+  matchers = [
+    {
+      name  = "service"
+      value = each.value.service
+    }
+  ]
+}
+
 output "maintenance_silence_id" {
   description = "The ID of the maintenance window silence"
   value       = groundcover_silence.maintenance_window.id
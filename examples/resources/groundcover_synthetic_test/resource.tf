@@ -42,10 +42,11 @@ resource "groundcover_synthetic_test" "http_health_check" {
   }
 }
 
-# Example: HTTP check with follow_redirects and allow_insecure
+# Example: HTTP check with follow_redirects and allow_insecure, without a paired monitor
 resource "groundcover_synthetic_test" "http_insecure_check" {
-  name     = "HTTP Insecure Redirect Check"
-  interval = "5m"
+  name           = "HTTP Insecure Redirect Check"
+  interval       = "5m"
+  create_monitor = false
 
   http_check {
     url              = "https://httpbin.org/redirect/1"
@@ -311,6 +312,10 @@ output "monitored_check_id" {
   value = groundcover_synthetic_test.monitored_check.id
 }
 
+output "monitored_check_monitor_id" {
+  value = groundcover_synthetic_test.monitored_check.monitor_id
+}
+
 output "ssl_check_id" {
   value = groundcover_synthetic_test.ssl_check.id
 }
@@ -1,2 +1,5 @@
-# Traces pipeline is a singleton resource. The import ID value is ignored.
-terraform import groundcover_tracespipeline.example any
+# Traces pipeline is a singleton resource, so the import ID is not used for lookups.
+# Any value works; "default" is the conventional choice. Importing adopts the
+# configuration that already exists (e.g. one created in the UI) without
+# overwriting it.
+terraform import groundcover_tracespipeline.example default
@@ -0,0 +1,3 @@
+# Workspace settings are a singleton resource, so the import ID is not used for lookups.
+# Any value works; "default" is the conventional choice.
+terraform import groundcover_workspace_settings.this default
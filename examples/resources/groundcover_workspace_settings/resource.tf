@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    groundcover = {
+      source = "groundcover-com/groundcover"
+    }
+  }
+}
+
+# Tenant-wide settings of the workspace. Declare this resource once.
+resource "groundcover_workspace_settings" "this" {
+  ai_features_opt_out = true
+}
+
+output "ai_features_enabled" {
+  value = groundcover_workspace_settings.this.ai_features_enabled
+}
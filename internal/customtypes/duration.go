@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package customtypes holds Terraform attribute types whose values carry
+// semantic equality, so the framework keeps a configured value in state when
+// the API reads back an equivalent but differently formatted one.
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = DurationType{}
+	_ basetypes.StringValuableWithSemanticEquals = Duration{}
+)
+
+// DurationType is a string type for durations such as `90s`, `1h30m`, `1d` or
+// `5 minutes`. Values of this type that denote the same length of time are
+// semantically equal, so `60m` in configuration is not reported as drift when
+// the API returns `1h`.
+type DurationType struct {
+	basetypes.StringType
+}
+
+func (t DurationType) String() string {
+	return "customtypes.DurationType"
+}
+
+func (t DurationType) ValueType(_ context.Context) attr.Value {
+	return Duration{}
+}
+
+func (t DurationType) Equal(o attr.Type) bool {
+	other, ok := o.(DurationType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t DurationType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Duration{StringValue: in}, nil
+}
+
+func (t DurationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+// Duration is a value of DurationType.
+type Duration struct {
+	basetypes.StringValue
+}
+
+// NewDurationNull returns a null Duration.
+func NewDurationNull() Duration {
+	return Duration{StringValue: basetypes.NewStringNull()}
+}
+
+// NewDurationUnknown returns an unknown Duration.
+func NewDurationUnknown() Duration {
+	return Duration{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewDurationValue returns a known Duration holding value as written.
+func NewDurationValue(value string) Duration {
+	return Duration{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v Duration) Type(_ context.Context) attr.Type {
+	return DurationType{}
+}
+
+func (v Duration) Equal(o attr.Value) bool {
+	other, ok := o.(Duration)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// KeepEquivalent returns v when it denotes the same duration as updated, and
+// updated otherwise. Mappers that rebuild a model from an API response use it
+// to keep the spelling a prior value was written in, as the framework does for
+// the state it stores.
+func (v Duration) KeepEquivalent(updated Duration) Duration {
+	if v.IsNull() || v.IsUnknown() || updated.IsNull() || updated.IsUnknown() {
+		return updated
+	}
+	if DurationsEqual(v.ValueString(), updated.ValueString()) {
+		return v
+	}
+	return updated
+}
+
+// StringSemanticEquals reports whether both values denote the same duration.
+// Values that cannot be parsed are only equal to an identical string.
+func (v Duration) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Duration)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: %T\nGot Value Type: %T", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return DurationsEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// DurationsEqual reports whether two duration strings denote the same length
+// of time. Empty and unparseable strings are only equal to themselves.
+func DurationsEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	if a == "" || b == "" {
+		return false
+	}
+	parsedA, errA := strfmt.ParseDuration(a)
+	parsedB, errB := strfmt.ParseDuration(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return parsedA == parsedB
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDurationStringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		prior, updated string
+		want           bool
+	}{
+		{"1h", "1h", true},
+		{"60m", "1h", true},
+		{"1h0m0s", "1h", true},
+		{"60 seconds", "1m", true},
+		{"5 minutes", "5m", true},
+		{"1d", "24h", true},
+		{"1w", "168h0m0s", true},
+		{"1d4h", "28h", true},
+		{" 30s ", "30s", true},
+		{"30s", "1m", false},
+		{"1h", "", false},
+		{"", "", true},
+		{"soon", "soon", true},
+		{"soon", "1m", false},
+	}
+	for _, tt := range tests {
+		got, diags := NewDurationValue(tt.prior).StringSemanticEquals(context.Background(), NewDurationValue(tt.updated))
+		if diags.HasError() {
+			t.Fatalf("StringSemanticEquals(%q, %q) diagnostics: %v", tt.prior, tt.updated, diags)
+		}
+		if got != tt.want {
+			t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", tt.prior, tt.updated, got, tt.want)
+		}
+	}
+}
+
+func TestDurationKeepEquivalent(t *testing.T) {
+	tests := []struct {
+		prior, updated, want Duration
+	}{
+		{NewDurationValue("60m"), NewDurationValue("1h"), NewDurationValue("60m")},
+		{NewDurationValue("30s"), NewDurationValue("1m"), NewDurationValue("1m")},
+		{NewDurationNull(), NewDurationValue("1h"), NewDurationValue("1h")},
+		{NewDurationUnknown(), NewDurationValue("1h"), NewDurationValue("1h")},
+		{NewDurationValue("1h"), NewDurationNull(), NewDurationNull()},
+	}
+	for _, tt := range tests {
+		if got := tt.prior.KeepEquivalent(tt.updated); !got.Equal(tt.want) {
+			t.Errorf("%s.KeepEquivalent(%s) = %s, want %s", tt.prior, tt.updated, got, tt.want)
+		}
+	}
+}
+
+func TestDurationStringSemanticEqualsWrongType(t *testing.T) {
+	_, diags := NewDurationValue("1h").StringSemanticEquals(context.Background(), basetypes.NewStringValue("1h"))
+	if !diags.HasError() {
+		t.Fatal("StringSemanticEquals() with a plain string value: expected an error diagnostic")
+	}
+}
+
+func TestDurationTypeValueFromTerraform(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		in   tftypes.Value
+		want Duration
+	}{
+		{tftypes.NewValue(tftypes.String, "5m"), NewDurationValue("5m")},
+		{tftypes.NewValue(tftypes.String, nil), NewDurationNull()},
+		{tftypes.NewValue(tftypes.String, tftypes.UnknownValue), NewDurationUnknown()},
+	}
+	for _, tt := range tests {
+		got, err := DurationType{}.ValueFromTerraform(ctx, tt.in)
+		if err != nil {
+			t.Fatalf("ValueFromTerraform(%s) error: %v", tt.in, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ValueFromTerraform(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
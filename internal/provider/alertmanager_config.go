@@ -0,0 +1,467 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// alertmanagerConfig is the part of an Alertmanager configuration file that
+// the conversion to notification routes and connected apps reads.
+type alertmanagerConfig struct {
+	Global struct {
+		SlackAPIURL    string `yaml:"slack_api_url"`
+		OpsgenieAPIKey string `yaml:"opsgenie_api_key"`
+	} `yaml:"global"`
+	Route        *alertmanagerRoute     `yaml:"route"`
+	Receivers    []alertmanagerReceiver `yaml:"receivers"`
+	InhibitRules []yaml.Node            `yaml:"inhibit_rules"`
+}
+
+type alertmanagerRoute struct {
+	Receiver            string               `yaml:"receiver"`
+	Match               map[string]string    `yaml:"match"`
+	MatchRE             map[string]string    `yaml:"match_re"`
+	Matchers            []string             `yaml:"matchers"`
+	Continue            bool                 `yaml:"continue"`
+	RepeatInterval      string               `yaml:"repeat_interval"`
+	MuteTimeIntervals   []string             `yaml:"mute_time_intervals"`
+	ActiveTimeIntervals []string             `yaml:"active_time_intervals"`
+	Routes              []*alertmanagerRoute `yaml:"routes"`
+}
+
+type alertmanagerReceiver struct {
+	Name             string                    `yaml:"name"`
+	SlackConfigs     []alertmanagerIntegration `yaml:"slack_configs"`
+	PagerdutyConfigs []alertmanagerIntegration `yaml:"pagerduty_configs"`
+	OpsgenieConfigs  []alertmanagerIntegration `yaml:"opsgenie_configs"`
+	WebhookConfigs   []alertmanagerIntegration `yaml:"webhook_configs"`
+	MSTeamsConfigs   []alertmanagerIntegration `yaml:"msteams_configs"`
+	MSTeamsV2Configs []alertmanagerIntegration `yaml:"msteamsv2_configs"`
+	Other            map[string]yaml.Node      `yaml:",inline"`
+}
+
+// alertmanagerIntegration holds the fields of the supported receiver
+// integrations; each integration type only sets some of them.
+type alertmanagerIntegration struct {
+	SendResolved *bool  `yaml:"send_resolved"`
+	APIURL       string `yaml:"api_url"`
+	URL          string `yaml:"url"`
+	WebhookURL   string `yaml:"webhook_url"`
+	RoutingKey   string `yaml:"routing_key"`
+	ServiceKey   string `yaml:"service_key"`
+	APIKey       string `yaml:"api_key"`
+	Channel      string `yaml:"channel"`
+}
+
+// alertmanagerConnectedApp is a connected app converted from one integration
+// of an Alertmanager receiver.
+type alertmanagerConnectedApp struct {
+	name         string
+	appType      string
+	receiver     string
+	data         map[string]string
+	sendResolved bool
+}
+
+// alertmanagerNotificationRoute is a notification route converted from a node
+// of the Alertmanager routing tree.
+type alertmanagerNotificationRoute struct {
+	name                   string
+	query                  string
+	receiver               string
+	rules                  []alertmanagerRouteRule
+	renotificationInterval string
+}
+
+// alertmanagerRouteRule lists the connected apps, by name, that a route
+// notifies for status.
+type alertmanagerRouteRule struct {
+	status        []string
+	connectedApps []alertmanagerConnectedApp
+}
+
+// alertmanagerConversion is the result of converting an Alertmanager
+// configuration.
+type alertmanagerConversion struct {
+	connectedApps      []alertmanagerConnectedApp
+	notificationRoutes []alertmanagerNotificationRoute
+	warnings           []string
+}
+
+// alertmanagerMatcherPattern matches one entry of a route's `matchers` list,
+// such as `severity=~"critical|warning"`.
+var alertmanagerMatcherPattern = regexp.MustCompile(`^\s*([a-zA-Z_][a-zA-Z0-9_]*|"[^"]*")\s*(=~|!~|!=|=)\s*(.*?)\s*$`)
+
+// alertmanagerMatcherUnescaper undoes the escapes Alertmanager accepts in a
+// quoted matcher value; other backslashes, such as in `\.`, are kept.
+var alertmanagerMatcherUnescaper = strings.NewReplacer(`\\`, `\`, `\"`, `"`, `\n`, "\n")
+
+// gcqlPlainValue matches values that can be written in a gcQL term unquoted.
+var gcqlPlainValue = regexp.MustCompile(`^[a-zA-Z0-9_.\-/]+$`)
+
+// convertAlertmanagerConfig converts the routing tree and receivers of the
+// Alertmanager configuration in configYaml into connected apps and
+// notification routes.
+//
+// Alertmanager sends an alert to the first matching child route, and to later
+// ones only while the matched routes set `continue`; a route's own receiver is
+// only notified when none of its children match. groundcover evaluates every
+// notification route independently, so each converted query excludes the
+// alerts an earlier sibling or a child takes.
+func convertAlertmanagerConfig(configYaml string) (*alertmanagerConversion, error) {
+	var config alertmanagerConfig
+	if err := yaml.Unmarshal([]byte(configYaml), &config); err != nil {
+		return nil, fmt.Errorf("could not parse the Alertmanager configuration: %w", err)
+	}
+	if config.Route == nil {
+		return nil, fmt.Errorf("the Alertmanager configuration has no top-level route")
+	}
+
+	result := &alertmanagerConversion{}
+	if len(config.InhibitRules) > 0 {
+		result.warnings = append(result.warnings, "inhibit_rules are not converted; groundcover notification routes have no inhibition")
+	}
+
+	appsByReceiver := map[string][]alertmanagerConnectedApp{}
+	for _, receiver := range config.Receivers {
+		apps := convertAlertmanagerReceiver(receiver, config, &result.warnings)
+		appsByReceiver[receiver.Name] = apps
+		result.connectedApps = append(result.connectedApps, apps...)
+	}
+
+	converter := alertmanagerRouteConverter{
+		appsByReceiver: appsByReceiver,
+		result:         result,
+		names:          map[string]int{},
+	}
+	converter.convert(config.Route, "route", nil, "", "")
+	return result, nil
+}
+
+type alertmanagerRouteConverter struct {
+	appsByReceiver map[string][]alertmanagerConnectedApp
+	result         *alertmanagerConversion
+	names          map[string]int
+}
+
+// convert adds the notification routes of route and its children. conditions
+// are the gcQL conditions an alert must meet to reach route, and receiver and
+// repeatInterval the values route inherits.
+func (c *alertmanagerRouteConverter) convert(route *alertmanagerRoute, location string, conditions []string, receiver, repeatInterval string) {
+	if route.Receiver != "" {
+		receiver = route.Receiver
+	}
+	if route.RepeatInterval != "" {
+		repeatInterval = route.RepeatInterval
+	}
+	if len(route.MuteTimeIntervals) > 0 || len(route.ActiveTimeIntervals) > 0 {
+		c.warn("%s: mute_time_intervals and active_time_intervals are not converted; use groundcover_silence or groundcover_recurring_silence", location)
+	}
+
+	// Matchers are converted once per route so each warning is reported once.
+	childTerms := make([][]string, len(route.Routes))
+	childMatchesAll := make([]bool, len(route.Routes))
+	for i, child := range route.Routes {
+		if child != nil {
+			childTerms[i], childMatchesAll[i] = c.routeMatchers(child, fmt.Sprintf("%s.routes[%d]", location, i))
+		}
+	}
+
+	var excluded []string
+	ownReachable := true
+	for i, child := range route.Routes {
+		if child == nil {
+			continue
+		}
+		childConditions := slices.Concat(conditions, excluded, childTerms[i])
+		c.convert(child, fmt.Sprintf("%s.routes[%d]", location, i), childConditions, receiver, repeatInterval)
+
+		if childMatchesAll[i] {
+			// A child that matches every alert leaves nothing for route's own receiver.
+			ownReachable = false
+			if !child.Continue {
+				if i < len(route.Routes)-1 {
+					c.warn("%s.routes[%d] matches every alert without continue, so the routes after it are never reached", location, i)
+				}
+				break
+			}
+			continue
+		}
+		// A child whose matchers were all left out excludes nothing, so its
+		// alerts may also be sent to the routes after it.
+		if !child.Continue && len(childTerms[i]) > 0 {
+			excluded = append(excluded, gcqlNot(childTerms[i]))
+		}
+	}
+	if !ownReachable {
+		return
+	}
+
+	// route's own receiver only gets the alerts none of its children match.
+	own := slices.Clone(conditions)
+	for _, terms := range childTerms {
+		if len(terms) > 0 {
+			own = append(own, gcqlNot(terms))
+		}
+	}
+	c.addRoute(own, receiver, repeatInterval, location)
+}
+
+// routeMatchers returns route's matchers as gcQL conditions, and whether
+// route has no matchers and so matches every alert. Matchers that cannot be
+// converted are left out with a warning for location.
+func (c *alertmanagerRouteConverter) routeMatchers(route *alertmanagerRoute, location string) ([]string, bool) {
+	matchesAll := len(route.Match) == 0 && len(route.MatchRE) == 0 && len(route.Matchers) == 0
+	var terms []string
+	for _, key := range slices.Sorted(maps.Keys(route.Match)) {
+		terms = append(terms, gcqlEquals(key, route.Match[key]))
+	}
+	for _, key := range slices.Sorted(maps.Keys(route.MatchRE)) {
+		term, ok := gcqlRegexMatch(key, route.MatchRE[key])
+		if !ok {
+			c.warn("%s: match_re %s=~%q is not a list of values or a prefix, so it is left out and the route matches more alerts", location, key, route.MatchRE[key])
+			continue
+		}
+		terms = append(terms, term)
+	}
+	for _, matcher := range route.Matchers {
+		term, ok := alertmanagerMatcherToGcQL(matcher)
+		if !ok {
+			c.warn("%s: matcher %q cannot be written in gcQL, so it is left out and the route matches more alerts", location, matcher)
+			continue
+		}
+		terms = append(terms, term)
+	}
+	return terms, matchesAll
+}
+
+func (c *alertmanagerRouteConverter) addRoute(conditions []string, receiver, repeatInterval, location string) {
+	apps := c.appsByReceiver[receiver]
+	if len(apps) == 0 {
+		if _, known := c.appsByReceiver[receiver]; !known {
+			c.warn("%s: receiver %q is not defined", location, receiver)
+		}
+		return
+	}
+
+	var both, alertingOnly []alertmanagerConnectedApp
+	for _, app := range apps {
+		if app.sendResolved {
+			both = append(both, app)
+		} else {
+			alertingOnly = append(alertingOnly, app)
+		}
+	}
+	var rules []alertmanagerRouteRule
+	if len(both) > 0 {
+		rules = append(rules, alertmanagerRouteRule{status: []string{"Alerting", "Resolved"}, connectedApps: both})
+	}
+	if len(alertingOnly) > 0 {
+		rules = append(rules, alertmanagerRouteRule{status: []string{"Alerting"}, connectedApps: alertingOnly})
+	}
+
+	query := strings.Join(conditions, " AND ")
+	if query == "" {
+		query = "*"
+	}
+	c.result.notificationRoutes = append(c.result.notificationRoutes, alertmanagerNotificationRoute{
+		name:                   c.uniqueName(receiver),
+		query:                  query,
+		receiver:               receiver,
+		rules:                  rules,
+		renotificationInterval: repeatInterval,
+	})
+}
+
+func (c *alertmanagerRouteConverter) uniqueName(receiver string) string {
+	c.names[receiver]++
+	if n := c.names[receiver]; n > 1 {
+		return fmt.Sprintf("%s-%d", receiver, n)
+	}
+	return receiver
+}
+
+func (c *alertmanagerRouteConverter) warn(format string, args ...any) {
+	c.result.warnings = append(c.result.warnings, fmt.Sprintf(format, args...))
+}
+
+// convertAlertmanagerReceiver returns a connected app for each integration of
+// receiver that has a groundcover connected app type.
+func convertAlertmanagerReceiver(receiver alertmanagerReceiver, config alertmanagerConfig, warnings *[]string) []alertmanagerConnectedApp {
+	var apps []alertmanagerConnectedApp
+	add := func(appType string, integration alertmanagerIntegration, sendResolvedDefault bool, data map[string]string) {
+		for key, value := range data {
+			if value == "" {
+				*warnings = append(*warnings, fmt.Sprintf("receiver %q: the %s integration sets no %s (values read from files are not converted); set it on the connected app", receiver.Name, appType, key))
+			}
+		}
+		sendResolved := sendResolvedDefault
+		if integration.SendResolved != nil {
+			sendResolved = *integration.SendResolved
+		}
+		apps = append(apps, alertmanagerConnectedApp{
+			appType:      appType,
+			receiver:     receiver.Name,
+			data:         data,
+			sendResolved: sendResolved,
+		})
+	}
+
+	for _, slack := range receiver.SlackConfigs {
+		url := slack.APIURL
+		if url == "" {
+			url = config.Global.SlackAPIURL
+		}
+		if slack.Channel != "" {
+			*warnings = append(*warnings, fmt.Sprintf("receiver %q: slack channel %q is not converted; a slack-webhook posts to the channel of its webhook", receiver.Name, slack.Channel))
+		}
+		add("slack-webhook", slack, false, map[string]string{"url": url})
+	}
+	for _, pagerduty := range receiver.PagerdutyConfigs {
+		key := pagerduty.RoutingKey
+		if key == "" {
+			key = pagerduty.ServiceKey
+		}
+		add("pagerduty", pagerduty, true, map[string]string{"routing_key": key})
+	}
+	for _, opsgenie := range receiver.OpsgenieConfigs {
+		key := opsgenie.APIKey
+		if key == "" {
+			key = config.Global.OpsgenieAPIKey
+		}
+		add("opsgenie", opsgenie, true, map[string]string{"api_key": key})
+	}
+	for _, webhook := range receiver.WebhookConfigs {
+		add("webhook", webhook, true, map[string]string{"url": webhook.URL})
+	}
+	for _, teams := range slices.Concat(receiver.MSTeamsConfigs, receiver.MSTeamsV2Configs) {
+		add("ms-teams", teams, true, map[string]string{"url": teams.WebhookURL})
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(receiver.Other)) {
+		if strings.HasSuffix(key, "_configs") {
+			*warnings = append(*warnings, fmt.Sprintf("receiver %q: %s have no groundcover connected app type and are not converted", receiver.Name, key))
+		}
+	}
+
+	for i := range apps {
+		apps[i].name = receiver.Name
+		if len(apps) > 1 {
+			apps[i].name = fmt.Sprintf("%s-%s-%d", receiver.Name, apps[i].appType, i+1)
+		}
+	}
+	return apps
+}
+
+// alertmanagerMatcherToGcQL converts a matcher such as `team="payments"` or
+// `severity=~"critical|warning"` into a gcQL condition.
+func alertmanagerMatcherToGcQL(matcher string) (string, bool) {
+	parts := alertmanagerMatcherPattern.FindStringSubmatch(matcher)
+	if parts == nil {
+		return "", false
+	}
+	key, op, value := parts[1], parts[2], parts[3]
+	if unquoted, err := strconv.Unquote(key); err == nil {
+		key = unquoted
+	}
+	if strings.HasPrefix(value, `"`) {
+		if len(value) < 2 || !strings.HasSuffix(value, `"`) {
+			return "", false
+		}
+		value = alertmanagerMatcherUnescaper.Replace(value[1 : len(value)-1])
+	}
+
+	switch op {
+	case "=":
+		return gcqlEquals(key, value), true
+	case "!=":
+		return gcqlNot([]string{gcqlEquals(key, value)}), true
+	case "=~":
+		return gcqlRegexMatch(key, value)
+	default:
+		term, ok := gcqlRegexMatch(key, value)
+		if !ok {
+			return "", false
+		}
+		return gcqlNot([]string{term}), true
+	}
+}
+
+// gcqlEquals returns the gcQL condition for label key equal to value. An
+// empty value matches alerts without the label, as in Alertmanager.
+func gcqlEquals(key, value string) string {
+	if value == "" {
+		return fmt.Sprintf("NOT %s:*", key)
+	}
+	if gcqlPlainValue.MatchString(value) {
+		return fmt.Sprintf("%s:%s", key, value)
+	}
+	return fmt.Sprintf("%s:%s", key, strconv.Quote(value))
+}
+
+// gcqlRegexMatch converts an anchored Alertmanager regular expression into
+// gcQL when it is a list of literal alternatives, each optionally ending or
+// starting with `.*`, such as `critical|warning` or `payments-.*`.
+func gcqlRegexMatch(key, pattern string) (string, bool) {
+	if strings.HasPrefix(pattern, "(") && strings.HasSuffix(pattern, ")") {
+		pattern = pattern[1 : len(pattern)-1]
+	}
+	var terms []string
+	for _, alternative := range strings.Split(pattern, "|") {
+		if alternative == ".*" || alternative == ".+" {
+			return fmt.Sprintf("%s:*", key), true
+		}
+		prefix := strings.HasPrefix(alternative, ".*")
+		suffix := strings.HasSuffix(alternative, ".*")
+		literal := strings.TrimSuffix(strings.TrimPrefix(alternative, ".*"), ".*")
+		literal, ok := unescapeRegexLiteral(literal)
+		if !ok || literal == "" || !gcqlPlainValue.MatchString(literal) {
+			return "", false
+		}
+		if prefix {
+			literal = "*" + literal
+		}
+		if suffix {
+			literal += "*"
+		}
+		terms = append(terms, fmt.Sprintf("%s:%s", key, literal))
+	}
+	if len(terms) == 1 {
+		return terms[0], true
+	}
+	return "(" + strings.Join(terms, " OR ") + ")", true
+}
+
+// unescapeRegexLiteral returns the text a regular expression without
+// metacharacters matches, or false when it has unescaped metacharacters.
+func unescapeRegexLiteral(pattern string) (string, bool) {
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c == '\\' && i+1 < len(pattern) {
+			i++
+			b.WriteByte(pattern[i])
+			continue
+		}
+		if strings.IndexByte(`\.^$|?*+()[]{}`, c) >= 0 {
+			return "", false
+		}
+		b.WriteByte(c)
+	}
+	return b.String(), true
+}
+
+// gcqlNot returns the gcQL condition that matches when the conditions in
+// terms do not all hold.
+func gcqlNot(terms []string) string {
+	if len(terms) == 1 && (!strings.Contains(terms[0], " ") || strings.HasPrefix(terms[0], "(")) {
+		return "NOT " + terms[0]
+	}
+	return "NOT (" + strings.Join(terms, " AND ") + ")"
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
+)
+
+const testAlertmanagerConfig = `
+global:
+  slack_api_url: https://hooks.slack.com/services/T0/B0/default
+route:
+  receiver: default-slack
+  repeat_interval: 4h
+  routes:
+    - matchers:
+        - team="payments"
+        - severity=~"critical|page"
+      receiver: payments-oncall
+      continue: true
+    - match:
+        team: payments
+      receiver: payments-slack
+      repeat_interval: 1h
+    - matchers: [ env!~"dev-.*" ]
+      receiver: blackhole
+receivers:
+  - name: default-slack
+    slack_configs:
+      - channel: "#alerts"
+  - name: payments-oncall
+    pagerduty_configs:
+      - routing_key: pd-key
+        send_resolved: false
+    slack_configs:
+      - api_url: https://hooks.slack.com/services/T0/B0/payments
+        send_resolved: true
+  - name: payments-slack
+    slack_configs:
+      - api_url: https://hooks.slack.com/services/T0/B0/payments
+    email_configs:
+      - to: payments@example.com
+  - name: blackhole
+inhibit_rules:
+  - source_matchers: [ severity="critical" ]
+    target_matchers: [ severity="warning" ]
+`
+
+func TestConvertAlertmanagerConfig(t *testing.T) {
+	conversion, err := convertAlertmanagerConfig(testAlertmanagerConfig)
+	if err != nil {
+		t.Fatalf("convertAlertmanagerConfig() error = %v", err)
+	}
+
+	var appNames []string
+	for _, app := range conversion.connectedApps {
+		appNames = append(appNames, app.name+"/"+app.appType)
+	}
+	wantApps := "default-slack/slack-webhook payments-oncall-slack-webhook-1/slack-webhook payments-oncall-pagerduty-2/pagerduty payments-slack/slack-webhook"
+	if strings.Join(appNames, " ") != wantApps {
+		t.Fatalf("connected apps = %v, want %s", appNames, wantApps)
+	}
+	if url := conversion.connectedApps[0].data["url"]; url != "https://hooks.slack.com/services/T0/B0/default" {
+		t.Fatalf("default-slack url = %q, want the global slack_api_url", url)
+	}
+
+	routes := map[string]alertmanagerNotificationRoute{}
+	for _, route := range conversion.notificationRoutes {
+		routes[route.name] = route
+		if err := validators.ValidateGcQLQuery(route.query); err != nil {
+			t.Fatalf("route %s query %q is not valid gcQL: %v", route.name, route.query, err)
+		}
+	}
+	if len(routes) != 3 {
+		t.Fatalf("notification routes = %+v, want payments-oncall, payments-slack, and default-slack", conversion.notificationRoutes)
+	}
+
+	oncall := routes["payments-oncall"]
+	if oncall.query != "team:payments AND (severity:critical OR severity:page)" || oncall.renotificationInterval != "4h" {
+		t.Fatalf("payments-oncall = %+v", oncall)
+	}
+	if len(oncall.rules) != 2 || len(oncall.rules[0].status) != 2 || oncall.rules[0].connectedApps[0].appType != "slack-webhook" || len(oncall.rules[1].status) != 1 || oncall.rules[1].connectedApps[0].appType != "pagerduty" {
+		t.Fatalf("payments-oncall rules = %+v, want the resolved-notifying slack app, then pagerduty for Alerting only", oncall.rules)
+	}
+
+	// The continue route does not exclude its alerts from the next sibling.
+	if slack := routes["payments-slack"]; slack.query != "team:payments" || slack.renotificationInterval != "1h" {
+		t.Fatalf("payments-slack = %+v", slack)
+	}
+
+	// The root receiver only gets alerts that no child route takes.
+	wantDefault := `NOT (team:payments AND (severity:critical OR severity:page)) AND NOT team:payments AND NOT (NOT env:dev-*)`
+	if def := routes["default-slack"]; def.query != wantDefault {
+		t.Fatalf("default-slack query = %q, want %q", def.query, wantDefault)
+	}
+
+	warnings := strings.Join(conversion.warnings, "\n")
+	for _, want := range []string{"inhibit_rules", `receiver "payments-slack": email_configs`, `slack channel "#alerts"`} {
+		if !strings.Contains(warnings, want) {
+			t.Fatalf("warnings = %q, want one about %s", warnings, want)
+		}
+	}
+}
+
+func TestConvertAlertmanagerConfigFirstMatch(t *testing.T) {
+	conversion, err := convertAlertmanagerConfig(`
+route:
+  receiver: default
+  routes:
+    - match_re:
+        service: api|web
+      receiver: frontend
+    - match_re:
+        service: "(a+)"
+      receiver: backend
+    - receiver: catch-all
+    - receiver: unreachable
+receivers:
+  - name: default
+    webhook_configs: [ { url: "https://example.com/default" } ]
+  - name: frontend
+    webhook_configs: [ { url: "https://example.com/frontend" } ]
+  - name: backend
+    msteams_configs: [ { webhook_url: "https://example.com/teams" } ]
+  - name: catch-all
+    opsgenie_configs: [ { api_key: og-key } ]
+`)
+	if err != nil {
+		t.Fatalf("convertAlertmanagerConfig() error = %v", err)
+	}
+
+	var got []string
+	for _, route := range conversion.notificationRoutes {
+		got = append(got, route.name+": "+route.query)
+	}
+	want := []string{
+		"frontend: (service:api OR service:web)",
+		"backend: NOT (service:api OR service:web)",
+		"catch-all: NOT (service:api OR service:web)",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("notification routes:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	warnings := strings.Join(conversion.warnings, "\n")
+	if !strings.Contains(warnings, `match_re service=~"(a+)"`) || !strings.Contains(warnings, "route.routes[2] matches every alert") {
+		t.Fatalf("warnings = %q", warnings)
+	}
+}
+
+func TestAlertmanagerMatcherToGcQL(t *testing.T) {
+	tests := []struct {
+		matcher string
+		want    string
+		wantOK  bool
+	}{
+		{matcher: `team="payments"`, want: "team:payments", wantOK: true},
+		{matcher: `team = payments`, want: "team:payments", wantOK: true},
+		{matcher: `summary="disk full"`, want: `summary:"disk full"`, wantOK: true},
+		{matcher: `env!="prod"`, want: "NOT env:prod", wantOK: true},
+		{matcher: `owner=""`, want: "NOT owner:*", wantOK: true},
+		{matcher: `cluster=~"eu-.*"`, want: "cluster:eu-*", wantOK: true},
+		{matcher: `host=~".*\.internal"`, want: "host:*.internal", wantOK: true},
+		{matcher: `severity!~"info|debug"`, want: "NOT (severity:info OR severity:debug)", wantOK: true},
+		{matcher: `pod=~"api-[0-9]+"`},
+		{matcher: `not a matcher`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.matcher, func(t *testing.T) {
+			got, ok := alertmanagerMatcherToGcQL(tt.matcher)
+			if ok != tt.wantOK || got != tt.want {
+				t.Fatalf("alertmanagerMatcherToGcQL() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
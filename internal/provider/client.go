@@ -5,6 +5,7 @@ package provider
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
@@ -13,8 +14,10 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	// NEW SDK IMPORTS
@@ -51,6 +54,9 @@ var (
 	ErrNotFound    = errors.New("resource not found")
 	ErrConcurrency = errors.New("concurrency conflict detected")
 	ErrReadOnly    = errors.New("resource is read-only")
+	// ErrUnauthorized and ErrPermissionDenied wrap 401 and 403 responses; see accessDeniedError.
+	ErrUnauthorized     = errors.New("API key rejected")
+	ErrPermissionDenied = errors.New("permission denied")
 )
 
 const (
@@ -147,11 +153,93 @@ func (t *rateLimitRetryTransport) RoundTrip(req *http.Request) (*http.Response,
 	return resp, err
 }
 
+// providerManagedHeaders are request headers the provider sets itself, which
+// extra_headers may not replace.
+var providerManagedHeaders = []string{"Authorization", "X-Backend-Id", "Content-Type", "Content-Encoding", "Content-Length", "Host"}
+
+// validateExtraHeaders rejects extra headers the provider manages itself.
+func validateExtraHeaders(headers map[string]string) error {
+	for name := range headers {
+		canonical := http.CanonicalHeaderKey(name)
+		if slices.Contains(providerManagedHeaders, canonical) {
+			return fmt.Errorf("the %s header is set by the provider and cannot be overridden", canonical)
+		}
+	}
+	return nil
+}
+
+// extraHeadersTransport adds a fixed set of headers, such as those a corporate
+// gateway requires, to every request attempt.
+type extraHeadersTransport struct {
+	transport http.RoundTripper
+	headers   http.Header
+}
+
+func (t *extraHeadersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	withHeaders := req.Clone(req.Context())
+	for name, values := range t.headers {
+		withHeaders.Header[name] = values
+	}
+	return t.transport.RoundTrip(withHeaders)
+}
+
+// minGzipRequestBytes is the smallest request body worth compressing.
+const minGzipRequestBytes = 1024
+
+// gzipRequestTransport compresses request bodies of at least minGzipRequestBytes
+// with gzip and marks them with Content-Encoding. Smaller bodies are sent as-is.
+type gzipRequestTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *gzipRequestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.Header.Get("Content-Encoding") != "" {
+		return t.transport.RoundTrip(req)
+	}
+
+	bodyBytes, err := io.ReadAll(req.Body)
+	_ = req.Body.Close() // Best-effort close; body already read
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body for compression: %w", err)
+	}
+
+	// RoundTrip must not modify the caller's request, so the body read above
+	// is sent on a clone.
+	sendReq := req.Clone(req.Context())
+	if len(bodyBytes) < minGzipRequestBytes {
+		sendReq.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+		sendReq.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(bodyBytes)), nil
+		}
+		return t.transport.RoundTrip(sendReq)
+	}
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	if _, err := gz.Write(bodyBytes); err != nil {
+		return nil, fmt.Errorf("failed to gzip request body: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to gzip request body: %w", err)
+	}
+
+	sendReq.Body = io.NopCloser(bytes.NewReader(compressed.Bytes()))
+	sendReq.ContentLength = int64(compressed.Len())
+	sendReq.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressed.Bytes())), nil
+	}
+	sendReq.Header.Set("Content-Encoding", "gzip")
+	sendReq.Header.Del("Content-Length")
+
+	return t.transport.RoundTrip(sendReq)
+}
+
 // ApiClient defines the interface for interacting with the Groundcover API for Terraform resources.
 type ApiClient interface {
 	// Policies
 	CreatePolicy(ctx context.Context, req *models.CreatePolicyRequest) (*models.Policy, error)
 	GetPolicy(ctx context.Context, uuid string) (*models.Policy, error)
+	ListPolicies(ctx context.Context) ([]*models.PolicyWithEntityCount, error)
 	UpdatePolicy(ctx context.Context, uuid string, req *models.UpdatePolicyRequest) (*models.Policy, error)
 	DeletePolicy(ctx context.Context, uuid string) error
 
@@ -164,6 +252,7 @@ type ApiClient interface {
 	// Monitors (YAML based) - Provider will unmarshal YAML to request models before calling.
 	CreateMonitor(ctx context.Context, req *models.CreateMonitorRequest) (*models.CreateMonitorResponse, error)
 	GetMonitor(ctx context.Context, id string) ([]byte, error)                            // Returns raw YAML bytes
+	ListMonitors(ctx context.Context) ([]*models.MonitorListItem, error)                  // IDs and titles only, no YAML
 	UpdateMonitor(ctx context.Context, id string, req *models.UpdateMonitorRequest) error // Update response has no payload
 	DeleteMonitor(ctx context.Context, id string) error
 
@@ -176,6 +265,7 @@ type ApiClient interface {
 	// API Keys
 	CreateApiKey(ctx context.Context, req *models.CreateAPIKeyRequest) (*models.CreateAPIKeyResponse, error)
 	ListApiKeys(ctx context.Context, withRevoked *bool, withExpired *bool) ([]*models.ListAPIKeysResponseItem, error)
+	GetApiKey(ctx context.Context, id string) (*models.ListAPIKeysResponseItem, error) // Served from a per-run list; the API has no GET by ID
 	DeleteApiKey(ctx context.Context, id string) error
 
 	// Logs Pipeline
@@ -202,6 +292,15 @@ type ApiClient interface {
 	UpdateMetricsPipeline(ctx context.Context, req *models.CreateOrUpdateMetricsPipelineConfigRequest) (*models.MetricsPipelineConfigInfo, error)
 	DeleteMetricsPipeline(ctx context.Context) error
 
+	// Workspace Settings (the tenant AI settings, the only tenant-wide settings the API exposes)
+	GetTenantAISettings(ctx context.Context) (*models.TenantAISettingsResponse, error)
+	UpdateTenantAISettings(ctx context.Context, req *models.UpdateTenantAISettingsRequest) (*models.TenantAISettingsResponse, error)
+
+	// Retention Policies (storage management policies, one per data type, which the API cannot delete)
+	CreateRetentionPolicy(ctx context.Context, dataType string, req *models.StorageManagementPolicyRequest) (*models.StorageManagementPolicyResponse, error)
+	GetRetentionPolicy(ctx context.Context, dataType string) (*models.StorageManagementPolicyResponse, error)
+	UpdateRetentionPolicy(ctx context.Context, dataType string, req *models.StorageManagementPolicyRequest) (*models.StorageManagementPolicyResponse, error)
+
 	// Ingestion Keys
 	CreateIngestionKey(ctx context.Context, req *models.CreateIngestionKeyRequest) (*models.IngestionKeyResult, error)
 	ListIngestionKeys(ctx context.Context, req *models.ListIngestionKeysRequest) ([]*models.IngestionKeyResult, error)
@@ -210,6 +309,7 @@ type ApiClient interface {
 	// Dashboards
 	CreateDashboard(ctx context.Context, dashboard *models.CreateDashboardRequest) (*models.View, error)
 	GetDashboard(ctx context.Context, uuid string) (*models.View, error)
+	ListDashboards(ctx context.Context) ([]*models.View, error)
 	UpdateDashboard(ctx context.Context, uuid string, dashboard *models.UpdateDashboardRequest) (*models.View, error)
 	DeleteDashboard(ctx context.Context, uuid string) error
 
@@ -219,6 +319,9 @@ type ApiClient interface {
 	UpdateDataIntegration(ctx context.Context, integrationType string, id string, req *models.CreateDataIntegrationConfigRequest) (*models.DataIntegrationConfig, error)
 	DeleteDataIntegration(ctx context.Context, integrationType string, id string, cluster *string) error
 
+	// Clusters
+	ListClusters(ctx context.Context) ([]*models.ClustersListResult, error)
+
 	// Secrets
 	CreateSecret(ctx context.Context, req *models.CreateSecretRequest) (*models.SecretResponse, error)
 	GetSecretHash(ctx context.Context, id string) (*models.SecretHashResponse, error)
@@ -240,21 +343,30 @@ type ApiClient interface {
 	// Connected Apps
 	CreateConnectedApp(ctx context.Context, req *models.CreateConnectedAppRequest) (*models.CreateConnectedAppResponse, error)
 	GetConnectedApp(ctx context.Context, id string) (*models.ConnectedAppResponse, error)
+	ListConnectedApps(ctx context.Context, req *models.ListConnectedAppsRequest) ([]*models.ConnectedAppListItemWithRoutesResponse, error)
 	UpdateConnectedApp(ctx context.Context, id string, req *models.UpdateConnectedAppRequest) error
 	DeleteConnectedApp(ctx context.Context, id string) error
 
 	// Notification Routes
 	CreateNotificationRoute(ctx context.Context, req *models.CreateNotificationRouteRequest) (*models.CreateNotificationRouteResponse, error)
 	GetNotificationRoute(ctx context.Context, id string) (*models.NotificationRouteResponse, error)
+	ListNotificationRoutes(ctx context.Context) ([]*models.NotificationRouteListItemResponse, error)
 	UpdateNotificationRoute(ctx context.Context, id string, req *models.UpdateNotificationRouteRequest) error
 	DeleteNotificationRoute(ctx context.Context, id string) error
 
 	// Synthetic Tests
 	CreateSyntheticTest(ctx context.Context, req *models.SyntheticTestCreateRequest) (*models.SyntheticTestCreateResponse, error)
 	GetSyntheticTest(ctx context.Context, id string) (*models.SyntheticTestCreateRequest, error)
+	ListSyntheticTests(ctx context.Context) ([]*models.SyntheticTestListItem, error) // Includes each test's monitor UUID
 	UpdateSyntheticTest(ctx context.Context, id string, req *models.SyntheticTestCreateRequest) error
 	DeleteSyntheticTest(ctx context.Context, id string) error
 
+	// Search (the keys a gcQL query can filter on)
+	GetSearchKeys(ctx context.Context, req *models.KeysRequest) (*models.KeysResponse, error)
+
+	// Workflows
+	ListWorkflows(ctx context.Context) ([]*models.Workflow, error)
+
 	// Agent Skills
 	CreateSkill(ctx context.Context, req *models.AgentSkillRequest) (*models.AgentSkillDetail, error)
 	GetSkill(ctx context.Context, id string) (*models.AgentSkillDetail, error)
@@ -265,10 +377,159 @@ type ApiClient interface {
 // SdkClientWrapper implements ApiClient using the Groundcover Go SDK.
 type SdkClientWrapper struct {
 	sdkClient *goclient.GroundcoverAPI
+
+	// monitorPrefetch is non-nil when bulk monitor prefetching is enabled.
+	monitorPrefetch *monitorPrefetchCache
+	// apiKeys indexes the listed API keys for GetApiKey.
+	apiKeys apiKeyIndex
+	// requestTimeout bounds each API call, retries included; zero means defaultTimeout.
+	requestTimeout time.Duration
+}
+
+// sdkClientOptions collects the optional behaviors of the wrapper built by NewSdkClientWrapper.
+type sdkClientOptions struct {
+	monitorPrefetch  bool
+	compressRequests bool
+	telemetryFile    string
+	requestTimeout   time.Duration
+	readOnly         bool
+	extraHeaders     http.Header
+	transport        http.RoundTripper
+}
+
+// sdkClientOption customizes the wrapper built by NewSdkClientWrapper.
+type sdkClientOption func(*sdkClientOptions)
+
+// withMonitorPrefetch enables the per-run monitor YAML cache populated on the first GetMonitor.
+func withMonitorPrefetch() sdkClientOption {
+	return func(o *sdkClientOptions) {
+		o.monitorPrefetch = true
+	}
+}
+
+// withRequestCompression gzip-compresses large request bodies.
+func withRequestCompression() sdkClientOption {
+	return func(o *sdkClientOptions) {
+		o.compressRequests = true
+	}
+}
+
+// withAPITelemetry records per-endpoint request statistics and keeps a JSON summary in path.
+func withAPITelemetry(path string) sdkClientOption {
+	return func(o *sdkClientOptions) {
+		o.telemetryFile = path
+	}
+}
+
+// withRequestTimeout bounds each API call, retries included, by d instead of defaultTimeout.
+func withRequestTimeout(d time.Duration) sdkClientOption {
+	return func(o *sdkClientOptions) {
+		o.requestTimeout = d
+	}
+}
+
+// withExtraHeaders adds headers to every request, after validateExtraHeaders.
+func withExtraHeaders(headers map[string]string) sdkClientOption {
+	return func(o *sdkClientOptions) {
+		o.extraHeaders = http.Header{}
+		for name, value := range headers {
+			o.extraHeaders.Set(name, value)
+		}
+	}
+}
+
+// withTransport sends requests through transport instead of a default
+// http.Transport. The provider's authentication, retry, and caching layers
+// still wrap it, so tests can intercept requests without a network.
+func withTransport(transport http.RoundTripper) sdkClientOption {
+	return func(o *sdkClientOptions) {
+		o.transport = transport
+	}
+}
+
+// withReadOnly fails every API call that could change the backend without sending it.
+func withReadOnly() sdkClientOption {
+	return func(o *sdkClientOptions) {
+		o.readOnly = true
+	}
 }
 
 var _ ApiClient = (*SdkClientWrapper)(nil)
 
+// timeout returns the time each API call may take, retries included.
+func (c *SdkClientWrapper) timeout() time.Duration {
+	if c.requestTimeout > 0 {
+		return c.requestTimeout
+	}
+	return defaultTimeout
+}
+
+// callContext returns the context for one API call. It ends after the
+// request timeout, or at ctx's own deadline if that comes first, and records
+// the request ID of a failed response so handleApiError can report it.
+func (c *SdkClientWrapper) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx = context.WithValue(ctx, apiCallInfoKey{}, &apiCallInfo{})
+	return context.WithTimeout(ctx, c.timeout())
+}
+
+type apiCallInfoKey struct{}
+
+// apiCallInfo collects what the transport learns about a call's HTTP requests.
+type apiCallInfo struct {
+	mu        sync.Mutex
+	requestID string
+}
+
+func (i *apiCallInfo) setRequestID(id string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.requestID = id
+}
+
+// apiCallRequestID returns the request ID of the last failed response of the
+// call ctx belongs to, or "" when there is none.
+func apiCallRequestID(ctx context.Context) string {
+	info, ok := ctx.Value(apiCallInfoKey{}).(*apiCallInfo)
+	if !ok {
+		return ""
+	}
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	return info.requestID
+}
+
+// requestIDHeader is the response header in which the API returns the ID it
+// assigned to a request.
+const requestIDHeader = "X-Request-Id"
+
+// requestIDTransport logs the request ID of every failed response and records
+// it on the call's apiCallInfo. It sits above the retry layers, so only the
+// final response of a request is seen.
+type requestIDTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if resp == nil || resp.StatusCode < http.StatusBadRequest {
+		return resp, err
+	}
+	requestID := resp.Header.Get(requestIDHeader)
+	if requestID == "" {
+		return resp, err
+	}
+
+	tflog.Warn(req.Context(), "groundcover API request failed", map[string]any{
+		"endpoint":    telemetryEndpoint(req),
+		"status_code": resp.StatusCode,
+		"request_id":  requestID,
+	})
+	if info, ok := req.Context().Value(apiCallInfoKey{}).(*apiCallInfo); ok {
+		info.setRequestID(requestID)
+	}
+	return resp, err
+}
+
 var getMonitorPathRegex = regexp.MustCompile(`^/api/monitors/[^/]+/?$`)
 
 // overrideYamlContextTypeTransport wraps an http.RoundTripper to ensure that
@@ -294,7 +555,7 @@ func (f *overrideYamlContextTypeTransport) RoundTrip(req *http.Request) (*http.R
 	return resp, nil
 }
 
-func NewSdkClientWrapper(ctx context.Context, baseURLStr, apiKey, backendID string) (ApiClient, error) {
+func NewSdkClientWrapper(ctx context.Context, baseURLStr, apiKey, backendID string, opts ...sdkClientOption) (ApiClient, error) {
 	if baseURLStr == "" {
 		return nil, errors.New("GROUNDCOVER_API_URL (api_url) environment variable or provider config is required")
 	}
@@ -305,6 +566,11 @@ func NewSdkClientWrapper(ctx context.Context, baseURLStr, apiKey, backendID stri
 		return nil, errors.New("GROUNDCOVER_BACKEND_ID (backend_id) environment variable or provider config is required")
 	}
 
+	var options sdkClientOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	userEnabledDebug := os.Getenv("TF_LOG") == "debug"
 
 	// Normalize the URL to ensure it has a proper scheme before parsing
@@ -332,9 +598,26 @@ func NewSdkClientWrapper(ctx context.Context, baseURLStr, apiKey, backendID stri
 		schemes = goclient.DefaultSchemes
 	}
 
-	baseHttpTransport := &http.Transport{
+	// net/http requests gzip-encoded responses and decompresses them transparently,
+	// so only request compression needs an explicit transport.
+	var baseHttpTransport http.RoundTripper = &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 	}
+	if options.transport != nil {
+		baseHttpTransport = options.transport
+	}
+	if len(options.extraHeaders) > 0 {
+		baseHttpTransport = &extraHeadersTransport{transport: baseHttpTransport, headers: options.extraHeaders}
+	}
+	if options.compressRequests {
+		baseHttpTransport = &gzipRequestTransport{transport: baseHttpTransport}
+	}
+	var telemetry *apiTelemetry
+	if options.telemetryFile != "" {
+		// Attempts are counted below both retry layers; logical requests above them.
+		telemetry = apiTelemetryFor(options.telemetryFile)
+		baseHttpTransport = &telemetryAttemptTransport{transport: baseHttpTransport, telemetry: telemetry}
+	}
 
 	retryableStatuses := []int{
 		http.StatusServiceUnavailable,
@@ -360,8 +643,13 @@ func NewSdkClientWrapper(ctx context.Context, baseURLStr, apiKey, backendID stri
 		maxWait:    maxRetryWait,
 	}
 
+	var topTransport http.RoundTripper = rateLimitTransport
+	if telemetry != nil {
+		topTransport = &telemetryRequestTransport{transport: rateLimitTransport, telemetry: telemetry}
+	}
+
 	monitorContentTypeFixer := &overrideYamlContextTypeTransport{
-		transport: rateLimitTransport,
+		transport: &requestIDTransport{transport: topTransport},
 	}
 
 	finalRuntimeTransport := openapi_client.New(host, basePath, schemes)
@@ -375,9 +663,22 @@ func NewSdkClientWrapper(ctx context.Context, baseURLStr, apiKey, backendID stri
 	finalRuntimeTransport.SetLogger(&tflogAdapter{ctx: ctx})
 	finalRuntimeTransport.SetDebug(userEnabledDebug)
 
-	newSdkClient := goclient.New(finalRuntimeTransport, strfmt.Default)
+	var clientTransport apiruntime.ClientTransport = &readRetryClientTransport{
+		transport:  finalRuntimeTransport,
+		maxRetries: readRetryCount,
+		wait:       readRetryWait,
+	}
+	if options.readOnly {
+		clientTransport = &readOnlyClientTransport{transport: clientTransport}
+	}
+	newSdkClient := goclient.New(clientTransport, strfmt.Default)
 
-	return &SdkClientWrapper{sdkClient: newSdkClient}, nil
+	wrapper := &SdkClientWrapper{sdkClient: newSdkClient, requestTimeout: options.requestTimeout}
+	if options.monitorPrefetch {
+		wrapper.monitorPrefetch = &monitorPrefetchCache{}
+	}
+
+	return wrapper, nil
 }
 
 // statusCodeRegex extracts the HTTP status code from SDK error strings.
@@ -390,6 +691,9 @@ func handleApiError(ctx context.Context, err error, operation string, resourceId
 	if err == nil {
 		return nil
 	}
+	if errors.Is(err, ErrProviderReadOnly) {
+		return err
+	}
 
 	errStr := err.Error()
 	logFields := map[string]any{
@@ -397,6 +701,10 @@ func handleApiError(ctx context.Context, err error, operation string, resourceId
 		"resource_id": resourceId,
 		"sdk_error":   errStr,
 	}
+	requestID := apiCallRequestID(ctx)
+	if requestID != "" {
+		logFields["request_id"] = requestID
+	}
 
 	tflog.Error(ctx, "SDK Error occurred (pre-mapping)", logFields)
 
@@ -427,6 +735,17 @@ func handleApiError(ctx context.Context, err error, operation string, resourceId
 	}
 
 	// --- Specific Error Mapping ---
+	// Authentication and authorization failures come first, so their response
+	// bodies are never substring-matched into the mappings below.
+	if strings.HasSuffix(operation, "Skill") && statusCode == http.StatusForbidden {
+		tflog.Warn(ctx, "Skill operation requires an admin service account.", logFields)
+		return fmt.Errorf("managing organizational Skills requires a service account with the admin role: %w", ErrPermissionDenied)
+	}
+	if statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden {
+		tflog.Warn(ctx, "Mapping SDK error to an access error based on status code.", logFields)
+		return accessDeniedError(operation, statusCode, requestID)
+	}
+
 	if operation == "CreateServiceAccount" && (statusCode == http.StatusConflict || strings.Contains(lowerErrStr, "conflict")) {
 		tflog.Warn(ctx, "Detected 409 Conflict during CreateServiceAccount, likely name collision.", logFields)
 		return fmt.Errorf("service account name '%s' was previously used or is currently in use. Please choose a different name", resourceId)
@@ -447,11 +766,6 @@ func handleApiError(ctx context.Context, err error, operation string, resourceId
 		return fmt.Errorf("cannot delete connected app '%s': it is referenced by one or more notification routes. Remove the references first", resourceId)
 	}
 
-	if strings.HasSuffix(operation, "Skill") && statusCode == http.StatusForbidden {
-		tflog.Warn(ctx, "Skill operation requires an admin service account.", logFields)
-		return fmt.Errorf("managing organizational Skills requires a service account with the admin role")
-	}
-
 	if (operation == "CreateSkill" || operation == "UpdateSkill") && (statusCode == http.StatusConflict || strings.Contains(lowerErrStr, "conflict")) {
 		tflog.Warn(ctx, "Detected Skill name conflict.", logFields)
 		return fmt.Errorf("organizational Skill name %q is already in use; choose a different name", resourceId)
@@ -489,12 +803,15 @@ func handleApiError(ctx context.Context, err error, operation string, resourceId
 		return ErrReadOnly
 	}
 
-	if operation == "UpdatePolicy" && (statusCode == http.StatusConflict || strings.Contains(lowerErrStr, "conflict")) {
-		tflog.Warn(ctx, "Mapping SDK error to ErrConcurrency based on status code or substring match (UpdatePolicy).", logFields)
+	if (operation == "UpdatePolicy" || operation == "UpdateRetentionPolicy") && (statusCode == http.StatusConflict || strings.Contains(lowerErrStr, "conflict")) {
+		tflog.Warn(ctx, fmt.Sprintf("Mapping SDK error to ErrConcurrency based on status code or substring match (%s).", operation), logFields)
 		return ErrConcurrency
 	}
 
 	// --- Generic Error Wrapping ---
 	tflog.Warn(ctx, "SDK error did not match specific mappings, wrapping original error.", logFields)
+	if requestID != "" {
+		return fmt.Errorf("%s failed (request ID %s): %w", operation, requestID, err)
+	}
 	return fmt.Errorf("%s failed: %w", operation, err)
 }
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+// accessObjectsRequiringAdmin are the objects of operations that manage
+// access itself, which only the admin role may change.
+var accessObjectsRequiringAdmin = []string{"Policy", "Policies", "ServiceAccount", "ServiceAccounts", "ApiKey", "ApiKeys", "TenantAISettings"}
+
+// accessDeniedError explains a 401 or 403 response to operation: which
+// credential was rejected, or which role the API key lacks.
+func accessDeniedError(operation string, statusCode int, requestID string) error {
+	requestNote := ""
+	if requestID != "" {
+		requestNote = fmt.Sprintf(", request ID %s", requestID)
+	}
+
+	if statusCode == http.StatusUnauthorized {
+		return fmt.Errorf("%w: %s returned 401 Unauthorized%s. Check that api_key (or GROUNDCOVER_API_KEY) is a valid API key that has not been revoked or expired, and that backend_id names the backend it was issued for",
+			ErrUnauthorized, operation, requestNote)
+	}
+
+	verb, object := splitOperation(operation)
+	access, role := "write", "write"
+	if verb == "Get" || verb == "List" {
+		access, role = "read", "read"
+	}
+	for _, adminObject := range accessObjectsRequiringAdmin {
+		if object == adminObject && access == "write" {
+			role = "admin"
+		}
+	}
+
+	subject := describeOperationObject(object)
+	if subject == "" {
+		subject = operation
+	}
+	return fmt.Errorf("%w: %s returned 403 Forbidden%s. The API key is valid, but its service account has no %s access to %s. Attach a policy with at least the %s role to the service account, or use an API key of a service account that has one",
+		ErrPermissionDenied, operation, requestNote, access, subject, role)
+}
+
+// splitOperation splits an operation name such as "CreateConnectedApp" into
+// its verb and object. The verb is empty for names without a known verb.
+func splitOperation(operation string) (verb, object string) {
+	for _, v := range []string{"Create", "Get", "List", "Update", "Delete"} {
+		if rest, ok := strings.CutPrefix(operation, v); ok && rest != "" {
+			return v, rest
+		}
+	}
+	return "", operation
+}
+
+// describeOperationObject turns an operation object such as "ConnectedApps"
+// into prose ("connected apps"), keeping acronyms such as "AI" upper case.
+func describeOperationObject(object string) string {
+	runes := []rune(object)
+	var words []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		lowerToUpper := unicode.IsLower(runes[i-1]) && unicode.IsUpper(runes[i])
+		acronymEnd := unicode.IsUpper(runes[i-1]) && unicode.IsUpper(runes[i]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if lowerToUpper || acronymEnd {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+
+	for i, word := range words {
+		if strings.ToUpper(word) != word {
+			words[i] = strings.ToLower(word)
+		}
+	}
+	return strings.Join(words, " ")
+}
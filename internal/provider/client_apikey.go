@@ -3,16 +3,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"sync"
 
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/client/apikeys"
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
 )
 
 // --- API Key Methods ---
 
 func (c *SdkClientWrapper) CreateApiKey(ctx context.Context, apiKeyReq *models.CreateAPIKeyRequest) (*models.CreateAPIKeyResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	var name string
 	if apiKeyReq != nil && apiKeyReq.Name != nil {
 		name = *apiKeyReq.Name
@@ -27,7 +33,7 @@ func (c *SdkClientWrapper) CreateApiKey(ctx context.Context, apiKeyReq *models.C
 
 	params := apikeys.NewCreateAPIKeyParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(apiKeyReq)
 
 	resp, err := c.sdkClient.Apikeys.CreateAPIKey(params, nil)
@@ -35,16 +41,20 @@ func (c *SdkClientWrapper) CreateApiKey(ctx context.Context, apiKeyReq *models.C
 		return nil, handleApiError(ctx, err, "CreateApiKey", name)
 	}
 
+	c.apiKeys.invalidate()
 	tflog.Debug(ctx, "SDK Call Successful: Create API Key", map[string]any{"id": resp.Payload.ID})
 	return resp.Payload, nil
 }
 
 func (c *SdkClientWrapper) ListApiKeys(ctx context.Context, withRevoked *bool, withExpired *bool) ([]*models.ListAPIKeysResponseItem, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: List API Keys", map[string]any{"withRevoked": withRevoked, "withExpired": withExpired})
 
 	params := apikeys.NewListAPIKeysParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithWithRevoked(withRevoked).
 		WithWithExpired(withExpired)
 
@@ -57,12 +67,79 @@ func (c *SdkClientWrapper) ListApiKeys(ctx context.Context, withRevoked *bool, w
 	return resp.Payload, nil
 }
 
+// apiKeyIndex holds the API keys of one list call, revoked and expired keys
+// included, keyed by ID. The API has no endpoint that reads a single key, so
+// GetApiKey serves every key read of a run from one list instead of listing
+// the keys once per key. Concurrent reads share one list call, made without
+// holding mu. The index is dropped on any API key write and on service account
+// deletion, which removes the account's keys.
+type apiKeyIndex struct {
+	mu   sync.Mutex
+	keys map[string]*models.ListAPIKeysResponseItem
+	// generation counts invalidations, so a list that started before one is
+	// neither stored nor shared with the reads that follow it.
+	generation uint64
+	lists      singleflight.Group
+}
+
+func (i *apiKeyIndex) invalidate() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.keys = nil
+	i.generation++
+}
+
+// GetApiKey returns the API key with the given ID, revoked and expired keys
+// included, or ErrNotFound. The keys are listed at most once between
+// invalidations, so a key missing from the index is not found.
+func (c *SdkClientWrapper) GetApiKey(ctx context.Context, id string) (*models.ListAPIKeysResponseItem, error) {
+	c.apiKeys.mu.Lock()
+	keys, generation := c.apiKeys.keys, c.apiKeys.generation
+	c.apiKeys.mu.Unlock()
+
+	if keys != nil {
+		tflog.Debug(ctx, "Serving API key from the API key index", map[string]any{"id": id})
+	} else {
+		listed, err, _ := c.apiKeys.lists.Do(strconv.FormatUint(generation, 10), func() (any, error) {
+			withRevoked, withExpired := true, true
+			list, err := c.ListApiKeys(ctx, &withRevoked, &withExpired)
+			if err != nil {
+				return nil, err
+			}
+			index := make(map[string]*models.ListAPIKeysResponseItem, len(list))
+			for _, key := range list {
+				if key != nil {
+					index[key.ID] = key
+				}
+			}
+			c.apiKeys.mu.Lock()
+			if c.apiKeys.generation == generation {
+				c.apiKeys.keys = index
+			}
+			c.apiKeys.mu.Unlock()
+			return index, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		keys = listed.(map[string]*models.ListAPIKeysResponseItem)
+	}
+
+	if key, ok := keys[id]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("API key %s: %w", id, ErrNotFound)
+}
+
 func (c *SdkClientWrapper) DeleteApiKey(ctx context.Context, id string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: Delete API Key", map[string]any{"id": id})
 
 	params := apikeys.NewDeleteAPIKeyParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	_, err := c.sdkClient.Apikeys.DeleteAPIKey(params, nil)
@@ -70,6 +147,7 @@ func (c *SdkClientWrapper) DeleteApiKey(ctx context.Context, id string) error {
 		return handleApiError(ctx, err, "DeleteApiKey", id)
 	}
 
+	c.apiKeys.invalidate()
 	tflog.Debug(ctx, "SDK Call Successful: Delete API Key", map[string]any{"id": id})
 	return nil
 }
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/client/k8s"
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func (c *SdkClientWrapper) ListClusters(ctx context.Context) ([]*models.ClustersListResult, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	tflog.Debug(ctx, "Executing SDK Call: List Clusters")
+
+	params := k8s.NewClustersListParamsWithContext(ctx).
+		WithTimeout(c.timeout()).
+		WithBody(&models.ClustersListRequest{Sources: []*models.Condition{}})
+
+	resp, err := c.sdkClient.K8s.ClustersList(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "ListClusters", "")
+	}
+
+	if resp == nil || resp.Payload == nil {
+		tflog.Warn(ctx, "ListClusters response payload was nil")
+		return nil, nil
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: List Clusters", map[string]any{"count": len(resp.Payload.Clusters)})
+	return resp.Payload.Clusters, nil
+}
@@ -14,6 +14,9 @@ import (
 )
 
 func (c *SdkClientWrapper) CreateConnectedApp(ctx context.Context, req *models.CreateConnectedAppRequest) (*models.CreateConnectedAppResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	identifier := "<unknown>"
 	if req.Name != nil && *req.Name != "" {
 		identifier = *req.Name
@@ -22,7 +25,7 @@ func (c *SdkClientWrapper) CreateConnectedApp(ctx context.Context, req *models.C
 	tflog.Debug(ctx, "Executing SDK Call: Create Connected App", logFields)
 
 	params := connected_apps.NewCreateConnectedAppParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(req)
 
 	resp, err := c.sdkClient.ConnectedApps.CreateConnectedApp(params, nil)
@@ -46,11 +49,14 @@ func (c *SdkClientWrapper) CreateConnectedApp(ctx context.Context, req *models.C
 }
 
 func (c *SdkClientWrapper) GetConnectedApp(ctx context.Context, id string) (*models.ConnectedAppResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Get Connected App", logFields)
 
 	params := connected_apps.NewGetConnectedAppParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	resp, err := c.sdkClient.ConnectedApps.GetConnectedApp(params, nil)
@@ -67,12 +73,39 @@ func (c *SdkClientWrapper) GetConnectedApp(ctx context.Context, id string) (*mod
 	return resp.Payload, nil
 }
 
+func (c *SdkClientWrapper) ListConnectedApps(ctx context.Context, req *models.ListConnectedAppsRequest) ([]*models.ConnectedAppListItemWithRoutesResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	tflog.Debug(ctx, "Executing SDK Call: List Connected Apps", map[string]any{"request": req})
+
+	params := connected_apps.NewListConnectedAppsParamsWithContext(ctx).
+		WithTimeout(c.timeout()).
+		WithBody(req)
+
+	resp, err := c.sdkClient.ConnectedApps.ListConnectedApps(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "ListConnectedApps", "")
+	}
+
+	if resp == nil || resp.Payload == nil {
+		tflog.Debug(ctx, "SDK Call Successful: List Connected Apps", map[string]any{"count": 0})
+		return nil, nil
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: List Connected Apps", map[string]any{"count": len(resp.Payload.ConnectedApps)})
+	return resp.Payload.ConnectedApps, nil
+}
+
 func (c *SdkClientWrapper) UpdateConnectedApp(ctx context.Context, id string, req *models.UpdateConnectedAppRequest) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Update Connected App", logFields)
 
 	params := connected_apps.NewUpdateConnectedAppParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id).
 		WithBody(req)
 
@@ -86,11 +119,14 @@ func (c *SdkClientWrapper) UpdateConnectedApp(ctx context.Context, id string, re
 }
 
 func (c *SdkClientWrapper) DeleteConnectedApp(ctx context.Context, id string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Connected App", logFields)
 
 	params := connected_apps.NewDeleteConnectedAppParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	_, err := c.sdkClient.ConnectedApps.DeleteConnectedApp(params, nil)
@@ -9,6 +9,9 @@ import (
 )
 
 func (c *SdkClientWrapper) CreateDashboard(ctx context.Context, dashboard *models.CreateDashboardRequest) (*models.View, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: Create Dashboard", map[string]any{"name": dashboard.Name})
 
 	tflog.Debug(ctx, "Sending CreateDashboardRequest to SDK", map[string]any{
@@ -20,7 +23,7 @@ func (c *SdkClientWrapper) CreateDashboard(ctx context.Context, dashboard *model
 
 	params := dashboards.NewCreateDashboardParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(dashboard)
 
 	resp, err := c.sdkClient.Dashboards.CreateDashboard(params, nil)
@@ -33,11 +36,14 @@ func (c *SdkClientWrapper) CreateDashboard(ctx context.Context, dashboard *model
 }
 
 func (c *SdkClientWrapper) GetDashboard(ctx context.Context, uuid string) (*models.View, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: Get Dashboard", map[string]any{"uuid": uuid})
 
 	params := dashboards.NewGetDashboardParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(uuid)
 
 	resp, err := c.sdkClient.Dashboards.GetDashboard(params, nil)
@@ -49,7 +55,29 @@ func (c *SdkClientWrapper) GetDashboard(ctx context.Context, uuid string) (*mode
 	return resp.Payload, nil
 }
 
+func (c *SdkClientWrapper) ListDashboards(ctx context.Context) ([]*models.View, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	tflog.Debug(ctx, "Executing SDK Call: List Dashboards")
+
+	params := dashboards.NewGetDashboardsParams().
+		WithContext(ctx).
+		WithTimeout(c.timeout())
+
+	resp, err := c.sdkClient.Dashboards.GetDashboards(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "GetDashboards", "")
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: List Dashboards", map[string]any{"count": len(resp.Payload)})
+	return resp.Payload, nil
+}
+
 func (c *SdkClientWrapper) UpdateDashboard(ctx context.Context, uuid string, dashboard *models.UpdateDashboardRequest) (*models.View, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: Update Dashboard", map[string]any{"uuid": uuid})
 
 	tflog.Debug(ctx, "Sending UpdateDashboardRequest to SDK", map[string]any{
@@ -65,7 +93,7 @@ func (c *SdkClientWrapper) UpdateDashboard(ctx context.Context, uuid string, das
 
 	params := dashboards.NewUpdateDashboardParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(uuid).
 		WithBody(dashboard)
 
@@ -79,11 +107,14 @@ func (c *SdkClientWrapper) UpdateDashboard(ctx context.Context, uuid string, das
 }
 
 func (c *SdkClientWrapper) DeleteDashboard(ctx context.Context, uuid string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: Delete Dashboard", map[string]any{"uuid": uuid})
 
 	params := dashboards.NewDeleteDashboardParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(uuid)
 
 	_, err := c.sdkClient.Dashboards.DeleteDashboard(params, nil)
@@ -14,6 +14,9 @@ const (
 
 // CreateDataIntegration creates a new data integration configuration
 func (c *SdkClientWrapper) CreateDataIntegration(ctx context.Context, integrationType string, req *models.CreateDataIntegrationConfigRequest) (*models.DataIntegrationConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "create_data_integration", "type": integrationType}
 	tflog.Debug(ctx, "Executing SDK Call: Create DataIntegration", logFields)
 
@@ -29,6 +32,9 @@ func (c *SdkClientWrapper) CreateDataIntegration(ctx context.Context, integratio
 
 // GetDataIntegration retrieves a data integration configuration by type and ID
 func (c *SdkClientWrapper) GetDataIntegration(ctx context.Context, integrationType string, id string) (*models.DataIntegrationConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "get_data_integration", "id": id, "type": integrationType}
 	tflog.Debug(ctx, "Executing SDK Call: Get DataIntegration", logFields)
 
@@ -44,6 +50,9 @@ func (c *SdkClientWrapper) GetDataIntegration(ctx context.Context, integrationTy
 
 // UpdateDataIntegration updates an existing data integration configuration
 func (c *SdkClientWrapper) UpdateDataIntegration(ctx context.Context, integrationType string, id string, req *models.CreateDataIntegrationConfigRequest) (*models.DataIntegrationConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "update_data_integration", "id": id, "type": integrationType}
 	tflog.Debug(ctx, "Executing SDK Call: Update DataIntegration", logFields)
 
@@ -59,6 +68,9 @@ func (c *SdkClientWrapper) UpdateDataIntegration(ctx context.Context, integratio
 
 // DeleteDataIntegration deletes a data integration configuration by type and ID
 func (c *SdkClientWrapper) DeleteDataIntegration(ctx context.Context, integrationType string, id string, cluster *string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "delete_data_integration", "id": id, "type": integrationType}
 	if cluster != nil {
 		logFields["cluster"] = *cluster
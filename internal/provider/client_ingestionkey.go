@@ -13,6 +13,9 @@ import (
 
 // --- API Key Methods ---
 func (c *SdkClientWrapper) CreateIngestionKey(ctx context.Context, req *models.CreateIngestionKeyRequest) (*models.IngestionKeyResult, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	var name string
 	if req != nil && req.Name != nil {
 		name = *req.Name
@@ -27,7 +30,7 @@ func (c *SdkClientWrapper) CreateIngestionKey(ctx context.Context, req *models.C
 
 	params := ingestionkeys.NewCreateIngestionKeyParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(req)
 
 	resp, err := c.sdkClient.Ingestionkeys.CreateIngestionKey(params, nil)
@@ -40,11 +43,14 @@ func (c *SdkClientWrapper) CreateIngestionKey(ctx context.Context, req *models.C
 }
 
 func (c *SdkClientWrapper) ListIngestionKeys(ctx context.Context, req *models.ListIngestionKeysRequest) ([]*models.IngestionKeyResult, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: List Ingestion Keys", map[string]any{"request": req})
 
 	params := ingestionkeys.NewListIngestionKeysParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(req)
 
 	resp, err := c.sdkClient.Ingestionkeys.ListIngestionKeys(params, nil)
@@ -57,6 +63,9 @@ func (c *SdkClientWrapper) ListIngestionKeys(ctx context.Context, req *models.Li
 }
 
 func (c *SdkClientWrapper) DeleteIngestionKey(ctx context.Context, req *models.DeleteIngestionKeyRequest) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	var name string
 	if req != nil && req.Name != nil {
 		name = *req.Name
@@ -69,7 +78,7 @@ func (c *SdkClientWrapper) DeleteIngestionKey(ctx context.Context, req *models.D
 
 	params := ingestionkeys.NewDeleteIngestionKeyParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(req)
 
 	if _, err := c.sdkClient.Ingestionkeys.DeleteIngestionKey(params, nil); err != nil {
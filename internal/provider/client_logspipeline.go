@@ -15,6 +15,9 @@ const (
 
 // CreateLogsPipeline creates a new logs pipeline configuration
 func (c *SdkClientWrapper) CreateLogsPipeline(ctx context.Context, req *models.CreateOrUpdateLogsPipelineConfigRequest) (*models.LogsPipelineConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "create_logs_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Create Logs Pipeline", logFields)
 
@@ -30,6 +33,9 @@ func (c *SdkClientWrapper) CreateLogsPipeline(ctx context.Context, req *models.C
 
 // GetLogsPipeline retrieves a logs pipeline configuration by key
 func (c *SdkClientWrapper) GetLogsPipeline(ctx context.Context) (*models.LogsPipelineConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "get_logs_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Get Logs Pipeline", logFields)
 
@@ -50,6 +56,9 @@ func (c *SdkClientWrapper) GetLogsPipeline(ctx context.Context) (*models.LogsPip
 
 // UpdateLogsPipeline updates an existing logs pipeline configuration
 func (c *SdkClientWrapper) UpdateLogsPipeline(ctx context.Context, req *models.CreateOrUpdateLogsPipelineConfigRequest) (*models.LogsPipelineConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "update_logs_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Update Logs Pipeline", logFields)
 
@@ -65,6 +74,9 @@ func (c *SdkClientWrapper) UpdateLogsPipeline(ctx context.Context, req *models.C
 
 // DeleteLogsPipeline deletes a logs pipeline configuration by key
 func (c *SdkClientWrapper) DeleteLogsPipeline(ctx context.Context) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "delete_logs_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Logs Pipeline", logFields)
 
@@ -14,6 +14,9 @@ const (
 
 // CreateMetricsAggregation creates a new metrics aggregation configuration
 func (c *SdkClientWrapper) CreateMetricsAggregation(ctx context.Context, req *models.CreateOrUpdateMetricsAggregatorConfigRequest) (*models.MetricsAggregatorConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "create_metrics_aggregation"}
 	tflog.Debug(ctx, "Executing SDK Call: Create Metrics Aggregation", logFields)
 
@@ -29,6 +32,9 @@ func (c *SdkClientWrapper) CreateMetricsAggregation(ctx context.Context, req *mo
 
 // GetMetricsAggregation retrieves the metrics aggregation configuration
 func (c *SdkClientWrapper) GetMetricsAggregation(ctx context.Context) (*models.MetricsAggregatorConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "get_metrics_aggregation"}
 	tflog.Debug(ctx, "Executing SDK Call: Get Metrics Aggregation", logFields)
 
@@ -49,6 +55,9 @@ func (c *SdkClientWrapper) GetMetricsAggregation(ctx context.Context) (*models.M
 
 // UpdateMetricsAggregation updates an existing metrics aggregation configuration
 func (c *SdkClientWrapper) UpdateMetricsAggregation(ctx context.Context, req *models.CreateOrUpdateMetricsAggregatorConfigRequest) (*models.MetricsAggregatorConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "update_metrics_aggregation"}
 	tflog.Debug(ctx, "Executing SDK Call: Update Metrics Aggregation", logFields)
 
@@ -64,6 +73,9 @@ func (c *SdkClientWrapper) UpdateMetricsAggregation(ctx context.Context, req *mo
 
 // DeleteMetricsAggregation deletes the metrics aggregation configuration
 func (c *SdkClientWrapper) DeleteMetricsAggregation(ctx context.Context) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "delete_metrics_aggregation"}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Metrics Aggregation", logFields)
 
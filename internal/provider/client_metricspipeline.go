@@ -13,6 +13,9 @@ const (
 )
 
 func (c *SdkClientWrapper) CreateMetricsPipeline(ctx context.Context, req *models.CreateOrUpdateMetricsPipelineConfigRequest) (*models.MetricsPipelineConfigInfo, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "create_metrics_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Create Metrics Pipeline", logFields)
 
@@ -27,6 +30,9 @@ func (c *SdkClientWrapper) CreateMetricsPipeline(ctx context.Context, req *model
 }
 
 func (c *SdkClientWrapper) GetMetricsPipeline(ctx context.Context) (*models.MetricsPipelineConfigInfo, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "get_metrics_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Get Metrics Pipeline", logFields)
 
@@ -46,6 +52,9 @@ func (c *SdkClientWrapper) GetMetricsPipeline(ctx context.Context) (*models.Metr
 }
 
 func (c *SdkClientWrapper) UpdateMetricsPipeline(ctx context.Context, req *models.CreateOrUpdateMetricsPipelineConfigRequest) (*models.MetricsPipelineConfigInfo, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "update_metrics_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Update Metrics Pipeline", logFields)
 
@@ -60,6 +69,9 @@ func (c *SdkClientWrapper) UpdateMetricsPipeline(ctx context.Context, req *model
 }
 
 func (c *SdkClientWrapper) DeleteMetricsPipeline(ctx context.Context) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "delete_metrics_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Metrics Pipeline", logFields)
 
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/client/monitors"
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
@@ -10,6 +11,9 @@ import (
 )
 
 func (c *SdkClientWrapper) CreateMonitor(ctx context.Context, monitorReq *models.CreateMonitorRequest) (*models.CreateMonitorResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	identifier := "<unknown_monitor>"
 	if monitorReq != nil && monitorReq.Title != nil {
 		identifier = *monitorReq.Title
@@ -23,7 +27,7 @@ func (c *SdkClientWrapper) CreateMonitor(ctx context.Context, monitorReq *models
 
 	params := monitors.NewCreateMonitorParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(monitorReq)
 
 	resp, err := c.sdkClient.Monitors.CreateMonitor(params, nil, monitors.WithContentTypeApplicationxYaml)
@@ -49,13 +53,148 @@ func (c *SdkClientWrapper) CreateMonitor(ctx context.Context, monitorReq *models
 	return resp.Payload, nil
 }
 
+// monitorPrefetchConcurrency bounds the parallel GETs issued while prefetching monitor YAML.
+const monitorPrefetchConcurrency = 10
+
+// monitorPrefetchCache holds monitor YAML fetched in bulk on the first monitor
+// refresh of a provider run. The monitors API has no list-with-YAML endpoint, so
+// the prefetch lists every monitor in the workspace, including those Terraform
+// does not manage, and fans out the GETs in parallel while the refresh reads that
+// arrive meanwhile wait for it. That pays off only when the configuration manages
+// a large share of the workspace's monitors; a small configuration, or a -target
+// run, in a large workspace is slower with it. Entries are handed out once and
+// dropped on any write, so a cached body is never served after it may be stale.
+type monitorPrefetchCache struct {
+	once    sync.Once
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+func (p *monitorPrefetchCache) take(id string) ([]byte, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	yamlBytes, ok := p.entries[id]
+	if ok {
+		delete(p.entries, id)
+	}
+	return yamlBytes, ok
+}
+
+func (p *monitorPrefetchCache) invalidate(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.entries, id)
+}
+
+// prefetchMonitors fills the prefetch cache with the YAML of every monitor. Failures
+// are logged and skipped; monitors missing from the cache fall back to a regular GET.
+func (c *SdkClientWrapper) prefetchMonitors(ctx context.Context) {
+	items, err := c.ListMonitors(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Monitor prefetch: failed to list monitors, falling back to per-monitor reads", map[string]any{"error": err.Error()})
+		return
+	}
+
+	entries := make(map[string][]byte, len(items))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, monitorPrefetchConcurrency)
+	for _, item := range items {
+		if item == nil || item.UUID == "" {
+			continue
+		}
+		id := item.UUID.String()
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			yamlBytes, err := c.getMonitorYaml(ctx, id)
+			if err != nil {
+				tflog.Debug(ctx, "Monitor prefetch: skipping monitor", map[string]any{"id": id, "error": err.Error()})
+				return
+			}
+			mu.Lock()
+			entries[id] = yamlBytes
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	c.monitorPrefetch.mu.Lock()
+	c.monitorPrefetch.entries = entries
+	c.monitorPrefetch.mu.Unlock()
+	tflog.Info(ctx, "Monitor prefetch complete", map[string]any{"listed": len(items), "cached": len(entries)})
+}
+
+// ListMonitors returns every monitor visible to the API key, following pagination.
+func (c *SdkClientWrapper) ListMonitors(ctx context.Context) ([]*models.MonitorListItem, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	tflog.Debug(ctx, "Executing SDK Call: List Monitors")
+
+	const pageSize = 1000
+	var all []*models.MonitorListItem
+	for skip := int64(0); ; skip += pageSize {
+		params := monitors.NewListMonitorsParams().
+			WithContext(ctx).
+			WithTimeout(c.timeout()).
+			WithBody(&models.MonitorListRequest{Limit: pageSize, Skip: skip})
+
+		resp, err := c.sdkClient.Monitors.ListMonitors(params, nil)
+		if err != nil {
+			return nil, handleApiError(ctx, err, "ListMonitors", "")
+		}
+		if resp == nil || resp.Payload == nil {
+			break
+		}
+		all = append(all, resp.Payload.Monitors...)
+		if resp.Payload.Done || len(resp.Payload.Monitors) < pageSize {
+			break
+		}
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: List Monitors", map[string]any{"count": len(all)})
+	return all, nil
+}
+
+type monitorRefreshKey struct{}
+
+// withMonitorRefresh marks ctx as the refresh of a monitor resource, the only
+// read that starts, or is served from, the monitor prefetch. Reads made while
+// creating or updating a monitor, and by data sources, fetch just the monitor
+// they need.
+func withMonitorRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, monitorRefreshKey{}, true)
+}
+
+func isMonitorRefresh(ctx context.Context) bool {
+	refresh, _ := ctx.Value(monitorRefreshKey{}).(bool)
+	return refresh
+}
+
 func (c *SdkClientWrapper) GetMonitor(ctx context.Context, id string) ([]byte, error) {
+	if c.monitorPrefetch != nil && isMonitorRefresh(ctx) {
+		c.monitorPrefetch.once.Do(func() { c.prefetchMonitors(ctx) })
+		if yamlBytes, ok := c.monitorPrefetch.take(id); ok {
+			tflog.Debug(ctx, "Serving monitor YAML from prefetch cache", map[string]any{"id": id})
+			return yamlBytes, nil
+		}
+	}
+	return c.getMonitorYaml(ctx, id)
+}
+
+func (c *SdkClientWrapper) getMonitorYaml(ctx context.Context, id string) ([]byte, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Get Monitor YAML", logFields)
 
 	params := monitors.NewGetMonitorParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	resp, err := c.sdkClient.Monitors.GetMonitor(params, nil)
@@ -81,6 +220,9 @@ func (c *SdkClientWrapper) GetMonitor(ctx context.Context, id string) ([]byte, e
 }
 
 func (c *SdkClientWrapper) UpdateMonitor(ctx context.Context, id string, monitorReq *models.UpdateMonitorRequest) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	identifier := "<unknown_monitor>"
 	if monitorReq != nil && monitorReq.Title != nil {
 		identifier = *monitorReq.Title
@@ -88,9 +230,13 @@ func (c *SdkClientWrapper) UpdateMonitor(ctx context.Context, id string, monitor
 	logFields := map[string]any{"id": id, "title": identifier}
 	tflog.Debug(ctx, "Executing SDK Call: Update Monitor", logFields)
 
+	if c.monitorPrefetch != nil {
+		c.monitorPrefetch.invalidate(id)
+	}
+
 	params := monitors.NewUpdateMonitorParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id).
 		WithBody(monitorReq)
 
@@ -104,12 +250,19 @@ func (c *SdkClientWrapper) UpdateMonitor(ctx context.Context, id string, monitor
 }
 
 func (c *SdkClientWrapper) DeleteMonitor(ctx context.Context, id string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Monitor", logFields)
 
+	if c.monitorPrefetch != nil {
+		c.monitorPrefetch.invalidate(id)
+	}
+
 	params := monitors.NewDeleteMonitorParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	_, err := c.sdkClient.Monitors.DeleteMonitor(params, nil)
@@ -14,6 +14,9 @@ import (
 )
 
 func (c *SdkClientWrapper) CreateNotificationRoute(ctx context.Context, req *models.CreateNotificationRouteRequest) (*models.CreateNotificationRouteResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	identifier := "<unknown>"
 	if req.Name != nil && *req.Name != "" {
 		identifier = *req.Name
@@ -22,7 +25,7 @@ func (c *SdkClientWrapper) CreateNotificationRoute(ctx context.Context, req *mod
 	tflog.Debug(ctx, "Executing SDK Call: Create Notification Route", logFields)
 
 	params := notification_routes.NewCreateNotificationRouteParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(req)
 
 	resp, err := c.sdkClient.NotificationRoutes.CreateNotificationRoute(params, nil)
@@ -46,11 +49,14 @@ func (c *SdkClientWrapper) CreateNotificationRoute(ctx context.Context, req *mod
 }
 
 func (c *SdkClientWrapper) GetNotificationRoute(ctx context.Context, id string) (*models.NotificationRouteResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Get Notification Route", logFields)
 
 	params := notification_routes.NewGetNotificationRouteParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	resp, err := c.sdkClient.NotificationRoutes.GetNotificationRoute(params, nil)
@@ -67,12 +73,39 @@ func (c *SdkClientWrapper) GetNotificationRoute(ctx context.Context, id string)
 	return resp.Payload, nil
 }
 
+func (c *SdkClientWrapper) ListNotificationRoutes(ctx context.Context) ([]*models.NotificationRouteListItemResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	tflog.Debug(ctx, "Executing SDK Call: List Notification Routes")
+
+	params := notification_routes.NewListNotificationRoutesParamsWithContext(ctx).
+		WithTimeout(c.timeout()).
+		WithBody(&models.ListNotificationRoutesRequest{})
+
+	resp, err := c.sdkClient.NotificationRoutes.ListNotificationRoutes(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "ListNotificationRoutes", "")
+	}
+
+	if resp == nil || resp.Payload == nil {
+		tflog.Warn(ctx, "ListNotificationRoutes response payload was nil")
+		return nil, nil
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: List Notification Routes", map[string]any{"count": len(resp.Payload.NotificationRoutes)})
+	return resp.Payload.NotificationRoutes, nil
+}
+
 func (c *SdkClientWrapper) UpdateNotificationRoute(ctx context.Context, id string, req *models.UpdateNotificationRouteRequest) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Update Notification Route", logFields)
 
 	params := notification_routes.NewUpdateNotificationRouteParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id).
 		WithBody(req)
 
@@ -86,11 +119,14 @@ func (c *SdkClientWrapper) UpdateNotificationRoute(ctx context.Context, id strin
 }
 
 func (c *SdkClientWrapper) DeleteNotificationRoute(ctx context.Context, id string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Notification Route", logFields)
 
 	params := notification_routes.NewDeleteNotificationRouteParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	_, err := c.sdkClient.NotificationRoutes.DeleteNotificationRoute(params, nil)
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+)
+
+// ErrProviderReadOnly is returned for every API call that could change the
+// backend while the provider's read_only setting is enabled.
+var ErrProviderReadOnly = errors.New("the provider is configured with read_only = true (or GROUNDCOVER_READ_ONLY), which blocks every change to groundcover; unset it to create, update, or delete resources")
+
+// readPostOperations are the SDK operations sent as POST that only read data,
+// such as list and search endpoints that take their filters in the body.
+var readPostOperations = map[string]bool{
+	"clustersList":           true,
+	"eventsSearch":           true,
+	"getDiscovery":           true,
+	"getEventsOverTime":      true,
+	"getKeys":                true,
+	"getMetricKeys":          true,
+	"getMetricNames":         true,
+	"getMetricValues":        true,
+	"getValues":              true,
+	"listConnectedApps":      true,
+	"listIngestionKeys":      true,
+	"listMonitors":           true,
+	"listNotificationRoutes": true,
+	"listWorkflows":          true,
+	"metricsQuery":           true,
+	"searchLogs":             true,
+	"searchTraces":           true,
+	"workloadsList":          true,
+}
+
+// readOnlyClientTransport fails every operation that is not known to be a
+// read without sending it, so no Create, Update, or Delete reaches the API.
+// Unknown POST operations are treated as writes.
+type readOnlyClientTransport struct {
+	transport runtime.ClientTransport
+}
+
+func (t *readOnlyClientTransport) Submit(op *runtime.ClientOperation) (any, error) {
+	if !isReadOperation(op) {
+		return nil, fmt.Errorf("%w (blocked %s %s)", ErrProviderReadOnly, op.Method, op.PathPattern)
+	}
+	return t.transport.Submit(op)
+}
+
+// isReadOperation reports whether op only reads data.
+func isReadOperation(op *runtime.ClientOperation) bool {
+	switch op.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		return readPostOperations[op.ID]
+	default:
+		return false
+	}
+}
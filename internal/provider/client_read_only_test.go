@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+)
+
+type countingClientTransport struct {
+	submitted []string
+}
+
+func (t *countingClientTransport) Submit(op *runtime.ClientOperation) (any, error) {
+	t.submitted = append(t.submitted, op.ID)
+	return nil, nil
+}
+
+func TestReadOnlyClientTransport(t *testing.T) {
+	tests := []struct {
+		id      string
+		method  string
+		allowed bool
+	}{
+		{id: "getMonitor", method: "GET", allowed: true},
+		{id: "listMonitors", method: "POST", allowed: true},
+		{id: "listIngestionKeys", method: "POST", allowed: true},
+		{id: "createMonitor", method: "POST"},
+		{id: "archiveDashboard", method: "POST"},
+		{id: "unknownOperation", method: "POST"},
+		{id: "updateMonitor", method: "PUT"},
+		{id: "patchPolicy", method: "PATCH"},
+		{id: "deleteMonitor", method: "DELETE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			inner := &countingClientTransport{}
+			transport := &readOnlyClientTransport{transport: inner}
+
+			_, err := transport.Submit(&runtime.ClientOperation{ID: tt.id, Method: tt.method, PathPattern: "/api/x"})
+			if tt.allowed {
+				if err != nil || len(inner.submitted) != 1 {
+					t.Fatalf("Submit() error = %v, submitted %v; want the operation sent", err, inner.submitted)
+				}
+				return
+			}
+			if !errors.Is(err, ErrProviderReadOnly) || len(inner.submitted) != 0 {
+				t.Fatalf("Submit() error = %v, submitted %v; want ErrProviderReadOnly and nothing sent", err, inner.submitted)
+			}
+			if mapped := handleApiError(context.Background(), err, "CreateMonitor", "m"); !errors.Is(mapped, ErrProviderReadOnly) {
+				t.Fatalf("handleApiError() = %v, want ErrProviderReadOnly", mapped)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// readRetryCount is how many times a failed GET call is repeated after
+	// the HTTP layer has given up on it.
+	readRetryCount = 2
+	readRetryWait  = 5 * time.Second
+)
+
+// readRetryClientTransport repeats GET operations that fail with a server
+// error or a dropped connection. The HTTP retry layers already retry single
+// responses such as 503s; this layer sits above them and the response
+// decoding, so an outage that outlasts those retries, or a connection reset
+// that they do not retry, still gets another attempt before a refresh fails.
+// Only GETs are repeated, since they have no side effects.
+type readRetryClientTransport struct {
+	transport  runtime.ClientTransport
+	maxRetries int
+	wait       time.Duration
+}
+
+func (t *readRetryClientTransport) Submit(op *runtime.ClientOperation) (any, error) {
+	if op.Method != http.MethodGet {
+		return t.transport.Submit(op)
+	}
+
+	ctx := op.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for attempt := 0; ; attempt++ {
+		result, err := t.transport.Submit(op)
+		if err == nil || attempt == t.maxRetries || !isTransientReadError(err) {
+			return result, err
+		}
+
+		delay := t.wait * time.Duration(attempt+1)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return result, err
+		}
+		tflog.Warn(ctx, "GET request failed with a transient error, retrying", map[string]any{
+			"operation": op.ID,
+			"attempt":   attempt + 1,
+			"error":     err.Error(),
+		})
+		select {
+		case <-ctx.Done():
+			return result, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// isTransientReadError reports whether err is a 5xx response or a network
+// failure, as opposed to a client error or a cancelled call.
+func isTransientReadError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var serverErr interface{ IsServerError() bool }
+	if errors.As(err, &serverErr) {
+		return serverErr.IsServerError()
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
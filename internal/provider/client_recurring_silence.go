@@ -14,11 +14,14 @@ import (
 )
 
 func (c *SdkClientWrapper) CreateRecurringSilence(ctx context.Context, req *models.V2CreateSilenceRequest) (*models.V2SilenceResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: Create Recurring Silence")
 
 	params := monitors.NewV2CreateSilenceParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(req)
 
 	resp, err := c.sdkClient.Monitors.V2CreateSilence(params, nil)
@@ -39,6 +42,9 @@ func (c *SdkClientWrapper) CreateRecurringSilence(ctx context.Context, req *mode
 }
 
 func (c *SdkClientWrapper) GetRecurringSilence(ctx context.Context, id string) (*models.V2SilenceResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Get Recurring Silence", logFields)
 
@@ -51,7 +57,7 @@ func (c *SdkClientWrapper) GetRecurringSilence(ctx context.Context, id string) (
 
 	params := monitors.NewV2GetSilenceParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	resp, err := c.sdkClient.Monitors.V2GetSilence(params, nil)
@@ -68,12 +74,15 @@ func (c *SdkClientWrapper) GetRecurringSilence(ctx context.Context, id string) (
 }
 
 func (c *SdkClientWrapper) UpdateRecurringSilence(ctx context.Context, id string, req *models.V2UpdateSilenceRequest) (*models.V2SilenceResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Update Recurring Silence", logFields)
 
 	params := monitors.NewV2UpdateSilenceParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id).
 		WithBody(req)
 
@@ -91,12 +100,15 @@ func (c *SdkClientWrapper) UpdateRecurringSilence(ctx context.Context, id string
 }
 
 func (c *SdkClientWrapper) DeleteRecurringSilence(ctx context.Context, id string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Recurring Silence", logFields)
 
 	params := monitors.NewV2DeleteSilenceParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	_, err := c.sdkClient.Monitors.V2DeleteSilence(params, nil)
@@ -11,12 +11,15 @@ import (
 )
 
 func (c *SdkClientWrapper) CreatePolicy(ctx context.Context, policyReq *models.CreatePolicyRequest) (*models.Policy, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"name": policyReq.Name}
 	tflog.Debug(ctx, "Executing SDK Call: Create Policy", logFields)
 
 	params := policies.NewCreatePolicyParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(policyReq)
 
 	resp, err := c.sdkClient.Policies.CreatePolicy(params, nil)
@@ -29,12 +32,15 @@ func (c *SdkClientWrapper) CreatePolicy(ctx context.Context, policyReq *models.C
 }
 
 func (c *SdkClientWrapper) GetPolicy(ctx context.Context, uuid string) (*models.Policy, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"uuid": uuid}
 	tflog.Debug(ctx, "Executing SDK Call: Get Policy", logFields)
 
 	params := policies.NewGetPolicyParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(uuid)
 
 	resp, err := c.sdkClient.Policies.GetPolicy(params, nil)
@@ -46,13 +52,35 @@ func (c *SdkClientWrapper) GetPolicy(ctx context.Context, uuid string) (*models.
 	return resp.Payload, nil
 }
 
+func (c *SdkClientWrapper) ListPolicies(ctx context.Context) ([]*models.PolicyWithEntityCount, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	tflog.Debug(ctx, "Executing SDK Call: List Policies")
+
+	params := policies.NewListPoliciesParams().
+		WithContext(ctx).
+		WithTimeout(c.timeout())
+
+	resp, err := c.sdkClient.Policies.ListPolicies(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "ListPolicies", "")
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: List Policies", map[string]any{"count": len(resp.Payload)})
+	return resp.Payload, nil
+}
+
 func (c *SdkClientWrapper) UpdatePolicy(ctx context.Context, uuid string, policyReq *models.UpdatePolicyRequest) (*models.Policy, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"uuid": uuid, "revision": policyReq.CurrentRevision}
 	tflog.Debug(ctx, "Executing SDK Call: Update Policy", logFields)
 
 	params := policies.NewUpdatePolicyParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(uuid).
 		WithBody(policyReq)
 
@@ -66,12 +94,15 @@ func (c *SdkClientWrapper) UpdatePolicy(ctx context.Context, uuid string, policy
 }
 
 func (c *SdkClientWrapper) DeletePolicy(ctx context.Context, uuid string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"uuid": uuid}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Policy", logFields)
 
 	params := policies.NewDeletePolicyParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(uuid)
 
 	_, err := c.sdkClient.Policies.DeletePolicy(params, nil)
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	storageManagementClient "github.com/groundcover-com/groundcover-sdk-go/pkg/client/storage_management"
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CreateRetentionPolicy creates the storage management policy of a data type
+func (c *SdkClientWrapper) CreateRetentionPolicy(ctx context.Context, dataType string, req *models.StorageManagementPolicyRequest) (*models.StorageManagementPolicyResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	logFields := map[string]any{"req": "create_retention_policy", "data_type": dataType}
+	tflog.Debug(ctx, "Executing SDK Call: Create Retention Policy", logFields)
+
+	params := storageManagementClient.NewCreateStorageManagementPolicyByTypeParamsWithContext(ctx).WithTimeout(c.timeout()).WithDataType(dataType).WithBody(req)
+	resp, err := c.sdkClient.StorageManagement.CreateStorageManagementPolicyByType(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "CreateRetentionPolicy", dataType)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, errors.New("create retention policy response payload was nil")
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: Create Retention Policy", logFields)
+	return resp.Payload, nil
+}
+
+// GetRetentionPolicy retrieves the storage management policy of a data type
+func (c *SdkClientWrapper) GetRetentionPolicy(ctx context.Context, dataType string) (*models.StorageManagementPolicyResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	logFields := map[string]any{"req": "get_retention_policy", "data_type": dataType}
+	tflog.Debug(ctx, "Executing SDK Call: Get Retention Policy", logFields)
+
+	params := storageManagementClient.NewGetStorageManagementPolicyByTypeParamsWithContext(ctx).WithTimeout(c.timeout()).WithDataType(dataType)
+	resp, err := c.sdkClient.StorageManagement.GetStorageManagementPolicyByType(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "GetRetentionPolicy", dataType)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, ErrNotFound
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: Get Retention Policy", logFields)
+	return resp.Payload, nil
+}
+
+// UpdateRetentionPolicy updates the storage management policy of a data type
+func (c *SdkClientWrapper) UpdateRetentionPolicy(ctx context.Context, dataType string, req *models.StorageManagementPolicyRequest) (*models.StorageManagementPolicyResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	logFields := map[string]any{"req": "update_retention_policy", "data_type": dataType}
+	tflog.Debug(ctx, "Executing SDK Call: Update Retention Policy", logFields)
+
+	params := storageManagementClient.NewUpdateStorageManagementPolicyByTypeParamsWithContext(ctx).WithTimeout(c.timeout()).WithDataType(dataType).WithBody(req)
+	resp, err := c.sdkClient.StorageManagement.UpdateStorageManagementPolicyByType(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "UpdateRetentionPolicy", dataType)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, errors.New("update retention policy response payload was nil")
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: Update Retention Policy", logFields)
+	return resp.Payload, nil
+}
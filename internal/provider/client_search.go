@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/client/search"
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func (c *SdkClientWrapper) GetSearchKeys(ctx context.Context, req *models.KeysRequest) (*models.KeysResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	logFields := map[string]any{"req": "get_search_keys", "filter": req.Filter}
+	tflog.Debug(ctx, "Executing SDK Call: Get Search Keys", logFields)
+
+	params := search.NewGetKeysParamsWithContext(ctx).WithTimeout(c.timeout()).WithBody(req)
+	resp, err := c.sdkClient.Search.GetKeys(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "GetSearchKeys", req.Filter)
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: Get Search Keys", logFields)
+	return resp.Payload, nil
+}
@@ -14,6 +14,9 @@ import (
 )
 
 func (c *SdkClientWrapper) CreateSecret(ctx context.Context, req *models.CreateSecretRequest) (*models.SecretResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	identifier := "<unknown>"
 	if req.Name != nil {
 		identifier = *req.Name
@@ -23,7 +26,7 @@ func (c *SdkClientWrapper) CreateSecret(ctx context.Context, req *models.CreateS
 
 	params := secret.NewCreateSecretParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(req)
 
 	resp, err := c.sdkClient.Secret.CreateSecret(params, nil)
@@ -42,12 +45,15 @@ func (c *SdkClientWrapper) CreateSecret(ctx context.Context, req *models.CreateS
 }
 
 func (c *SdkClientWrapper) GetSecretHash(ctx context.Context, id string) (*models.SecretHashResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Get Secret Hash", logFields)
 
 	params := secret.NewGetSecretHashParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	resp, err := c.sdkClient.Secret.GetSecretHash(params, nil)
@@ -60,12 +66,15 @@ func (c *SdkClientWrapper) GetSecretHash(ctx context.Context, id string) (*model
 }
 
 func (c *SdkClientWrapper) UpdateSecret(ctx context.Context, id string, req *models.UpdateSecretRequest) (*models.SecretResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Update Secret", logFields)
 
 	params := secret.NewUpdateSecretParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id).
 		WithBody(req)
 
@@ -79,12 +88,15 @@ func (c *SdkClientWrapper) UpdateSecret(ctx context.Context, id string, req *mod
 }
 
 func (c *SdkClientWrapper) DeleteSecret(ctx context.Context, id string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Secret", logFields)
 
 	params := secret.NewDeleteSecretParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	_, err := c.sdkClient.Secret.DeleteSecret(params, nil)
@@ -12,6 +12,9 @@ import (
 )
 
 func (c *SdkClientWrapper) CreateServiceAccount(ctx context.Context, saReq *models.CreateServiceAccountRequest) (*models.ServiceAccountCreatePayload, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	identifier := "<unknown>"
 	if saReq.Name != nil {
 		identifier = *saReq.Name
@@ -21,7 +24,7 @@ func (c *SdkClientWrapper) CreateServiceAccount(ctx context.Context, saReq *mode
 
 	params := serviceaccounts.NewCreateServiceAccountParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(saReq)
 
 	resp, err := c.sdkClient.Serviceaccounts.CreateServiceAccount(params, nil)
@@ -42,11 +45,14 @@ func (c *SdkClientWrapper) CreateServiceAccount(ctx context.Context, saReq *mode
 }
 
 func (c *SdkClientWrapper) ListServiceAccounts(ctx context.Context) ([]*models.ServiceAccountsWithPolicy, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: List Service Accounts")
 
 	params := serviceaccounts.NewListServiceAccountsParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout)
+		WithTimeout(c.timeout())
 
 	resp, err := c.sdkClient.Serviceaccounts.ListServiceAccounts(params, nil)
 	if err != nil {
@@ -58,6 +64,9 @@ func (c *SdkClientWrapper) ListServiceAccounts(ctx context.Context) ([]*models.S
 }
 
 func (c *SdkClientWrapper) UpdateServiceAccount(ctx context.Context, id string, saReq *models.UpdateServiceAccountRequest) (*models.ServiceAccountsWithPolicy, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	if saReq.ServiceAccountID == nil || *saReq.ServiceAccountID == "" {
 		saReq.ServiceAccountID = &id
 	} else if *saReq.ServiceAccountID != id {
@@ -69,7 +78,7 @@ func (c *SdkClientWrapper) UpdateServiceAccount(ctx context.Context, id string,
 
 	params := serviceaccounts.NewUpdateServiceAccountParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(saReq)
 
 	_, err := c.sdkClient.Serviceaccounts.UpdateServiceAccount(params, nil)
@@ -97,12 +106,15 @@ func (c *SdkClientWrapper) UpdateServiceAccount(ctx context.Context, id string,
 }
 
 func (c *SdkClientWrapper) DeleteServiceAccount(ctx context.Context, id string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Service Account", logFields)
 
 	params := serviceaccounts.NewDeleteServiceAccountParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	_, err := c.sdkClient.Serviceaccounts.DeleteServiceAccount(params, nil)
@@ -115,6 +127,7 @@ func (c *SdkClientWrapper) DeleteServiceAccount(ctx context.Context, id string)
 		return mappedErr
 	}
 
+	c.apiKeys.invalidate()
 	tflog.Debug(ctx, "SDK Call Successful: Delete Service Account", logFields)
 	return nil
 }
@@ -14,6 +14,9 @@ import (
 )
 
 func (c *SdkClientWrapper) CreateSilence(ctx context.Context, req *models.CreateSilenceRequest) (*models.Silence, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	identifier := "<unknown>"
 	if req.Comment != "" {
 		identifier = req.Comment
@@ -23,7 +26,7 @@ func (c *SdkClientWrapper) CreateSilence(ctx context.Context, req *models.Create
 
 	params := monitors.NewCreateSilenceParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(req)
 
 	resp, err := c.sdkClient.Monitors.CreateSilence(params, nil)
@@ -47,12 +50,15 @@ func (c *SdkClientWrapper) CreateSilence(ctx context.Context, req *models.Create
 }
 
 func (c *SdkClientWrapper) GetSilence(ctx context.Context, id string) (*models.Silence, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Get Silence", logFields)
 
 	params := monitors.NewGetSilenceParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	resp, err := c.sdkClient.Monitors.GetSilence(params, nil)
@@ -70,12 +76,15 @@ func (c *SdkClientWrapper) GetSilence(ctx context.Context, id string) (*models.S
 }
 
 func (c *SdkClientWrapper) UpdateSilence(ctx context.Context, id string, req *models.UpdateSilenceRequest) (*models.Silence, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Update Silence", logFields)
 
 	params := monitors.NewUpdateSilenceParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id).
 		WithBody(req)
 
@@ -94,12 +103,15 @@ func (c *SdkClientWrapper) UpdateSilence(ctx context.Context, id string, req *mo
 }
 
 func (c *SdkClientWrapper) DeleteSilence(ctx context.Context, id string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Silence", logFields)
 
 	params := monitors.NewDeleteSilenceParams().
 		WithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	_, err := c.sdkClient.Monitors.DeleteSilence(params, nil)
@@ -25,8 +25,11 @@ func skillRequestOptions() []agent.ClientOption {
 }
 
 func (c *SdkClientWrapper) CreateSkill(ctx context.Context, req *models.AgentSkillRequest) (*models.AgentSkillDetail, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: Create Skill")
-	params := agent.NewAgentCreateSkillParams().WithContext(ctx).WithTimeout(defaultTimeout).WithBody(req)
+	params := agent.NewAgentCreateSkillParams().WithContext(ctx).WithTimeout(c.timeout()).WithBody(req)
 	resp, err := c.sdkClient.Agent.AgentCreateSkill(params, nil, skillRequestOptions()...)
 	if err != nil {
 		return nil, handleApiError(ctx, err, "CreateSkill", skillRequestName(req))
@@ -38,8 +41,11 @@ func (c *SdkClientWrapper) CreateSkill(ctx context.Context, req *models.AgentSki
 }
 
 func (c *SdkClientWrapper) GetSkill(ctx context.Context, id string) (*models.AgentSkillDetail, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: Get Skill", map[string]any{"id": id})
-	params := agent.NewAgentGetSkillParams().WithContext(ctx).WithTimeout(defaultTimeout).WithSkillID(id)
+	params := agent.NewAgentGetSkillParams().WithContext(ctx).WithTimeout(c.timeout()).WithSkillID(id)
 	resp, err := c.sdkClient.Agent.AgentGetSkill(params, nil, skillRequestOptions()...)
 	if err != nil {
 		return nil, handleApiError(ctx, err, "GetSkill", id)
@@ -51,8 +57,11 @@ func (c *SdkClientWrapper) GetSkill(ctx context.Context, id string) (*models.Age
 }
 
 func (c *SdkClientWrapper) UpdateSkill(ctx context.Context, id string, req *models.AgentSkillRequest) (*models.AgentSkillDetail, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: Update Skill", map[string]any{"id": id})
-	params := agent.NewAgentUpdateSkillParams().WithContext(ctx).WithTimeout(defaultTimeout).WithSkillID(id).WithBody(req)
+	params := agent.NewAgentUpdateSkillParams().WithContext(ctx).WithTimeout(c.timeout()).WithSkillID(id).WithBody(req)
 	resp, err := c.sdkClient.Agent.AgentUpdateSkill(params, nil, skillRequestOptions()...)
 	if err != nil {
 		return nil, handleApiError(ctx, err, "UpdateSkill", skillRequestName(req))
@@ -64,8 +73,11 @@ func (c *SdkClientWrapper) UpdateSkill(ctx context.Context, id string, req *mode
 }
 
 func (c *SdkClientWrapper) DeleteSkill(ctx context.Context, id string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	tflog.Debug(ctx, "Executing SDK Call: Delete Skill", map[string]any{"id": id})
-	params := agent.NewAgentDeleteSkillParams().WithContext(ctx).WithTimeout(defaultTimeout).WithSkillID(id)
+	params := agent.NewAgentDeleteSkillParams().WithContext(ctx).WithTimeout(c.timeout()).WithSkillID(id)
 	_, err := c.sdkClient.Agent.AgentDeleteSkill(params, nil, skillRequestOptions()...)
 	if err == nil {
 		return nil
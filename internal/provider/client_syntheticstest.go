@@ -14,11 +14,14 @@ const (
 
 // CreateSyntheticTest creates a new synthetic test
 func (c *SdkClientWrapper) CreateSyntheticTest(ctx context.Context, req *models.SyntheticTestCreateRequest) (*models.SyntheticTestCreateResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "create_synthetic_test", "name": req.Name}
 	tflog.Debug(ctx, "Executing SDK Call: Create Synthetic Test", logFields)
 
 	params := synthetics.NewCreateSyntheticTestParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithBody(req)
 
 	resp, err := c.sdkClient.Synthetics.CreateSyntheticTest(params, nil)
@@ -32,6 +35,9 @@ func (c *SdkClientWrapper) CreateSyntheticTest(ctx context.Context, req *models.
 
 // GetSyntheticTest retrieves a synthetic test by ID
 func (c *SdkClientWrapper) GetSyntheticTest(ctx context.Context, id string) (*models.SyntheticTestCreateRequest, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "get_synthetic_test", "id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Get Synthetic Test", logFields)
 
@@ -43,7 +49,7 @@ func (c *SdkClientWrapper) GetSyntheticTest(ctx context.Context, id string) (*mo
 	}
 
 	params := synthetics.NewGetSyntheticTestParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	resp, err := c.sdkClient.Synthetics.GetSyntheticTest(params, nil)
@@ -55,13 +61,40 @@ func (c *SdkClientWrapper) GetSyntheticTest(ctx context.Context, id string) (*mo
 	return resp.Payload, nil
 }
 
+// ListSyntheticTests lists all synthetic tests with the UUIDs of their monitors
+func (c *SdkClientWrapper) ListSyntheticTests(ctx context.Context) ([]*models.SyntheticTestListItem, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	logFields := map[string]any{"req": "list_synthetic_tests"}
+	tflog.Debug(ctx, "Executing SDK Call: List Synthetic Tests", logFields)
+
+	params := synthetics.NewListSyntheticTestsParamsWithContext(ctx).
+		WithTimeout(c.timeout())
+
+	resp, err := c.sdkClient.Synthetics.ListSyntheticTests(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "ListSyntheticTests", syntheticTestResourceId)
+	}
+	if resp == nil || resp.Payload == nil {
+		tflog.Debug(ctx, "SDK Call Successful: List Synthetic Tests", map[string]any{"count": 0})
+		return nil, nil
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: List Synthetic Tests", map[string]any{"count": len(resp.Payload.Synthetics)})
+	return resp.Payload.Synthetics, nil
+}
+
 // UpdateSyntheticTest updates an existing synthetic test
 func (c *SdkClientWrapper) UpdateSyntheticTest(ctx context.Context, id string, req *models.SyntheticTestCreateRequest) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "update_synthetic_test", "id": id, "name": req.Name}
 	tflog.Debug(ctx, "Executing SDK Call: Update Synthetic Test", logFields)
 
 	params := synthetics.NewUpdateSyntheticTestParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id).
 		WithBody(req)
 
@@ -76,11 +109,14 @@ func (c *SdkClientWrapper) UpdateSyntheticTest(ctx context.Context, id string, r
 
 // DeleteSyntheticTest deletes a synthetic test by ID
 func (c *SdkClientWrapper) DeleteSyntheticTest(ctx context.Context, id string) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "delete_synthetic_test", "id": id}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Synthetic Test", logFields)
 
 	params := synthetics.NewDeleteSyntheticTestParamsWithContext(ctx).
-		WithTimeout(defaultTimeout).
+		WithTimeout(c.timeout()).
 		WithID(id)
 
 	_, err := c.sdkClient.Synthetics.DeleteSyntheticTest(params, nil)
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiTelemetry collects per-endpoint request statistics for one telemetry file.
+// Provider configurations (aliases) that name the same file share a collector,
+// so the file always describes every request the provider process made to it.
+type apiTelemetry struct {
+	path string
+	// writeMu serializes file writes so an older summary never replaces a newer one.
+	writeMu sync.Mutex
+
+	mu        sync.Mutex
+	endpoints map[string]*endpointTelemetry
+}
+
+// endpointTelemetry holds the counters of one "METHOD /path" endpoint.
+type endpointTelemetry struct {
+	requests    int
+	attempts    int
+	rateLimited int
+	latencies   []time.Duration
+}
+
+// apiTelemetrySummary is the JSON document written to the telemetry file.
+type apiTelemetrySummary struct {
+	UpdatedAt        time.Time                  `json:"updated_at"`
+	TotalRequests    int                        `json:"total_requests"`
+	TotalRetries     int                        `json:"total_retries"`
+	TotalRateLimited int                        `json:"total_rate_limited"`
+	Endpoints        []apiTelemetryEndpointStat `json:"endpoints"`
+}
+
+// apiTelemetryEndpointStat summarizes one endpoint. Latencies cover the whole
+// logical request, including retries and backoff.
+type apiTelemetryEndpointStat struct {
+	Endpoint     string  `json:"endpoint"`
+	Requests     int     `json:"requests"`
+	Retries      int     `json:"retries"`
+	RateLimited  int     `json:"rate_limited"`
+	P95LatencyMs float64 `json:"p95_latency_ms"`
+	MaxLatencyMs float64 `json:"max_latency_ms"`
+}
+
+var (
+	apiTelemetryMu     sync.Mutex
+	apiTelemetryByPath = map[string]*apiTelemetry{}
+)
+
+// apiTelemetryFor returns the process-wide collector writing to path.
+func apiTelemetryFor(path string) *apiTelemetry {
+	apiTelemetryMu.Lock()
+	defer apiTelemetryMu.Unlock()
+
+	if t, ok := apiTelemetryByPath[path]; ok {
+		return t
+	}
+	t := &apiTelemetry{path: path, endpoints: make(map[string]*endpointTelemetry)}
+	apiTelemetryByPath[path] = t
+	return t
+}
+
+// endpointLocked returns the counters for endpoint. t.mu must be held.
+func (t *apiTelemetry) endpointLocked(endpoint string) *endpointTelemetry {
+	e, ok := t.endpoints[endpoint]
+	if !ok {
+		e = &endpointTelemetry{}
+		t.endpoints[endpoint] = e
+	}
+	return e
+}
+
+// recordAttempt counts one HTTP attempt. statusCode is 0 when the attempt failed
+// without a response.
+func (t *apiTelemetry) recordAttempt(endpoint string, statusCode int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e := t.endpointLocked(endpoint)
+	e.attempts++
+	if statusCode == http.StatusTooManyRequests {
+		e.rateLimited++
+	}
+}
+
+// recordRequest counts one logical request and its end-to-end latency.
+func (t *apiTelemetry) recordRequest(endpoint string, latency time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e := t.endpointLocked(endpoint)
+	e.requests++
+	e.latencies = append(e.latencies, latency)
+}
+
+// summary returns the current statistics, with endpoints sorted by name.
+func (t *apiTelemetry) summary() apiTelemetrySummary {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := apiTelemetrySummary{UpdatedAt: time.Now().UTC(), Endpoints: []apiTelemetryEndpointStat{}}
+	for endpoint, e := range t.endpoints {
+		retries := max(e.attempts-e.requests, 0)
+		stat := apiTelemetryEndpointStat{
+			Endpoint:    endpoint,
+			Requests:    e.requests,
+			Retries:     retries,
+			RateLimited: e.rateLimited,
+		}
+		if len(e.latencies) > 0 {
+			sorted := append([]time.Duration(nil), e.latencies...)
+			sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+			// Nearest-rank percentile.
+			rank := int(math.Ceil(0.95*float64(len(sorted)))) - 1
+			stat.P95LatencyMs = durationMs(sorted[rank])
+			stat.MaxLatencyMs = durationMs(sorted[len(sorted)-1])
+		}
+		s.TotalRequests += stat.Requests
+		s.TotalRetries += stat.Retries
+		s.TotalRateLimited += stat.RateLimited
+		s.Endpoints = append(s.Endpoints, stat)
+	}
+	sort.Slice(s.Endpoints, func(i, j int) bool { return s.Endpoints[i].Endpoint < s.Endpoints[j].Endpoint })
+	return s
+}
+
+func durationMs(d time.Duration) float64 {
+	return math.Round(float64(d)/float64(time.Millisecond)*100) / 100
+}
+
+// write replaces the telemetry file with the current summary. The file is
+// written to a temporary sibling and renamed, so readers never see a partial
+// document even if the provider is killed mid-write.
+func (t *apiTelemetry) write() error {
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+
+	data, err := json.MarshalIndent(t.summary(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(t.path), filepath.Base(t.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), t.path)
+}
+
+// telemetryIDSegmentRegex matches path segments that identify a single object:
+// anything with a digit except API version segments such as "v2".
+var (
+	telemetryIDSegmentRegex      = regexp.MustCompile(`\d`)
+	telemetryVersionSegmentRegex = regexp.MustCompile(`^v\d+$`)
+)
+
+// telemetryEndpoint returns "METHOD /path" for req with object IDs replaced by
+// "{id}", so requests for different monitors or dashboards share an endpoint.
+func telemetryEndpoint(req *http.Request) string {
+	segments := strings.Split(req.URL.Path, "/")
+	for i, segment := range segments {
+		if telemetryIDSegmentRegex.MatchString(segment) && !telemetryVersionSegmentRegex.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return fmt.Sprintf("%s %s", req.Method, strings.Join(segments, "/"))
+}
+
+// telemetryRequestTransport records each logical request and its latency,
+// including retries, and refreshes the telemetry file afterwards. It sits
+// outside the retry layers.
+type telemetryRequestTransport struct {
+	transport http.RoundTripper
+	telemetry *apiTelemetry
+}
+
+func (t *telemetryRequestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := telemetryEndpoint(req)
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	latency := time.Since(start)
+
+	t.telemetry.recordRequest(endpoint, latency)
+	if writeErr := t.telemetry.write(); writeErr != nil {
+		tflog.Warn(req.Context(), "Failed to write API telemetry file", map[string]any{"path": t.telemetry.path, "error": writeErr.Error()})
+	}
+	return resp, err
+}
+
+// telemetryAttemptTransport records every HTTP attempt, including those the
+// retry layers above it discard. It wraps the base HTTP transport.
+type telemetryAttemptTransport struct {
+	transport http.RoundTripper
+	telemetry *apiTelemetry
+}
+
+func (t *telemetryAttemptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	t.telemetry.recordAttempt(telemetryEndpoint(req), statusCode)
+	return resp, err
+}
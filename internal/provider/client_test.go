@@ -3,13 +3,23 @@
 package provider
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	apiruntime "github.com/go-openapi/runtime"
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -123,6 +133,70 @@ func TestHandleApiErrorSkillDiagnostics(t *testing.T) {
 	assert.Contains(t, conflict.Error(), `Skill name "my-skill" is already in use`)
 }
 
+func TestHandleApiErrorAccessDenied(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		operation    string
+		wantIs       error
+		wantContains []string
+	}{
+		{
+			name:         "401",
+			err:          errors.New(`[GET /api/monitors/abc][401] getMonitorUnauthorized`),
+			operation:    "GetMonitor",
+			wantIs:       ErrUnauthorized,
+			wantContains: []string{"401 Unauthorized", "api_key"},
+		},
+		{
+			name:         "403 on a write",
+			err:          errors.New(`[POST /api/connected-apps/v1][403] createConnectedAppForbidden`),
+			operation:    "CreateConnectedApp",
+			wantIs:       ErrPermissionDenied,
+			wantContains: []string{"no write access to connected app", "the write role"},
+		},
+		{
+			name:         "403 on a read",
+			err:          errors.New(`[GET /api/synthetics/v1/rules][403] listSyntheticTestsForbidden`),
+			operation:    "ListSyntheticTests",
+			wantIs:       ErrPermissionDenied,
+			wantContains: []string{"no read access to synthetic tests", "the read role"},
+		},
+		{
+			name:         "403 on access management",
+			err:          errors.New(`[PUT /api/rbac/v2/tenant/ai-settings][403] updateTenantAISettingsForbidden`),
+			operation:    "UpdateTenantAISettings",
+			wantIs:       ErrPermissionDenied,
+			wantContains: []string{"tenant AI settings", "the admin role"},
+		},
+		{
+			name:         "403 body is not matched as a conflict",
+			err:          errors.New(`[POST /api/rbac/service-accounts][403] createServiceAccountForbidden {"message":"conflict with organization policy"}`),
+			operation:    "CreateServiceAccount",
+			wantIs:       ErrPermissionDenied,
+			wantContains: []string{"service account", "the admin role"},
+		},
+		{
+			name:         "403 runtime API error",
+			err:          apiruntime.NewAPIError("forbidden", nil, http.StatusForbidden),
+			operation:    "DeletePolicy",
+			wantIs:       ErrPermissionDenied,
+			wantContains: []string{"no write access to policy", "the admin role"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := handleApiError(context.Background(), tt.err, tt.operation, "test-resource")
+			require.Error(t, result)
+			assert.ErrorIs(t, result, tt.wantIs)
+			assert.NotErrorIs(t, result, ErrNotFound)
+			for _, want := range tt.wantContains {
+				assert.Contains(t, result.Error(), want)
+			}
+		})
+	}
+}
+
 func TestProviderErrorTypes(t *testing.T) {
 	tests := []struct {
 		name string
@@ -193,6 +267,56 @@ func TestRateLimitRetryTransportDoesNotRetryPostInternalServerError(t *testing.T
 	assert.Equal(t, 1, attempts)
 }
 
+// clientTransportFunc adapts a function to runtime.ClientTransport.
+type clientTransportFunc func(*apiruntime.ClientOperation) (any, error)
+
+func (f clientTransportFunc) Submit(op *apiruntime.ClientOperation) (any, error) {
+	return f(op)
+}
+
+func TestReadRetryClientTransport(t *testing.T) {
+	unavailable := apiruntime.NewAPIError("unavailable", nil, http.StatusServiceUnavailable)
+
+	tests := []struct {
+		name         string
+		method       string
+		errs         []error
+		wantAttempts int
+		wantErr      bool
+	}{
+		{name: "retries a GET server error", method: http.MethodGet, errs: []error{unavailable}, wantAttempts: 2},
+		{name: "retries a GET connection reset", method: http.MethodGet, errs: []error{io.ErrUnexpectedEOF}, wantAttempts: 2},
+		{name: "gives up after the retries", method: http.MethodGet, errs: []error{unavailable, unavailable, unavailable}, wantAttempts: 3, wantErr: true},
+		{name: "does not retry a GET client error", method: http.MethodGet, errs: []error{apiruntime.NewAPIError("not found", nil, http.StatusNotFound)}, wantAttempts: 1, wantErr: true},
+		{name: "does not retry a PUT", method: http.MethodPut, errs: []error{unavailable}, wantAttempts: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			transport := &readRetryClientTransport{
+				transport: clientTransportFunc(func(*apiruntime.ClientOperation) (any, error) {
+					attempts++
+					if attempts <= len(tt.errs) {
+						return nil, tt.errs[attempts-1]
+					}
+					return "ok", nil
+				}),
+				maxRetries: 2,
+				wait:       time.Millisecond,
+			}
+
+			result, err := transport.Submit(&apiruntime.ClientOperation{Method: tt.method, Context: context.Background()})
+			assert.Equal(t, tt.wantAttempts, attempts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "ok", result)
+		})
+	}
+}
+
 func TestRateLimitRetryTransportRetriesRateLimitForPost(t *testing.T) {
 	attempts := 0
 	transport := &rateLimitRetryTransport{
@@ -284,3 +408,280 @@ func TestErrorConstants(t *testing.T) {
 	assert.NotEqual(t, ErrNotFound, ErrReadOnly)
 	assert.NotEqual(t, ErrConcurrency, ErrReadOnly)
 }
+
+func TestMonitorPrefetchServesRefreshesFromCache(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t, withMonitorPrefetch())
+	ids := make([]string, 2*monitorPrefetchConcurrency)
+	for i := range ids {
+		ids[i] = m.addMonitor(fmt.Sprintf("title: monitor %d\n", i))
+	}
+	const listPath = "/api/monitors/list"
+
+	// Reads outside a refresh fetch only their monitor.
+	yamlBytes, err := client.GetMonitor(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, "title: monitor 0\n", string(yamlBytes))
+	assert.Zero(t, m.requestCount(http.MethodPost, listPath))
+
+	// Concurrent refresh reads wait for a single prefetch and are served from
+	// it, rather than fetching their monitor again.
+	refresh := withMonitorRefresh(ctx)
+	got := make([]string, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Go(func() {
+			yamlBytes, err := client.GetMonitor(refresh, id)
+			assert.NoError(t, err)
+			got[i] = string(yamlBytes)
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, 1, m.requestCount(http.MethodPost, listPath))
+	for i, id := range ids {
+		assert.Equal(t, fmt.Sprintf("title: monitor %d\n", i), got[i])
+		want := 1
+		if i == 0 {
+			want = 2
+		}
+		assert.Equal(t, want, m.requestCount(http.MethodGet, "/api/monitors/"+id), "monitor %d", i)
+	}
+
+	// An entry is served once; a later read fetches the monitor, without a
+	// second prefetch.
+	_, err = client.GetMonitor(refresh, ids[1])
+	require.NoError(t, err)
+	assert.Equal(t, 2, m.requestCount(http.MethodGet, "/api/monitors/"+ids[1]))
+	assert.Equal(t, 1, m.requestCount(http.MethodPost, listPath))
+}
+
+func TestGzipRequestTransportCompressesLargeBodies(t *testing.T) {
+	large := strings.Repeat("a", minGzipRequestBytes)
+	var gotEncoding, gotBody string
+	transport := &gzipRequestTransport{
+		transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			gotEncoding = req.Header.Get("Content-Encoding")
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			if gotEncoding == "gzip" {
+				gz, err := gzip.NewReader(bytes.NewReader(body))
+				require.NoError(t, err)
+				body, err = io.ReadAll(gz)
+				require.NoError(t, err)
+			}
+			gotBody = string(body)
+			return testHTTPResponse(http.StatusOK), nil
+		}),
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/resource", strings.NewReader(large))
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, "gzip", gotEncoding)
+	assert.Equal(t, large, gotBody)
+
+	req, err = http.NewRequest(http.MethodPost, "https://example.com/resource", strings.NewReader("small"))
+	require.NoError(t, err)
+	body := req.Body
+	_, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Empty(t, gotEncoding)
+	assert.Equal(t, "small", gotBody)
+	assert.True(t, req.Body == body, "the caller's request must not be modified")
+}
+
+func TestExtraHeadersTransportAddsHeadersToEveryAttempt(t *testing.T) {
+	var got []http.Header
+	transport := &extraHeadersTransport{
+		transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			got = append(got, req.Header.Clone())
+			return testHTTPResponse(http.StatusOK), nil
+		}),
+		headers: http.Header{"X-Internal-Auth": {"token"}, "User-Agent": {"corp-terraform"}},
+	}
+
+	for range 2 {
+		req, err := http.NewRequest(http.MethodGet, "https://example.com/resource", nil)
+		require.NoError(t, err)
+		req.Header.Set("User-Agent", "groundcover-go-sdk")
+		req.Header.Set("Authorization", "Bearer key")
+		_, err = transport.RoundTrip(req)
+		require.NoError(t, err)
+		assert.Equal(t, "groundcover-go-sdk", req.Header.Get("User-Agent"), "the caller's request must not be modified")
+	}
+
+	require.Len(t, got, 2)
+	for _, headers := range got {
+		assert.Equal(t, "token", headers.Get("X-Internal-Auth"))
+		assert.Equal(t, "corp-terraform", headers.Get("User-Agent"))
+		assert.Equal(t, "Bearer key", headers.Get("Authorization"))
+	}
+}
+
+func TestValidateExtraHeaders(t *testing.T) {
+	assert.NoError(t, validateExtraHeaders(map[string]string{"X-Internal-Auth": "token", "user-agent": "corp"}))
+	assert.ErrorContains(t, validateExtraHeaders(map[string]string{"authorization": "Bearer other"}), "Authorization")
+	assert.ErrorContains(t, validateExtraHeaders(map[string]string{"x-backend-id": "other"}), "X-Backend-Id")
+}
+
+func TestAPITelemetryTransportsRecordRequestsRetriesAndRateLimits(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "telemetry.json")
+	telemetry := &apiTelemetry{path: path, endpoints: make(map[string]*endpointTelemetry)}
+
+	attempts := 0
+	transport := &telemetryRequestTransport{
+		telemetry: telemetry,
+		transport: &rateLimitRetryTransport{
+			transport: &telemetryAttemptTransport{
+				telemetry: telemetry,
+				transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					attempts++
+					if attempts == 1 {
+						return testHTTPResponse(http.StatusTooManyRequests), nil
+					}
+					return testHTTPResponse(http.StatusOK), nil
+				}),
+			},
+			maxRetries: 1,
+		},
+	}
+
+	for _, id := range []string{"5f1c2d3e-0000-4000-8000-000000000001", "5f1c2d3e-0000-4000-8000-000000000002"} {
+		req, err := http.NewRequest(http.MethodGet, "https://example.com/api/monitors/"+id, nil)
+		require.NoError(t, err)
+		_, err = transport.RoundTrip(req)
+		require.NoError(t, err)
+	}
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var summary apiTelemetrySummary
+	require.NoError(t, json.Unmarshal(data, &summary))
+
+	assert.Equal(t, 2, summary.TotalRequests)
+	assert.Equal(t, 1, summary.TotalRetries)
+	assert.Equal(t, 1, summary.TotalRateLimited)
+	require.Len(t, summary.Endpoints, 1)
+	assert.Equal(t, "GET /api/monitors/{id}", summary.Endpoints[0].Endpoint)
+	assert.Equal(t, 2, summary.Endpoints[0].Requests)
+	assert.GreaterOrEqual(t, summary.Endpoints[0].MaxLatencyMs, summary.Endpoints[0].P95LatencyMs)
+}
+
+func TestTelemetryEndpointKeepsVersionSegments(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPut, "https://example.com/api/dashboards/v2/d41d8cd98f00b204", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "PUT /api/dashboards/v2/{id}", telemetryEndpoint(req))
+}
+
+func TestRequestIDTransportRecordsFailedRequestID(t *testing.T) {
+	transport := &requestIDTransport{
+		transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			resp := testHTTPResponse(http.StatusInternalServerError)
+			resp.Header.Set(requestIDHeader, "req-123")
+			return resp, nil
+		}),
+	}
+	client := &SdkClientWrapper{}
+	ctx, cancel := client.callContext(context.Background())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com/api/monitors/abc", nil)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+
+	assert.Equal(t, "req-123", apiCallRequestID(ctx))
+	err = handleApiError(ctx, errors.New("unexpected response [500]"), "GetMonitor", "abc")
+	assert.EqualError(t, err, "GetMonitor failed (request ID req-123): unexpected response [500]")
+	assert.Empty(t, apiCallRequestID(context.Background()))
+}
+
+func TestSdkClientWrapperRequestTimeout(t *testing.T) {
+	assert.Equal(t, defaultTimeout, (&SdkClientWrapper{}).timeout())
+
+	client := &SdkClientWrapper{requestTimeout: time.Minute}
+	assert.Equal(t, time.Minute, client.timeout())
+
+	// An earlier deadline on the caller's context is kept.
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Second)
+	defer cancelParent()
+	ctx, cancel := client.callContext(parent)
+	defer cancel()
+	parentDeadline, _ := parent.Deadline()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.Equal(t, parentDeadline, deadline)
+}
+
+func TestSdkClientWrapperSendsRequestsThroughInjectedTransport(t *testing.T) {
+	var requests []*http.Request
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req)
+		resp := testHTTPResponse(http.StatusOK)
+		resp.Header.Set("Content-Type", "application/json")
+		resp.Body = io.NopCloser(strings.NewReader(`[]`))
+		return resp, nil
+	})
+
+	// The host does not resolve, so the request can only be served by the transport.
+	client, err := NewSdkClientWrapper(context.Background(), "https://api.groundcover.invalid", "test-key", "test-backend", withTransport(transport))
+	require.NoError(t, err)
+	policies, err := client.ListPolicies(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+
+	require.Len(t, requests, 1)
+	assert.Equal(t, "api.groundcover.invalid", requests[0].URL.Host)
+	assert.Equal(t, "/api/rbac/policies/list", requests[0].URL.Path)
+	assert.Equal(t, "Bearer test-key", requests[0].Header.Get("Authorization"))
+	assert.Equal(t, "test-backend", requests[0].Header.Get("X-Backend-Id"))
+}
+
+func TestGetApiKeyServesReadsFromOneList(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+
+	name, email := "ci", "ci@example.com"
+	account, err := client.CreateServiceAccount(ctx, &models.CreateServiceAccountRequest{Name: &name, Email: &email})
+	require.NoError(t, err)
+	var ids []string
+	for _, keyName := range []string{"deploy", "backup", "audit"} {
+		key, err := client.CreateApiKey(ctx, &models.CreateAPIKeyRequest{Name: &keyName, ServiceAccountID: account.ServiceAccountID})
+		require.NoError(t, err)
+		ids = append(ids, key.ID)
+	}
+
+	for i, id := range ids {
+		key, err := client.GetApiKey(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"deploy", "backup", "audit"}[i], key.Name)
+	}
+	assert.Equal(t, 1, m.requestCount(http.MethodGet, "/api/rbac/apikeys/list"), "every key is read from one list")
+
+	// A key missing from the index does not list the keys again.
+	_, err = client.GetApiKey(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, 1, m.requestCount(http.MethodGet, "/api/rbac/apikeys/list"))
+
+	// A write drops the index, so a revoked key is reported as such, and
+	// concurrent reads share the list that follows.
+	require.NoError(t, client.DeleteApiKey(ctx, ids[0]))
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			key, err := client.GetApiKey(ctx, ids[0])
+			if assert.NoError(t, err) {
+				assert.False(t, key.RevokedAt.IsZero())
+			}
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, 2, m.requestCount(http.MethodGet, "/api/rbac/apikeys/list"))
+
+	// Deleting the service account deletes its keys.
+	require.NoError(t, client.DeleteServiceAccount(ctx, *account.ServiceAccountID))
+	_, err = client.GetApiKey(ctx, ids[1])
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, 3, m.requestCount(http.MethodGet, "/api/rbac/apikeys/list"))
+}
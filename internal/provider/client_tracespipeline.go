@@ -12,6 +12,9 @@ const tracesPipelineResourceId = "traces-pipeline"
 
 // CreateTracesPipeline creates a new traces pipeline configuration
 func (c *SdkClientWrapper) CreateTracesPipeline(ctx context.Context, req *models.CreateOrUpdateTracesPipelineConfigRequest) (*models.TracesPipelineConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "create_traces_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Create Traces Pipeline", logFields)
 
@@ -27,6 +30,9 @@ func (c *SdkClientWrapper) CreateTracesPipeline(ctx context.Context, req *models
 
 // GetTracesPipeline retrieves a traces pipeline configuration by key
 func (c *SdkClientWrapper) GetTracesPipeline(ctx context.Context) (*models.TracesPipelineConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "get_traces_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Get Traces Pipeline", logFields)
 
@@ -47,6 +53,9 @@ func (c *SdkClientWrapper) GetTracesPipeline(ctx context.Context) (*models.Trace
 
 // UpdateTracesPipeline updates an existing traces pipeline configuration
 func (c *SdkClientWrapper) UpdateTracesPipeline(ctx context.Context, req *models.CreateOrUpdateTracesPipelineConfigRequest) (*models.TracesPipelineConfig, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "update_traces_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Update Traces Pipeline", logFields)
 
@@ -62,6 +71,9 @@ func (c *SdkClientWrapper) UpdateTracesPipeline(ctx context.Context, req *models
 
 // DeleteTracesPipeline deletes a traces pipeline configuration by key
 func (c *SdkClientWrapper) DeleteTracesPipeline(ctx context.Context) error {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
 	logFields := map[string]any{"req": "delete_traces_pipeline"}
 	tflog.Debug(ctx, "Executing SDK Call: Delete Traces Pipeline", logFields)
 
@@ -0,0 +1,36 @@
+// Copyright groundcover 2024
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/client/workflows"
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func (c *SdkClientWrapper) ListWorkflows(ctx context.Context) ([]*models.Workflow, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	tflog.Debug(ctx, "Executing SDK Call: List Workflows")
+
+	params := workflows.NewListWorkflowsParamsWithContext(ctx).
+		WithTimeout(c.timeout())
+
+	resp, err := c.sdkClient.Workflows.ListWorkflows(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "ListWorkflows", "")
+	}
+
+	if resp == nil || resp.Payload == nil {
+		tflog.Warn(ctx, "ListWorkflows response payload was nil")
+		return nil, nil
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: List Workflows", map[string]any{"count": len(resp.Payload.Workflows)})
+	return resp.Payload.Workflows, nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/client/rbac_v2"
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	workspaceSettingsResourceId = "workspace-settings"
+)
+
+func (c *SdkClientWrapper) GetTenantAISettings(ctx context.Context) (*models.TenantAISettingsResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	logFields := map[string]any{"req": "get_tenant_ai_settings"}
+	tflog.Debug(ctx, "Executing SDK Call: Get Tenant AI Settings", logFields)
+
+	params := rbac_v2.NewGetTenantAISettingsParamsWithContext(ctx).WithTimeout(c.timeout())
+	resp, err := c.sdkClient.RbacV2.GetTenantAISettings(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "GetTenantAISettings", workspaceSettingsResourceId)
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: Get Tenant AI Settings", logFields)
+	return resp.Payload, nil
+}
+
+func (c *SdkClientWrapper) UpdateTenantAISettings(ctx context.Context, req *models.UpdateTenantAISettingsRequest) (*models.TenantAISettingsResponse, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	logFields := map[string]any{"req": "update_tenant_ai_settings"}
+	tflog.Debug(ctx, "Executing SDK Call: Update Tenant AI Settings", logFields)
+
+	params := rbac_v2.NewUpdateTenantAISettingsParamsWithContext(ctx).WithTimeout(c.timeout()).WithBody(req)
+	resp, err := c.sdkClient.RbacV2.UpdateTenantAISettings(params, nil)
+	if err != nil {
+		return nil, handleApiError(ctx, err, "UpdateTenantAISettings", workspaceSettingsResourceId)
+	}
+
+	tflog.Debug(ctx, "SDK Call Successful: Update Tenant AI Settings", logFields)
+	return resp.Payload, nil
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// dashboardPresetChanges summarizes how a dashboard preset changed, by panel,
+// so a plan can say what changed instead of only showing the JSON diff.
+type dashboardPresetChanges struct {
+	// Added, Removed, and Changed are panel labels, in preset order.
+	Added   []string
+	Removed []string
+	Changed []string
+	// Moved are the labels of panels whose layout entry changed.
+	Moved []string
+	// Settings are the other top-level preset keys that changed, sorted.
+	Settings []string
+}
+
+func (c dashboardPresetChanges) empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Changed) == 0 && len(c.Moved) == 0 && len(c.Settings) == 0
+}
+
+// String returns the changes as one line per kind of change.
+func (c dashboardPresetChanges) String() string {
+	var lines []string
+	for _, group := range []struct {
+		what   string
+		labels []string
+	}{
+		{"Panels added", c.Added},
+		{"Panels removed", c.Removed},
+		{"Panels changed", c.Changed},
+		{"Panels moved or resized", c.Moved},
+		{"Dashboard settings changed", c.Settings},
+	} {
+		if len(group.labels) > 0 {
+			lines = append(lines, fmt.Sprintf("%s: %s", group.what, strings.Join(group.labels, ", ")))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// dashboardPanel is a widget of a preset and its layout entry.
+type dashboardPanel struct {
+	label  string
+	widget any
+	layout any
+}
+
+// diffDashboardPresets compares two presets after removing ignorePaths from
+// both (see RemoveJSONPaths). Widgets are matched to each other, and to their
+// layout entries, by `id`; a widget without an id is matched by position.
+func diffDashboardPresets(prior, planned string, ignorePaths []string) (dashboardPresetChanges, error) {
+	var changes dashboardPresetChanges
+	priorPreset, err := dashboardPresetObject(prior, ignorePaths)
+	if err != nil {
+		return changes, fmt.Errorf("failed to parse the prior preset: %w", err)
+	}
+	plannedPreset, err := dashboardPresetObject(planned, ignorePaths)
+	if err != nil {
+		return changes, fmt.Errorf("failed to parse the planned preset: %w", err)
+	}
+
+	priorIDs, priorPanels := dashboardPanels(priorPreset)
+	plannedIDs, plannedPanels := dashboardPanels(plannedPreset)
+	for _, id := range plannedIDs {
+		panel := plannedPanels[id]
+		priorPanel, ok := priorPanels[id]
+		switch {
+		case !ok:
+			changes.Added = append(changes.Added, panel.label)
+		case !reflect.DeepEqual(panel.widget, priorPanel.widget):
+			changes.Changed = append(changes.Changed, panel.label)
+		}
+		if ok && !reflect.DeepEqual(panel.layout, priorPanel.layout) {
+			changes.Moved = append(changes.Moved, panel.label)
+		}
+	}
+	for _, id := range priorIDs {
+		if _, ok := plannedPanels[id]; !ok {
+			changes.Removed = append(changes.Removed, priorPanels[id].label)
+		}
+	}
+
+	for key := range priorPreset {
+		if _, ok := plannedPreset[key]; !ok && key != "widgets" && key != "layout" {
+			changes.Settings = append(changes.Settings, key)
+		}
+	}
+	for key, value := range plannedPreset {
+		if key != "widgets" && key != "layout" && !reflect.DeepEqual(value, priorPreset[key]) {
+			changes.Settings = append(changes.Settings, key)
+		}
+	}
+	slices.Sort(changes.Settings)
+	return changes, nil
+}
+
+// dashboardPresetObject parses preset, which must be a JSON object.
+func dashboardPresetObject(preset string, ignorePaths []string) (map[string]any, error) {
+	if len(ignorePaths) > 0 {
+		stripped, err := RemoveJSONPaths(preset, ignorePaths)
+		if err != nil {
+			return nil, err
+		}
+		preset = stripped
+	}
+	var object map[string]any
+	if err := json.Unmarshal([]byte(preset), &object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// dashboardPanels returns the panels of preset keyed by widget id, and the ids
+// in preset order.
+func dashboardPanels(preset map[string]any) ([]string, map[string]dashboardPanel) {
+	layouts := map[string]any{}
+	entries, _ := preset["layout"].([]any)
+	for i, entry := range entries {
+		layouts[dashboardPanelID(entry, i)] = entry
+	}
+
+	var ids []string
+	panels := map[string]dashboardPanel{}
+	widgets, _ := preset["widgets"].([]any)
+	for i, widget := range widgets {
+		id := dashboardPanelID(widget, i)
+		if _, ok := panels[id]; ok {
+			continue
+		}
+		ids = append(ids, id)
+		panels[id] = dashboardPanel{label: dashboardPanelLabel(widget, id), widget: widget, layout: layouts[id]}
+	}
+	return ids, panels
+}
+
+// dashboardPanelID returns the id of a widget or layout entry, or its
+// position when it has none.
+func dashboardPanelID(item any, index int) string {
+	if object, ok := item.(map[string]any); ok {
+		if id, ok := object["id"].(string); ok && id != "" {
+			return id
+		}
+	}
+	return fmt.Sprintf("#%d", index)
+}
+
+// dashboardPanelLabel names a panel by its title and id, such as
+// `"CPU Usage" (A)`, or by its type when it has no title.
+func dashboardPanelLabel(widget any, id string) string {
+	object, _ := widget.(map[string]any)
+	for _, key := range []string{"name", "title"} {
+		if title, ok := object[key].(string); ok && title != "" {
+			return fmt.Sprintf("%q (%s)", title, id)
+		}
+	}
+	if kind, ok := object["type"].(string); ok && kind != "" {
+		return fmt.Sprintf("%s panel (%s)", kind, id)
+	}
+	return fmt.Sprintf("panel (%s)", id)
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffDashboardPresets(t *testing.T) {
+	prior := `{
+  "duration": "Last 1 hour",
+  "layout": [
+    {"id": "A", "x": 0, "y": 0, "w": 12, "h": 4},
+    {"id": "B", "x": 0, "y": 4, "w": 6, "h": 4},
+    {"id": "C", "x": 6, "y": 4, "w": 6, "h": 4}
+  ],
+  "widgets": [
+    {"id": "A", "type": "widget", "name": "CPU Usage", "queries": [{"expr": "avg(cpu)"}]},
+    {"id": "B", "type": "widget", "name": "Memory Usage", "queries": [{"expr": "avg(memory)"}]},
+    {"id": "C", "type": "text", "html": "<h3>System</h3>"}
+  ],
+  "schemaVersion": 3
+}`
+	planned := `{
+  "duration": "Last 6 hours",
+  "layout": [
+    {"id": "A", "x": 0, "y": 0, "w": 12, "h": 4},
+    {"id": "C", "x": 0, "y": 4, "w": 12, "h": 4},
+    {"id": "D", "x": 0, "y": 8, "w": 12, "h": 4}
+  ],
+  "widgets": [
+    {"id": "A", "type": "widget", "name": "CPU Usage", "queries": [{"expr": "max(cpu)"}]},
+    {"id": "C", "type": "text", "html": "<h3>System</h3>"},
+    {"id": "D", "type": "widget", "name": "Disk Usage", "queries": [{"expr": "avg(disk)"}]}
+  ],
+  "variables": {},
+  "schemaVersion": 3
+}`
+
+	changes, err := diffDashboardPresets(prior, planned, nil)
+	require.NoError(t, err)
+	assert.Equal(t, dashboardPresetChanges{
+		Added:    []string{`"Disk Usage" (D)`},
+		Removed:  []string{`"Memory Usage" (B)`},
+		Changed:  []string{`"CPU Usage" (A)`},
+		Moved:    []string{"text panel (C)"},
+		Settings: []string{"duration", "variables"},
+	}, changes)
+	assert.Equal(t, `Panels added: "Disk Usage" (D)
+Panels removed: "Memory Usage" (B)
+Panels changed: "CPU Usage" (A)
+Panels moved or resized: text panel (C)
+Dashboard settings changed: duration, variables`, changes.String())
+
+	// Ignored paths are not reported.
+	changes, err = diffDashboardPresets(
+		`{"widgets":[{"id":"A","name":"CPU","updatedAt":"1"}],"updatedAt":"1"}`,
+		`{"widgets":[{"id":"A","name":"CPU","updatedAt":"2"}],"updatedAt":"2"}`,
+		[]string{"updatedAt", "widgets.*.updatedAt"},
+	)
+	require.NoError(t, err)
+	assert.True(t, changes.empty())
+
+	_, err = diffDashboardPresets(`{}`, `[`, nil)
+	assert.ErrorContains(t, err, "failed to parse the planned preset")
+}
+
+func TestDashboardPanelsWithoutIDsMatchByPosition(t *testing.T) {
+	changes, err := diffDashboardPresets(
+		`{"widgets":[{"title":"Errors"},{"type":"text"}]}`,
+		`{"widgets":[{"title":"Errors"},{"type":"text","html":"new"},{}]}`,
+		nil,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"panel (#2)"}, changes.Added)
+	assert.Equal(t, []string{"text panel (#1)"}, changes.Changed)
+	assert.Empty(t, changes.Removed)
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
+)
+
+var (
+	_ datasource.DataSource              = &alertRoutingDataSource{}
+	_ datasource.DataSourceWithConfigure = &alertRoutingDataSource{}
+)
+
+const (
+	alertRoutingMatches  = "matches"
+	alertRoutingMayMatch = "may_match"
+)
+
+func NewAlertRoutingDataSource() datasource.DataSource {
+	return &alertRoutingDataSource{}
+}
+
+type alertRoutingDataSource struct {
+	client ApiClient
+}
+
+type alertRoutingDataSourceModel struct {
+	Labels             types.Map    `tfsdk:"labels"`
+	Severity           types.String `tfsdk:"severity"`
+	Status             types.String `tfsdk:"status"`
+	NotificationRoutes types.List   `tfsdk:"notification_routes"` // List of alertRoutingRouteObjectType
+	ConnectedApps      types.List   `tfsdk:"connected_apps"`      // List of alertRoutingConnectedAppObjectType
+}
+
+var alertRoutingRouteObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":    types.StringType,
+		"name":  types.StringType,
+		"match": types.StringType,
+	},
+}
+
+var alertRoutingConnectedAppObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":       types.StringType,
+		"name":     types.StringType,
+		"type":     types.StringType,
+		"route_id": types.StringType,
+	},
+}
+
+// alertRoutingRoute is a notification route whose query matches, or may
+// match, the simulated issue.
+type alertRoutingRoute struct {
+	id    string
+	name  string
+	match string
+}
+
+// alertRoutingConnectedApp is a connected app a matching route delivers the
+// simulated issue to, with the first route that delivers it.
+type alertRoutingConnectedApp struct {
+	id      string
+	name    string
+	appType string
+	routeID string
+}
+
+func (d *alertRoutingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_routing"
+}
+
+func (d *alertRoutingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Simulates routing an issue with the given labels through the notification routes, returning the routes whose `query` matches it and the connected apps they notify. " +
+			"Queries are evaluated by the provider with the same matcher as `groundcover_monitor` `notification_routes`, so a `check` or test can assert the alerting topology without firing an alert.",
+		Attributes: map[string]schema.Attribute{
+			"labels": schema.MapAttribute{
+				Description: "The labels of the simulated issue.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"severity": schema.StringAttribute{
+				Description: "The severity of the simulated issue, such as `S1`. Matched as the `severity` label unless `labels` sets it.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the simulated issue: `Alerting` (default) or `Resolved`. Only route rules for this status contribute `connected_apps`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.OneOfCaseInsensitive("Alerting", "Resolved"),
+				},
+			},
+			"notification_routes": schema.ListNestedAttribute{
+				Description: "The notification routes whose query can match the issue, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The notification route ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The notification route name.",
+							Computed:    true,
+						},
+						"match": schema.StringAttribute{
+							Description: "`matches` when the query matches the labels, or `may_match` when it depends on labels that are not set or on terms, such as free text, that cannot be checked against labels.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"connected_apps": schema.ListNestedAttribute{
+				Description: "The connected apps the matching routes notify for `status`, each listed once, in route order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The connected app ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The connected app name.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The connected app type, such as `slack-webhook`.",
+							Computed:    true,
+						},
+						"route_id": schema.StringAttribute{
+							Description: "The ID of the first matching route that notifies the connected app.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *alertRoutingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *alertRoutingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config alertRoutingDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var labels map[string]string
+	resp.Diagnostics.Append(config.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	status := config.Status.ValueString()
+	if status == "" {
+		status = "Alerting"
+	}
+
+	routes, err := d.client.ListNotificationRoutes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Notification Routes", fmt.Sprintf("Could not list notification routes: %s", err.Error()))
+		return
+	}
+
+	matched, apps := simulateAlertRouting(routes, alertRoutingLabels(labels, config.Severity.ValueString()), status)
+
+	state := config
+	var diags diag.Diagnostics
+	state.NotificationRoutes, diags = alertRoutingRoutesList(matched)
+	resp.Diagnostics.Append(diags...)
+	state.ConnectedApps, diags = alertRoutingConnectedAppsList(apps)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, fmt.Sprintf("Simulated issue matches %d notification routes notifying %d connected apps", len(matched), len(apps)))
+}
+
+// alertRoutingLabels returns labels with severity added as the severity label,
+// unless labels already sets it.
+func alertRoutingLabels(labels map[string]string, severity string) map[string]string {
+	result := maps.Clone(labels)
+	if result == nil {
+		result = map[string]string{}
+	}
+	if _, ok := result["severity"]; !ok && severity != "" {
+		result["severity"] = severity
+	}
+	return result
+}
+
+// simulateAlertRouting returns the routes whose query matches or may match
+// labels, and the connected apps their rules for status notify.
+func simulateAlertRouting(routes []*models.NotificationRouteListItemResponse, labels map[string]string, status string) ([]alertRoutingRoute, []alertRoutingConnectedApp) {
+	var matched []alertRoutingRoute
+	var apps []alertRoutingConnectedApp
+	seen := map[string]bool{}
+
+	for _, route := range matchingNotificationRoutes(routes, labels) {
+		match := alertRoutingMayMatch
+		if result, err := validators.MatchGcQLQuery(route.Query, labels); err == nil && result == validators.GcQLMatches {
+			match = alertRoutingMatches
+		}
+		matched = append(matched, alertRoutingRoute{id: route.ID, name: route.Name, match: match})
+
+		for _, rule := range route.Routes {
+			if rule == nil || !routeRuleHasStatus(rule, status) {
+				continue
+			}
+			for _, app := range rule.ConnectedApps {
+				if app == nil || seen[app.ID] {
+					continue
+				}
+				seen[app.ID] = true
+				apps = append(apps, alertRoutingConnectedApp{id: app.ID, name: app.Name, appType: app.Type, routeID: route.ID})
+			}
+		}
+	}
+	return matched, apps
+}
+
+func routeRuleHasStatus(rule *models.RouteRuleResponse, status string) bool {
+	for _, s := range rule.Status {
+		if strings.EqualFold(s, status) {
+			return true
+		}
+	}
+	return false
+}
+
+func alertRoutingRoutesList(routes []alertRoutingRoute) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([]attr.Value, 0, len(routes))
+	for _, route := range routes {
+		obj, objDiags := types.ObjectValue(alertRoutingRouteObjectType.AttrTypes, map[string]attr.Value{
+			"id":    types.StringValue(route.id),
+			"name":  types.StringValue(route.name),
+			"match": types.StringValue(route.match),
+		})
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return types.ListNull(alertRoutingRouteObjectType), diags
+		}
+		values = append(values, obj)
+	}
+
+	list, listDiags := types.ListValue(alertRoutingRouteObjectType, values)
+	diags.Append(listDiags...)
+	return list, diags
+}
+
+func alertRoutingConnectedAppsList(apps []alertRoutingConnectedApp) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([]attr.Value, 0, len(apps))
+	for _, app := range apps {
+		obj, objDiags := types.ObjectValue(alertRoutingConnectedAppObjectType.AttrTypes, map[string]attr.Value{
+			"id":       types.StringValue(app.id),
+			"name":     types.StringValue(app.name),
+			"type":     types.StringValue(app.appType),
+			"route_id": types.StringValue(app.routeID),
+		})
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return types.ListNull(alertRoutingConnectedAppObjectType), diags
+		}
+		values = append(values, obj)
+	}
+
+	list, listDiags := types.ListValue(alertRoutingConnectedAppObjectType, values)
+	diags.Append(listDiags...)
+	return list, diags
+}
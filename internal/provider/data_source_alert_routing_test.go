@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+)
+
+func TestSimulateAlertRouting(t *testing.T) {
+	slack := &models.RouteConnectedAppResponse{ID: "slack", Name: "Slack", Type: "slack-webhook"}
+	pager := &models.RouteConnectedAppResponse{ID: "pager", Name: "PagerDuty", Type: "pagerduty"}
+	routes := []*models.NotificationRouteListItemResponse{
+		{ID: "r2", Name: "payments", Query: "team:payments AND severity:S1", Routes: []*models.RouteRuleResponse{
+			{Status: []string{"Alerting"}, ConnectedApps: []*models.RouteConnectedAppResponse{pager, slack}},
+		}},
+		{ID: "r1", Name: "all prod", Query: "env:prod", Routes: []*models.RouteRuleResponse{
+			{Status: []string{"alerting", "Resolved"}, ConnectedApps: []*models.RouteConnectedAppResponse{slack}},
+		}},
+		{ID: "r3", Name: "regional", Query: "region:us-east-1", Routes: []*models.RouteRuleResponse{
+			{Status: []string{"Resolved"}, ConnectedApps: []*models.RouteConnectedAppResponse{pager}},
+		}},
+		{ID: "r4", Name: "staging", Query: "env:staging", Routes: []*models.RouteRuleResponse{
+			{Status: []string{"Alerting"}, ConnectedApps: []*models.RouteConnectedAppResponse{pager}},
+		}},
+		nil,
+	}
+	labels := alertRoutingLabels(map[string]string{"env": "prod", "team": "payments"}, "S1")
+
+	gotRoutes, gotApps := simulateAlertRouting(routes, labels, "Alerting")
+	wantRoutes := []alertRoutingRoute{
+		{id: "r1", name: "all prod", match: alertRoutingMatches},
+		{id: "r2", name: "payments", match: alertRoutingMatches},
+		{id: "r3", name: "regional", match: alertRoutingMayMatch},
+	}
+	if !reflect.DeepEqual(gotRoutes, wantRoutes) {
+		t.Errorf("simulateAlertRouting() routes = %+v, want %+v", gotRoutes, wantRoutes)
+	}
+	wantApps := []alertRoutingConnectedApp{
+		{id: "slack", name: "Slack", appType: "slack-webhook", routeID: "r1"},
+		{id: "pager", name: "PagerDuty", appType: "pagerduty", routeID: "r2"},
+	}
+	if !reflect.DeepEqual(gotApps, wantApps) {
+		t.Errorf("simulateAlertRouting() connected apps = %+v, want %+v", gotApps, wantApps)
+	}
+
+	_, gotApps = simulateAlertRouting(routes, labels, "Resolved")
+	wantApps = []alertRoutingConnectedApp{
+		{id: "slack", name: "Slack", appType: "slack-webhook", routeID: "r1"},
+		{id: "pager", name: "PagerDuty", appType: "pagerduty", routeID: "r3"},
+	}
+	if !reflect.DeepEqual(gotApps, wantApps) {
+		t.Errorf("simulateAlertRouting() resolved connected apps = %+v, want %+v", gotApps, wantApps)
+	}
+}
+
+func TestAlertRoutingLabels(t *testing.T) {
+	if got := alertRoutingLabels(nil, "S2"); !reflect.DeepEqual(got, map[string]string{"severity": "S2"}) {
+		t.Errorf("alertRoutingLabels(nil, S2) = %v", got)
+	}
+	labels := map[string]string{"severity": "S1"}
+	if got := alertRoutingLabels(labels, "S3"); got["severity"] != "S1" {
+		t.Errorf("alertRoutingLabels() overrode the severity label: %v", got)
+	}
+}
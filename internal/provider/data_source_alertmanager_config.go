@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &alertmanagerConfigDataSource{}
+
+func NewAlertmanagerConfigDataSource() datasource.DataSource {
+	return &alertmanagerConfigDataSource{}
+}
+
+type alertmanagerConfigDataSource struct{}
+
+type alertmanagerConfigDataSourceModel struct {
+	Config             types.String `tfsdk:"config"`
+	ConnectedApps      types.List   `tfsdk:"connected_apps"`      // List of alertmanagerConnectedAppObjectType
+	NotificationRoutes types.List   `tfsdk:"notification_routes"` // List of alertmanagerNotificationRouteObjectType
+	Warnings           types.List   `tfsdk:"warnings"`
+}
+
+var alertmanagerConnectedAppObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":     types.StringType,
+		"type":     types.StringType,
+		"receiver": types.StringType,
+		"data":     types.MapType{ElemType: types.StringType},
+	},
+}
+
+var alertmanagerRouteAppObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name": types.StringType,
+		"type": types.StringType,
+	},
+}
+
+var alertmanagerRouteRuleObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"status":         types.ListType{ElemType: types.StringType},
+		"connected_apps": types.ListType{ElemType: alertmanagerRouteAppObjectType},
+	},
+}
+
+var alertmanagerNotificationRouteObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":                    types.StringType,
+		"query":                   types.StringType,
+		"receiver":                types.StringType,
+		"routes":                  types.ListType{ElemType: alertmanagerRouteRuleObjectType},
+		"renotification_interval": types.StringType,
+	},
+}
+
+func (d *alertmanagerConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alertmanager_config"
+}
+
+func (d *alertmanagerConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Converts an Alertmanager configuration into the arguments of `groundcover_connected_app` and `groundcover_notification_route` resources, to port existing alert routing. " +
+			"Each receiver integration with a groundcover counterpart (Slack, PagerDuty, Opsgenie, webhook, and Microsoft Teams) becomes a connected app, and each node of the routing tree that notifies a receiver becomes a notification route. " +
+			"Alertmanager stops at the first matching route unless it sets `continue`, while groundcover evaluates every notification route, so each `query` excludes the alerts earlier sibling routes and child routes take. " +
+			"The conversion runs in the provider without API calls.",
+		Attributes: map[string]schema.Attribute{
+			"config": schema.StringAttribute{
+				Description: "The Alertmanager configuration YAML, such as the contents of `alertmanager.yml`. Only `global`, `route`, `receivers`, and `inhibit_rules` are read.",
+				Required:    true,
+			},
+			"connected_apps": schema.ListNestedAttribute{
+				Description: "The connected apps converted from the receivers, in receiver order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The connected app name: the receiver name, suffixed with the type and position when the receiver has several integrations.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The connected app type: `slack-webhook`, `pagerduty`, `opsgenie`, `webhook`, or `ms-teams`.",
+							Computed:    true,
+						},
+						"receiver": schema.StringAttribute{
+							Description: "The Alertmanager receiver the connected app was converted from.",
+							Computed:    true,
+						},
+						"data": schema.MapAttribute{
+							Description: "The connected app `data`, such as `url` or `routing_key`, taken from the integration and the `global` section.",
+							ElementType: types.StringType,
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"notification_routes": schema.ListNestedAttribute{
+				Description: "The notification routes converted from the routing tree, parents after their children.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The route name: the receiver name, suffixed with a number when several routes notify the receiver.",
+							Computed:    true,
+						},
+						"query": schema.StringAttribute{
+							Description: "The gcQL query matching the alerts the Alertmanager route receives.",
+							Computed:    true,
+						},
+						"receiver": schema.StringAttribute{
+							Description: "The Alertmanager receiver the route notifies.",
+							Computed:    true,
+						},
+						"routes": schema.ListNestedAttribute{
+							Description: "The routing rules, for `groundcover_notification_route` `routes`. Integrations with `send_resolved` are notified for `Alerting` and `Resolved`, the others for `Alerting` only.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"status": schema.ListAttribute{
+										Description: "The issue statuses of the rule.",
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+									"connected_apps": schema.ListNestedAttribute{
+										Description: "The connected apps the rule notifies, by the `name` they have in `connected_apps`.",
+										Computed:    true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description: "The connected app name.",
+													Computed:    true,
+												},
+												"type": schema.StringAttribute{
+													Description: "The connected app type.",
+													Computed:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"renotification_interval": schema.StringAttribute{
+							Description: "The route's `repeat_interval`, inherited from its parents, for `notification_settings.renotification_interval`. Null when unset.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"warnings": schema.ListAttribute{
+				Description: "Parts of the configuration that were not converted or not converted exactly, such as regular expression matchers, time intervals, inhibition rules, and unsupported integrations.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *alertmanagerConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config alertmanagerConfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conversion, err := convertAlertmanagerConfig(config.Config.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config"),
+			"Error Converting Alertmanager Configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	var diags diag.Diagnostics
+	config.ConnectedApps, diags = alertmanagerConnectedAppsList(ctx, conversion.connectedApps)
+	resp.Diagnostics.Append(diags...)
+	config.NotificationRoutes, diags = alertmanagerNotificationRoutesList(ctx, conversion.notificationRoutes)
+	resp.Diagnostics.Append(diags...)
+	warnings := conversion.warnings
+	if warnings == nil {
+		warnings = []string{}
+	}
+	config.Warnings, diags = types.ListValueFrom(ctx, types.StringType, warnings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Debug(ctx, fmt.Sprintf("Converted Alertmanager configuration into %d connected apps and %d notification routes with %d warnings",
+		len(conversion.connectedApps), len(conversion.notificationRoutes), len(conversion.warnings)))
+}
+
+func alertmanagerConnectedAppsList(ctx context.Context, apps []alertmanagerConnectedApp) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([]attr.Value, 0, len(apps))
+	for _, app := range apps {
+		data, dataDiags := types.MapValueFrom(ctx, types.StringType, app.data)
+		diags.Append(dataDiags...)
+		obj, objDiags := types.ObjectValue(alertmanagerConnectedAppObjectType.AttrTypes, map[string]attr.Value{
+			"name":     types.StringValue(app.name),
+			"type":     types.StringValue(app.appType),
+			"receiver": types.StringValue(app.receiver),
+			"data":     data,
+		})
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return types.ListNull(alertmanagerConnectedAppObjectType), diags
+		}
+		values = append(values, obj)
+	}
+
+	list, listDiags := types.ListValue(alertmanagerConnectedAppObjectType, values)
+	diags.Append(listDiags...)
+	return list, diags
+}
+
+func alertmanagerNotificationRoutesList(ctx context.Context, routes []alertmanagerNotificationRoute) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([]attr.Value, 0, len(routes))
+	for _, route := range routes {
+		rules := make([]attr.Value, 0, len(route.rules))
+		for _, rule := range route.rules {
+			apps := make([]attr.Value, 0, len(rule.connectedApps))
+			for _, app := range rule.connectedApps {
+				obj, objDiags := types.ObjectValue(alertmanagerRouteAppObjectType.AttrTypes, map[string]attr.Value{
+					"name": types.StringValue(app.name),
+					"type": types.StringValue(app.appType),
+				})
+				diags.Append(objDiags...)
+				apps = append(apps, obj)
+			}
+			status, statusDiags := types.ListValueFrom(ctx, types.StringType, rule.status)
+			diags.Append(statusDiags...)
+			appsList, appsDiags := types.ListValue(alertmanagerRouteAppObjectType, apps)
+			diags.Append(appsDiags...)
+			obj, objDiags := types.ObjectValue(alertmanagerRouteRuleObjectType.AttrTypes, map[string]attr.Value{
+				"status":         status,
+				"connected_apps": appsList,
+			})
+			diags.Append(objDiags...)
+			rules = append(rules, obj)
+		}
+		if diags.HasError() {
+			return types.ListNull(alertmanagerNotificationRouteObjectType), diags
+		}
+
+		rulesList, rulesDiags := types.ListValue(alertmanagerRouteRuleObjectType, rules)
+		diags.Append(rulesDiags...)
+		renotificationInterval := types.StringNull()
+		if route.renotificationInterval != "" {
+			renotificationInterval = types.StringValue(route.renotificationInterval)
+		}
+		obj, objDiags := types.ObjectValue(alertmanagerNotificationRouteObjectType.AttrTypes, map[string]attr.Value{
+			"name":                    types.StringValue(route.name),
+			"query":                   types.StringValue(route.query),
+			"receiver":                types.StringValue(route.receiver),
+			"routes":                  rulesList,
+			"renotification_interval": renotificationInterval,
+		})
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return types.ListNull(alertmanagerNotificationRouteObjectType), diags
+		}
+		values = append(values, obj)
+	}
+
+	list, listDiags := types.ListValue(alertmanagerNotificationRouteObjectType, values)
+	diags.Append(listDiags...)
+	return list, diags
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+)
+
+var (
+	_ datasource.DataSource              = &apiKeyDataSource{}
+	_ datasource.DataSourceWithConfigure = &apiKeyDataSource{}
+)
+
+func NewApiKeyDataSource() datasource.DataSource {
+	return &apiKeyDataSource{}
+}
+
+type apiKeyDataSource struct {
+	client ApiClient
+}
+
+type apiKeyDataSourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	ServiceAccountId   types.String `tfsdk:"service_account_id"`
+	ServiceAccountName types.String `tfsdk:"service_account_name"`
+	CreatedBy          types.String `tfsdk:"created_by"`
+	CreationDate       types.String `tfsdk:"creation_date"`
+	LastActive         types.String `tfsdk:"last_active"`
+	ExpiredAt          types.String `tfsdk:"expired_at"`
+	RevokedAt          types.String `tfsdk:"revoked_at"`
+	Policies           types.List   `tfsdk:"policies"` // List of policyMetadataModel
+}
+
+func (d *apiKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apikey"
+}
+
+func (d *apiKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the metadata of an existing API key by name. The key's secret value is never returned. Active keys are searched first; revoked and expired keys are only considered when no active key has the name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the API key to look up.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for the API key.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the API key.",
+				Computed:    true,
+			},
+			"service_account_id": schema.StringAttribute{
+				Description: "The ID of the service account associated with the API key.",
+				Computed:    true,
+			},
+			"service_account_name": schema.StringAttribute{
+				Description: "The name of the service account associated with the API key.",
+				Computed:    true,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "The user who created the API key.",
+				Computed:    true,
+			},
+			"creation_date": schema.StringAttribute{
+				Description: "The date the API key was created (RFC3339 format).",
+				Computed:    true,
+			},
+			"last_active": schema.StringAttribute{
+				Description: "The last time the API key was active (RFC3339 format).",
+				Computed:    true,
+			},
+			"expired_at": schema.StringAttribute{
+				Description: "The expiry date of the API key (RFC3339 format), if it has one. May be in the future.",
+				Computed:    true,
+			},
+			"revoked_at": schema.StringAttribute{
+				Description: "The date the API key was revoked (RFC3339 format), if applicable.",
+				Computed:    true,
+			},
+			"policies": schema.ListNestedAttribute{
+				Description: "Policies associated with the service account linked to this API key.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Description: "Policy UUID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Policy name.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *apiKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *apiKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config apiKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := config.Name.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Looking up API Key by name: %s", name))
+
+	key, err := d.findApiKeyByName(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Error Looking Up API Key", err.Error())
+		return
+	}
+
+	state := apiKeyDataSourceModel{
+		Id:                 types.StringValue(key.ID),
+		Name:               types.StringValue(key.Name),
+		Description:        types.StringValue(key.Description),
+		ServiceAccountId:   types.StringValue(key.ServiceAccountID),
+		ServiceAccountName: types.StringValue(key.ServiceAccountName),
+		CreatedBy:          types.StringValue(key.CreatedBy),
+		CreationDate:       types.StringValue(key.CreationDate.String()),
+		LastActive:         optionalDateTimeString(key.LastActive),
+		ExpiredAt:          optionalDateTimeString(key.ExpiredAt),
+		RevokedAt:          optionalDateTimeString(key.RevokedAt),
+	}
+
+	policiesList, diags := apiKeyPoliciesList(key.Policies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Policies = policiesList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, fmt.Sprintf("Found API Key %s for name %s", key.ID, name))
+}
+
+// findApiKeyByName returns the API key named name. Active keys take precedence;
+// revoked and expired keys are only searched when no active key matches. More
+// than one match in the same list is an error, since the lookup would be
+// ambiguous.
+func (d *apiKeyDataSource) findApiKeyByName(ctx context.Context, name string) (*models.ListAPIKeysResponseItem, error) {
+	activeKeys, err := d.client.ListApiKeys(ctx, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not list API keys: %w", err)
+	}
+	key, err := apiKeyByName(activeKeys, name)
+	if key != nil || err != nil {
+		return key, err
+	}
+
+	withRevoked := true
+	withExpired := true
+	allKeys, err := d.client.ListApiKeys(ctx, &withRevoked, &withExpired)
+	if err != nil {
+		return nil, fmt.Errorf("could not list API keys with filters: %w", err)
+	}
+	key, err = apiKeyByName(allKeys, name)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, fmt.Errorf("no API key named %q was found (checked active, revoked, and expired keys)", name)
+	}
+	return key, nil
+}
+
+// apiKeyByName returns the single key in keys named name, nil when there is
+// none, or an error when several keys share the name.
+func apiKeyByName(keys []*models.ListAPIKeysResponseItem, name string) (*models.ListAPIKeysResponseItem, error) {
+	var found *models.ListAPIKeysResponseItem
+	for _, key := range keys {
+		if key == nil || key.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("more than one API key is named %q (IDs %s and %s); API key names must be unique to be looked up", name, found.ID, key.ID)
+		}
+		found = key
+	}
+	return found, nil
+}
+
+// optionalDateTimeString returns the RFC3339 form of t, or null when t is unset.
+func optionalDateTimeString(t strfmt.DateTime) types.String {
+	if t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.String())
+}
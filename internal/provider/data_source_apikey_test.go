@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApiKeyDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-apikey-ds")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiKeyResourceConfig(name) + `
+data "groundcover_apikey" "test" {
+  name = groundcover_apikey.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.groundcover_apikey.test", "id", "groundcover_apikey.test", "id"),
+					resource.TestCheckResourceAttrPair("data.groundcover_apikey.test", "service_account_id", "groundcover_apikey.test", "service_account_id"),
+					resource.TestCheckResourceAttr("data.groundcover_apikey.test", "service_account_name", name+"-sa"),
+					resource.TestCheckResourceAttr("data.groundcover_apikey.test", "policies.#", "1"),
+					resource.TestCheckNoResourceAttr("data.groundcover_apikey.test", "api_key"),
+				),
+			},
+		},
+	})
+}
+
+// listingApiKeyClient serves ListApiKeys from separate active and all-keys lists.
+type listingApiKeyClient struct {
+	ApiClient
+	active []*models.ListAPIKeysResponseItem
+	all    []*models.ListAPIKeysResponseItem
+}
+
+func (c *listingApiKeyClient) ListApiKeys(_ context.Context, withRevoked *bool, withExpired *bool) ([]*models.ListAPIKeysResponseItem, error) {
+	if withRevoked != nil && *withRevoked || withExpired != nil && *withExpired {
+		return c.all, nil
+	}
+	return c.active, nil
+}
+
+func TestApiKeyDataSourceFindApiKeyByName(t *testing.T) {
+	active := &models.ListAPIKeysResponseItem{ID: "active", Name: "ci"}
+	revoked := &models.ListAPIKeysResponseItem{ID: "revoked", Name: "ci"}
+	oldOnly := &models.ListAPIKeysResponseItem{ID: "old", Name: "legacy"}
+	duplicate := &models.ListAPIKeysResponseItem{ID: "duplicate", Name: "ci"}
+
+	tests := []struct {
+		name    string
+		client  *listingApiKeyClient
+		lookup  string
+		wantID  string
+		wantErr string
+	}{
+		{
+			name:   "active key wins over revoked key with the same name",
+			client: &listingApiKeyClient{active: []*models.ListAPIKeysResponseItem{active}, all: []*models.ListAPIKeysResponseItem{active, revoked}},
+			lookup: "ci",
+			wantID: "active",
+		},
+		{
+			name:   "falls back to revoked and expired keys",
+			client: &listingApiKeyClient{active: []*models.ListAPIKeysResponseItem{active}, all: []*models.ListAPIKeysResponseItem{active, oldOnly}},
+			lookup: "legacy",
+			wantID: "old",
+		},
+		{
+			name:    "ambiguous name",
+			client:  &listingApiKeyClient{active: []*models.ListAPIKeysResponseItem{active, duplicate}},
+			lookup:  "ci",
+			wantErr: "more than one API key",
+		},
+		{
+			name:    "not found",
+			client:  &listingApiKeyClient{active: []*models.ListAPIKeysResponseItem{active}, all: []*models.ListAPIKeysResponseItem{active}},
+			lookup:  "missing",
+			wantErr: "no API key named",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &apiKeyDataSource{client: tt.client}
+			key, err := d.findApiKeyByName(context.Background(), tt.lookup)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if key.ID != tt.wantID {
+				t.Fatalf("expected key %q, got %q", tt.wantID, key.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+)
+
+var (
+	_ datasource.DataSource              = &connectedAppDataSource{}
+	_ datasource.DataSourceWithConfigure = &connectedAppDataSource{}
+)
+
+func NewConnectedAppDataSource() datasource.DataSource {
+	return &connectedAppDataSource{}
+}
+
+type connectedAppDataSource struct {
+	client ApiClient
+}
+
+type connectedAppDataSourceModel struct {
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	ID        types.String `tfsdk:"id"`
+	CreatedBy types.String `tfsdk:"created_by"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *connectedAppDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connected_app"
+}
+
+func (d *connectedAppDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing connected app by name, and optionally type, so notification routes can reference an app managed by another team or workspace without a hard-coded ID. " +
+			"The lookup fails when no app, or more than one app, matches. The app's `data` is not exported.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the connected app (exact match).",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the connected app, such as `slack-webhook` or `pagerduty`. Set it when apps of different types share the name. When unset, it is computed from the matching app.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the connected app.",
+				Computed:    true,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "The user who created the connected app.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the connected app was created, in RFC 3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *connectedAppDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *connectedAppDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config connectedAppDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := config.Name.ValueString()
+	appType := config.Type.ValueString()
+
+	// The list query only narrows by type: its free-text name search is not
+	// an exact match, so names are compared by connectedAppByName.
+	listReq := &models.ListConnectedAppsRequest{}
+	if appType != "" {
+		listReq.Query = "type:" + appType
+	}
+	apps, err := d.client.ListConnectedApps(ctx, listReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Connected Apps", fmt.Sprintf("Could not list connected apps to resolve %q: %s", name, err.Error()))
+		return
+	}
+
+	app, err := connectedAppByName(apps, name, appType)
+	if err != nil {
+		resp.Diagnostics.AddError("Cannot Resolve Connected App", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(app.ID)
+	config.Type = types.StringValue(app.Type)
+	config.CreatedBy = types.StringValue(app.CreatedBy)
+	config.CreatedAt = types.StringValue(time.Time(app.CreatedAt).UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Debug(ctx, fmt.Sprintf("Resolved connected app %q to ID %s", name, app.ID))
+}
+
+// connectedAppByName returns the only app named name, and of type appType
+// when it is not empty. Names are matched exactly.
+func connectedAppByName(apps []*models.ConnectedAppListItemWithRoutesResponse, name, appType string) (*models.ConnectedAppListItemWithRoutesResponse, error) {
+	var matched []*models.ConnectedAppListItemWithRoutesResponse
+	for _, app := range apps {
+		if app == nil || app.Name != name {
+			continue
+		}
+		if appType != "" && app.Type != appType {
+			continue
+		}
+		matched = append(matched, app)
+	}
+
+	switch len(matched) {
+	case 0:
+		if appType != "" {
+			return nil, fmt.Errorf("no connected app of type %q is named %q", appType, name)
+		}
+		return nil, fmt.Errorf("no connected app is named %q", name)
+	case 1:
+		return matched[0], nil
+	default:
+		var found []string
+		for _, app := range matched {
+			found = append(found, fmt.Sprintf("%s (%s)", app.ID, app.Type))
+		}
+		hint := "set type to choose between them"
+		if appType != "" {
+			hint = "reference the app by ID instead"
+		}
+		return nil, fmt.Errorf("%d connected apps are named %q: %s; %s", len(matched), name, strings.Join(found, ", "), hint)
+	}
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConnectedAppDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-app-lookup")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationRouteConfig_basic(name) + `
+data "groundcover_connected_app" "test" {
+  name = groundcover_connected_app.test.name
+  type = groundcover_connected_app.test.type
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.groundcover_connected_app.test", "id", "groundcover_connected_app.test", "id"),
+					resource.TestCheckResourceAttrSet("data.groundcover_connected_app.test", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestConnectedAppByName(t *testing.T) {
+	apps := []*models.ConnectedAppListItemWithRoutesResponse{
+		{ID: "a1", Name: "alerts", Type: "slack-webhook"},
+		{ID: "a2", Name: "alerts", Type: "pagerduty"},
+		{ID: "a3", Name: "oncall", Type: "pagerduty"},
+		{ID: "a4", Name: "oncall-eu", Type: "pagerduty"},
+		nil,
+	}
+
+	tests := []struct {
+		name    string
+		appName string
+		appType string
+		wantID  string
+		wantErr string
+	}{
+		{name: "unique name", appName: "oncall", wantID: "a3"},
+		{name: "name and type", appName: "alerts", appType: "pagerduty", wantID: "a2"},
+		{name: "ambiguous name", appName: "alerts", wantErr: "set type to choose between them"},
+		{name: "unknown name", appName: "oncall-us", wantErr: `no connected app is named "oncall-us"`},
+		{name: "wrong type", appName: "oncall", appType: "slack-webhook", wantErr: `no connected app of type "slack-webhook"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, err := connectedAppByName(apps, tt.appName, tt.appType)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("connectedAppByName() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("connectedAppByName() error = %v", err)
+			}
+			if app.ID != tt.wantID {
+				t.Fatalf("connectedAppByName() = %s, want %s", app.ID, tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+)
+
+var (
+	_ datasource.DataSource              = &connectedAppUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &connectedAppUsageDataSource{}
+)
+
+func NewConnectedAppUsageDataSource() datasource.DataSource {
+	return &connectedAppUsageDataSource{}
+}
+
+type connectedAppUsageDataSource struct {
+	client ApiClient
+}
+
+type connectedAppUsageDataSourceModel struct {
+	ConnectedAppId     types.String `tfsdk:"connected_app_id"`
+	NotificationRoutes types.List   `tfsdk:"notification_routes"` // List of connectedAppReferenceObjectType
+	Workflows          types.List   `tfsdk:"workflows"`           // List of connectedAppReferenceObjectType
+	InUse              types.Bool   `tfsdk:"in_use"`
+}
+
+var connectedAppReferenceObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	},
+}
+
+// connectedAppReference is a notification route or workflow that uses a connected app.
+type connectedAppReference struct {
+	id   string
+	name string
+}
+
+func (d *connectedAppUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connected_app_usage"
+}
+
+func (d *connectedAppUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	referenceAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the referencing object.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the referencing object.",
+			Computed:    true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the notification routes and workflows that reference a connected app. A connected app that is still referenced cannot be deleted, so this data source can back a `precondition` that fails with a readable message before the API rejects the delete.",
+		Attributes: map[string]schema.Attribute{
+			"connected_app_id": schema.StringAttribute{
+				Description: "The ID of the connected app.",
+				Required:    true,
+			},
+			"notification_routes": schema.ListNestedAttribute{
+				Description:  "Notification routes with at least one rule that delivers to the connected app.",
+				Computed:     true,
+				NestedObject: schema.NestedAttributeObject{Attributes: referenceAttributes},
+			},
+			"workflows": schema.ListNestedAttribute{
+				Description:  "Workflows that use the connected app, either as one of their providers or by ID in their definition.",
+				Computed:     true,
+				NestedObject: schema.NestedAttributeObject{Attributes: referenceAttributes},
+			},
+			"in_use": schema.BoolAttribute{
+				Description: "Whether any notification route or workflow references the connected app.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *connectedAppUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *connectedAppUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config connectedAppUsageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := config.ConnectedAppId.ValueString()
+	tflog.Debug(ctx, fmt.Sprintf("Looking up references to connected app: %s", appID))
+
+	routes, err := d.client.ListNotificationRoutes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Notification Routes", fmt.Sprintf("Could not list notification routes: %s", err.Error()))
+		return
+	}
+	workflows, err := d.client.ListWorkflows(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Workflows", fmt.Sprintf("Could not list workflows: %s", err.Error()))
+		return
+	}
+
+	routeRefs := notificationRoutesReferencingConnectedApp(routes, appID)
+	workflowRefs := workflowsReferencingConnectedApp(workflows, appID)
+
+	state := connectedAppUsageDataSourceModel{
+		ConnectedAppId: config.ConnectedAppId,
+		InUse:          types.BoolValue(len(routeRefs)+len(workflowRefs) > 0),
+	}
+	var diags diag.Diagnostics
+	state.NotificationRoutes, diags = connectedAppReferencesList(routeRefs)
+	resp.Diagnostics.Append(diags...)
+	state.Workflows, diags = connectedAppReferencesList(workflowRefs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, fmt.Sprintf("Connected app %s is referenced by %d notification routes and %d workflows", appID, len(routeRefs), len(workflowRefs)))
+}
+
+// notificationRoutesReferencingConnectedApp returns the routes with a rule
+// delivering to appID.
+func notificationRoutesReferencingConnectedApp(routes []*models.NotificationRouteListItemResponse, appID string) []connectedAppReference {
+	var refs []connectedAppReference
+	for _, route := range routes {
+		if route == nil {
+			continue
+		}
+	rules:
+		for _, rule := range route.Routes {
+			if rule == nil {
+				continue
+			}
+			for _, app := range rule.ConnectedApps {
+				if app != nil && app.ID == appID {
+					refs = append(refs, connectedAppReference{id: route.ID, name: route.Name})
+					break rules
+				}
+			}
+		}
+	}
+	return refs
+}
+
+// workflowsReferencingConnectedApp returns the workflows that list appID as a
+// provider or mention it in their raw definition.
+func workflowsReferencingConnectedApp(workflows []*models.Workflow, appID string) []connectedAppReference {
+	var refs []connectedAppReference
+	for _, workflow := range workflows {
+		if workflow == nil {
+			continue
+		}
+		referenced := appID != "" && strings.Contains(workflow.WorkflowRaw, appID)
+		for _, p := range workflow.Providers {
+			if p != nil && p.ID != nil && fmt.Sprint(p.ID) == appID {
+				referenced = true
+				break
+			}
+		}
+		if referenced {
+			refs = append(refs, connectedAppReference{id: workflow.ID, name: workflow.Name})
+		}
+	}
+	return refs
+}
+
+func connectedAppReferencesList(refs []connectedAppReference) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([]attr.Value, 0, len(refs))
+	for _, ref := range refs {
+		obj, objDiags := types.ObjectValue(connectedAppReferenceObjectType.AttrTypes, map[string]attr.Value{
+			"id":   types.StringValue(ref.id),
+			"name": types.StringValue(ref.name),
+		})
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return types.ListNull(connectedAppReferenceObjectType), diags
+		}
+		values = append(values, obj)
+	}
+
+	list, listDiags := types.ListValue(connectedAppReferenceObjectType, values)
+	diags.Append(listDiags...)
+	return list, diags
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConnectedAppUsageDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-app-usage")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationRouteConfig_basic(name) + `
+data "groundcover_connected_app_usage" "test" {
+  connected_app_id = groundcover_connected_app.test.id
+
+  depends_on = [groundcover_notification_route.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.groundcover_connected_app_usage.test", "in_use", "true"),
+					resource.TestCheckResourceAttr("data.groundcover_connected_app_usage.test", "notification_routes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.groundcover_connected_app_usage.test", "notification_routes.0.id", "groundcover_notification_route.test", "id"),
+					resource.TestCheckResourceAttr("data.groundcover_connected_app_usage.test", "notification_routes.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestNotificationRoutesReferencingConnectedApp(t *testing.T) {
+	routes := []*models.NotificationRouteListItemResponse{
+		{ID: "r1", Name: "uses app in two rules", Routes: []*models.RouteRuleResponse{
+			{ConnectedApps: []*models.RouteConnectedAppResponse{{ID: "app"}}},
+			{ConnectedApps: []*models.RouteConnectedAppResponse{{ID: "other"}, {ID: "app"}}},
+		}},
+		{ID: "r2", Name: "other app only", Routes: []*models.RouteRuleResponse{
+			{ConnectedApps: []*models.RouteConnectedAppResponse{{ID: "other"}}},
+		}},
+		nil,
+	}
+
+	got := notificationRoutesReferencingConnectedApp(routes, "app")
+	want := []connectedAppReference{{id: "r1", name: "uses app in two rules"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("notificationRoutesReferencingConnectedApp() = %+v, want %+v", got, want)
+	}
+}
+
+func TestWorkflowsReferencingConnectedApp(t *testing.T) {
+	workflows := []*models.Workflow{
+		{ID: "w1", Name: "provider", Providers: []*models.Provider{{ID: "app", Name: "slack"}}},
+		{ID: "w2", Name: "raw", WorkflowRaw: "actions:\n  - provider:\n      config: '{{ providers.app }}'\n"},
+		{ID: "w3", Name: "unrelated", Providers: []*models.Provider{{ID: 42}}, WorkflowRaw: "name: unrelated"},
+	}
+
+	got := workflowsReferencingConnectedApp(workflows, "app")
+	want := []connectedAppReference{{id: "w1", name: "provider"}, {id: "w2", name: "raw"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("workflowsReferencingConnectedApp() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+)
+
+var (
+	_ datasource.DataSource              = &dashboardsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dashboardsDataSource{}
+)
+
+func NewDashboardsDataSource() datasource.DataSource {
+	return &dashboardsDataSource{}
+}
+
+type dashboardsDataSource struct {
+	client ApiClient
+}
+
+type dashboardsDataSourceModel struct {
+	Team       types.String `tfsdk:"team"`
+	Owner      types.String `tfsdk:"owner"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Dashboards types.List   `tfsdk:"dashboards"` // List of dashboardSummaryObjectType
+}
+
+var dashboardSummaryObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"uuid":            types.StringType,
+		"name":            types.StringType,
+		"description":     types.StringType,
+		"team":            types.StringType,
+		"owner":           types.StringType,
+		"status":          types.StringType,
+		"revision_number": types.Int64Type,
+	},
+}
+
+// dashboardFilter holds the optional filters of the data source. Empty fields
+// match every dashboard.
+type dashboardFilter struct {
+	team       string
+	owner      string
+	namePrefix string
+}
+
+func (d *dashboardsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboards"
+}
+
+func (d *dashboardsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the organization's dashboards, optionally filtered by team, owner, or name prefix. Dashboards are sorted by name, then UUID, so the result is stable for `for_each` and outputs.",
+		Attributes: map[string]schema.Attribute{
+			"team": schema.StringAttribute{
+				Description: "Only return dashboards assigned to this team (exact match).",
+				Optional:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "Only return dashboards owned by this user (exact match).",
+				Optional:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return dashboards whose name starts with this prefix (case-sensitive).",
+				Optional:    true,
+			},
+			"dashboards": schema.ListNestedAttribute{
+				Description: "The dashboards that match every filter that is set.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Description: "The UUID of the dashboard.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the dashboard.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the dashboard.",
+							Computed:    true,
+						},
+						"team": schema.StringAttribute{
+							Description: "The team the dashboard is assigned to.",
+							Computed:    true,
+						},
+						"owner": schema.StringAttribute{
+							Description: "The owner of the dashboard.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the dashboard.",
+							Computed:    true,
+						},
+						"revision_number": schema.Int64Attribute{
+							Description: "The current revision number of the dashboard.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dashboardsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *dashboardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config dashboardsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all, err := d.client.ListDashboards(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Dashboards", fmt.Sprintf("Could not list dashboards: %s", err.Error()))
+		return
+	}
+
+	filter := dashboardFilter{
+		team:       config.Team.ValueString(),
+		owner:      config.Owner.ValueString(),
+		namePrefix: config.NamePrefix.ValueString(),
+	}
+	matched := filterDashboards(all, filter)
+
+	var diags diag.Diagnostics
+	config.Dashboards, diags = dashboardSummariesList(matched)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Debug(ctx, fmt.Sprintf("Dashboards data source matched %d of %d dashboards", len(matched), len(all)))
+}
+
+// filterDashboards returns the dashboards matching filter, sorted by name and
+// then UUID.
+func filterDashboards(dashboards []*models.View, filter dashboardFilter) []*models.View {
+	var matched []*models.View
+	for _, dashboard := range dashboards {
+		if dashboard == nil {
+			continue
+		}
+		if filter.team != "" && dashboard.Team != filter.team {
+			continue
+		}
+		if filter.owner != "" && dashboard.Owner != filter.owner {
+			continue
+		}
+		if !strings.HasPrefix(dashboard.Name, filter.namePrefix) {
+			continue
+		}
+		matched = append(matched, dashboard)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		if matched[i].Name != matched[j].Name {
+			return matched[i].Name < matched[j].Name
+		}
+		return matched[i].UUID < matched[j].UUID
+	})
+	return matched
+}
+
+func dashboardSummariesList(dashboards []*models.View) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([]attr.Value, 0, len(dashboards))
+	for _, dashboard := range dashboards {
+		obj, objDiags := types.ObjectValue(dashboardSummaryObjectType.AttrTypes, map[string]attr.Value{
+			"uuid":            types.StringValue(dashboard.UUID),
+			"name":            types.StringValue(dashboard.Name),
+			"description":     types.StringValue(dashboard.Description),
+			"team":            types.StringValue(dashboard.Team),
+			"owner":           types.StringValue(dashboard.Owner),
+			"status":          types.StringValue(dashboard.Status),
+			"revision_number": types.Int64Value(int64(dashboard.RevisionNumber)),
+		})
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return types.ListNull(dashboardSummaryObjectType), diags
+		}
+		values = append(values, obj)
+	}
+
+	list, listDiags := types.ListValue(dashboardSummaryObjectType, values)
+	diags.Append(listDiags...)
+	return list, diags
+}
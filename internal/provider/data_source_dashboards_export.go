@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+)
+
+// dashboardExportConcurrency bounds the parallel GETs issued for dashboards
+// whose preset the list omits.
+const dashboardExportConcurrency = 10
+
+var (
+	_ datasource.DataSource              = &dashboardsExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &dashboardsExportDataSource{}
+)
+
+func NewDashboardsExportDataSource() datasource.DataSource {
+	return &dashboardsExportDataSource{}
+}
+
+type dashboardsExportDataSource struct {
+	client ApiClient
+}
+
+type dashboardsExportDataSourceModel struct {
+	Team       types.String `tfsdk:"team"`
+	Owner      types.String `tfsdk:"owner"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Dashboards types.Map    `tfsdk:"dashboards"`
+}
+
+func (d *dashboardsExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboards_export"
+}
+
+func (d *dashboardsExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exports the preset JSON of the organization's dashboards, all of them or those matching the same filters as `groundcover_dashboards`, for backups and for promoting dashboards between tenants: export from one provider configuration and pass each preset as `preset` of a `groundcover_dashboard` managed through another. " +
+			"Pair it with `groundcover_dashboards` for the names and teams of the exported dashboards. " +
+			"A dashboard deleted while the export runs is left out; any other failure fails the read, so an export is never silently incomplete.",
+		Attributes: map[string]schema.Attribute{
+			"team": schema.StringAttribute{
+				Description: "Only export dashboards assigned to this team (exact match).",
+				Optional:    true,
+			},
+			"owner": schema.StringAttribute{
+				Description: "Only export dashboards owned by this user (exact match).",
+				Optional:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only export dashboards whose name starts with this prefix (case-sensitive).",
+				Optional:    true,
+			},
+			"dashboards": schema.MapAttribute{
+				Description: "The preset JSON of each exported dashboard, as the API returns it, keyed by dashboard UUID.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dashboardsExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *dashboardsExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config dashboardsExportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all, err := d.client.ListDashboards(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Dashboards", fmt.Sprintf("Could not list dashboards: %s", err.Error()))
+		return
+	}
+	matched := filterDashboards(all, dashboardFilter{
+		team:       config.Team.ValueString(),
+		owner:      config.Owner.ValueString(),
+		namePrefix: config.NamePrefix.ValueString(),
+	})
+
+	exported, err := exportDashboards(ctx, d.client, matched)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Exporting Dashboards", err.Error())
+		return
+	}
+
+	values := make(map[string]attr.Value, len(exported))
+	for uuid, preset := range exported {
+		values[uuid] = types.StringValue(preset)
+	}
+	dashboards, diags := types.MapValue(types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Dashboards = dashboards
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Debug(ctx, fmt.Sprintf("Dashboards export data source exported %d of %d dashboards", len(exported), len(all)))
+}
+
+// exportDashboards returns the preset of each dashboard, keyed by UUID. The
+// preset is taken from the list when it carries one, and otherwise fetched, at
+// most dashboardExportConcurrency at a time. Dashboards deleted since they were
+// listed are skipped; any other error is returned.
+func exportDashboards(ctx context.Context, client ApiClient, dashboards []*models.View) (map[string]string, error) {
+	exported := make(map[string]string, len(dashboards))
+	var mu sync.Mutex
+	var errs []error
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, dashboardExportConcurrency)
+	for _, dashboard := range dashboards {
+		if dashboard.UUID == "" {
+			continue
+		}
+		if dashboard.Preset != "" {
+			mu.Lock()
+			exported[dashboard.UUID] = dashboard.Preset
+			mu.Unlock()
+			continue
+		}
+		uuid := dashboard.UUID
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			view, err := client.GetDashboard(ctx, uuid)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case errors.Is(err, ErrNotFound):
+				tflog.Debug(ctx, "Dashboards export: skipping dashboard deleted since it was listed", map[string]any{"uuid": uuid})
+			case err != nil:
+				errs = append(errs, fmt.Errorf("dashboard %s: %w", uuid, err))
+			case view != nil:
+				exported[uuid] = view.Preset
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("could not export %d of %d dashboards: %w", len(errs), len(dashboards), errors.Join(errs...))
+	}
+	return exported, nil
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccDashboardsExportDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-dashboards-export")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardResourceConfig(name) + fmt.Sprintf(`
+data "groundcover_dashboards_export" "test" {
+  team        = "engineering"
+  name_prefix = %q
+
+  depends_on = [groundcover_dashboard.test]
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.groundcover_dashboards_export.test", "dashboards.%", "1"),
+					testAccCheckDashboardsExportHasDashboard("data.groundcover_dashboards_export.test", "groundcover_dashboard.test", regexp.MustCompile("Test Widget")),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDashboardsExportHasDashboard checks that the export holds the
+// preset of the dashboard resource, and that the preset matches pattern.
+func testAccCheckDashboardsExportHasDashboard(exportName, dashboardName string, pattern *regexp.Regexp) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		dashboard, ok := s.RootModule().Resources[dashboardName]
+		if !ok {
+			return fmt.Errorf("not found: %s", dashboardName)
+		}
+		return resource.TestMatchResourceAttr(exportName, "dashboards."+dashboard.Primary.ID, pattern)(s)
+	}
+}
+
+func TestExportDashboards(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	a := m.addDashboard(models.View{Name: "a", Preset: `{"widgets":["a"]}`})
+	b := m.addDashboard(models.View{Name: "b", Preset: `{"widgets":["b"]}`})
+	dashboards := []*models.View{
+		{UUID: a, Preset: `{"widgets":["a"]}`},
+		{UUID: b},
+		{UUID: "deleted"},
+		{Name: "no uuid"},
+	}
+
+	exported, err := exportDashboards(ctx, client, dashboards)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{a: `{"widgets":["a"]}`, b: `{"widgets":["b"]}`}, exported)
+	assert.Zero(t, m.requestCount(http.MethodGet, "/api/dashboards/"+a), "presets included in the list must not be fetched again")
+	assert.Equal(t, 1, m.requestCount(http.MethodGet, "/api/dashboards/"+b))
+
+	m.fail(http.MethodGet, "/api/dashboards/"+b, http.StatusForbidden, 1)
+	_, err = exportDashboards(ctx, client, dashboards)
+	assert.ErrorContains(t, err, "could not export 1 of 4 dashboards: dashboard "+b+": ")
+}
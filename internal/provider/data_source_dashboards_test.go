@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDashboardsDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-dashboards")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardResourceConfig(name) + fmt.Sprintf(`
+data "groundcover_dashboards" "test" {
+  team        = "engineering"
+  name_prefix = %q
+
+  depends_on = [groundcover_dashboard.test]
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.groundcover_dashboards.test", "dashboards.#", "1"),
+					resource.TestCheckResourceAttrPair("data.groundcover_dashboards.test", "dashboards.0.uuid", "groundcover_dashboard.test", "id"),
+					resource.TestCheckResourceAttr("data.groundcover_dashboards.test", "dashboards.0.name", name),
+					resource.TestCheckResourceAttr("data.groundcover_dashboards.test", "dashboards.0.team", "engineering"),
+					resource.TestCheckResourceAttrSet("data.groundcover_dashboards.test", "dashboards.0.revision_number"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterDashboards(t *testing.T) {
+	dashboards := []*models.View{
+		{UUID: "3", Name: "payments-latency", Team: "payments", Owner: "alice"},
+		{UUID: "1", Name: "payments-errors", Team: "payments", Owner: "bob"},
+		{UUID: "2", Name: "checkout-overview", Team: "payments", Owner: "alice"},
+		{UUID: "4", Name: "payments-errors", Team: "platform", Owner: "alice"},
+		nil,
+	}
+
+	tests := []struct {
+		name   string
+		filter dashboardFilter
+		want   []string
+	}{
+		{name: "no filters", filter: dashboardFilter{}, want: []string{"2", "1", "4", "3"}},
+		{name: "team", filter: dashboardFilter{team: "payments"}, want: []string{"2", "1", "3"}},
+		{name: "owner", filter: dashboardFilter{owner: "alice"}, want: []string{"2", "4", "3"}},
+		{name: "name prefix", filter: dashboardFilter{namePrefix: "payments-"}, want: []string{"1", "4", "3"}},
+		{name: "all filters", filter: dashboardFilter{team: "payments", owner: "alice", namePrefix: "payments-"}, want: []string{"3"}},
+		{name: "no match", filter: dashboardFilter{team: "unknown"}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, dashboard := range filterDashboards(dashboards, tt.filter) {
+				got = append(got, dashboard.UUID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("filterDashboards() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+)
+
+var (
+	_ datasource.DataSource              = &ingestionKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &ingestionKeysDataSource{}
+)
+
+func NewIngestionKeysDataSource() datasource.DataSource {
+	return &ingestionKeysDataSource{}
+}
+
+type ingestionKeysDataSource struct {
+	client ApiClient
+}
+
+type ingestionKeysDataSourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	RemoteConfig  types.Bool   `tfsdk:"remote_config"`
+	IngestionKeys types.List   `tfsdk:"ingestion_keys"` // List of ingestionKeySummaryObjectType
+}
+
+var ingestionKeySummaryObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":            types.StringType,
+		"name":          types.StringType,
+		"type":          types.StringType,
+		"remote_config": types.BoolType,
+		"created_by":    types.StringType,
+		"tags":          types.ListType{ElemType: types.StringType},
+	},
+}
+
+// ingestionKeyFilter holds the optional filters of the data source. Empty
+// fields, and a nil remoteConfig, match every ingestion key.
+type ingestionKeyFilter struct {
+	name         string
+	keyType      string
+	remoteConfig *bool
+}
+
+// request returns the ListIngestionKeys request for the filter. The API
+// cannot filter on remote_config = false, so remote_config is only sent when
+// true and always checked by filterIngestionKeys.
+func (f ingestionKeyFilter) request() *models.ListIngestionKeysRequest {
+	return &models.ListIngestionKeysRequest{
+		Name:         f.name,
+		Type:         f.keyType,
+		RemoteConfig: f.remoteConfig != nil && *f.remoteConfig,
+	}
+}
+
+func (d *ingestionKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ingestion_keys"
+}
+
+func (d *ingestionKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the organization's ingestion keys, optionally filtered by name, type, or remote configuration, to audit key sprawl. Keys are sorted by name, then ID. " +
+			"The key values are not exported; the API does not return a creation date, so keys cannot be filtered by age.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the ingestion key with this name (exact match).",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return ingestion keys of this type: 'sensor', 'rum', or 'thirdParty'.",
+				Optional:    true,
+			},
+			"remote_config": schema.BoolAttribute{
+				Description: "Only return ingestion keys whose remote configuration setting equals this value.",
+				Optional:    true,
+			},
+			"ingestion_keys": schema.ListNestedAttribute{
+				Description: "The ingestion keys that match every filter that is set.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the ingestion key.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the ingestion key.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the ingestion key.",
+							Computed:    true,
+						},
+						"remote_config": schema.BoolAttribute{
+							Description: "Whether the ingestion key is configured for remote configuration.",
+							Computed:    true,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "The user who created the ingestion key.",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "Tags associated with the ingestion key.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ingestionKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ingestionKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ingestionKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := ingestionKeyFilter{
+		name:    config.Name.ValueString(),
+		keyType: config.Type.ValueString(),
+	}
+	if !config.RemoteConfig.IsNull() {
+		remoteConfig := config.RemoteConfig.ValueBool()
+		filter.remoteConfig = &remoteConfig
+	}
+
+	listed, err := d.client.ListIngestionKeys(ctx, filter.request())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Ingestion Keys", fmt.Sprintf("Could not list ingestion keys: %s", err.Error()))
+		return
+	}
+	matched := filterIngestionKeys(listed, filter)
+
+	var diags diag.Diagnostics
+	config.IngestionKeys, diags = ingestionKeySummariesList(ctx, matched)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Debug(ctx, fmt.Sprintf("Ingestion keys data source matched %d of %d listed ingestion keys", len(matched), len(listed)))
+}
+
+// filterIngestionKeys returns the keys matching filter, sorted by name and
+// then ID. The API's name filter is not relied on to be exact.
+func filterIngestionKeys(keys []*models.IngestionKeyResult, filter ingestionKeyFilter) []*models.IngestionKeyResult {
+	var matched []*models.IngestionKeyResult
+	for _, key := range keys {
+		if key == nil {
+			continue
+		}
+		if filter.name != "" && key.Name != filter.name {
+			continue
+		}
+		if filter.keyType != "" && key.Type != filter.keyType {
+			continue
+		}
+		if filter.remoteConfig != nil && key.RemoteConfig != *filter.remoteConfig {
+			continue
+		}
+		matched = append(matched, key)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		if matched[i].Name != matched[j].Name {
+			return matched[i].Name < matched[j].Name
+		}
+		return matched[i].ID < matched[j].ID
+	})
+	return matched
+}
+
+func ingestionKeySummariesList(ctx context.Context, keys []*models.IngestionKeyResult) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([]attr.Value, 0, len(keys))
+	for _, key := range keys {
+		tags, tagDiags := types.ListValueFrom(ctx, types.StringType, key.Tags)
+		diags.Append(tagDiags...)
+		obj, objDiags := types.ObjectValue(ingestionKeySummaryObjectType.AttrTypes, map[string]attr.Value{
+			"id":            types.StringValue(key.ID),
+			"name":          types.StringValue(key.Name),
+			"type":          types.StringValue(key.Type),
+			"remote_config": types.BoolValue(key.RemoteConfig),
+			"created_by":    types.StringValue(key.CreatedBy),
+			"tags":          tags,
+		})
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return types.ListNull(ingestionKeySummaryObjectType), diags
+		}
+		values = append(values, obj)
+	}
+
+	list, listDiags := types.ListValue(ingestionKeySummaryObjectType, values)
+	diags.Append(listDiags...)
+	return list, diags
+}
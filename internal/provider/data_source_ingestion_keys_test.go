@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+)
+
+func TestFilterIngestionKeys(t *testing.T) {
+	keys := []*models.IngestionKeyResult{
+		{ID: "3", Name: "sensor-prod", Type: "sensor", RemoteConfig: true},
+		{ID: "1", Name: "rum-web", Type: "rum"},
+		{ID: "2", Name: "sensor-prod-old", Type: "sensor"},
+		{ID: "4", Name: "otel", Type: "thirdParty"},
+		nil,
+	}
+	remoteConfig := true
+	noRemoteConfig := false
+
+	tests := []struct {
+		name   string
+		filter ingestionKeyFilter
+		want   []string
+	}{
+		{name: "no filters", filter: ingestionKeyFilter{}, want: []string{"4", "1", "3", "2"}},
+		{name: "exact name", filter: ingestionKeyFilter{name: "sensor-prod"}, want: []string{"3"}},
+		{name: "type", filter: ingestionKeyFilter{keyType: "sensor"}, want: []string{"3", "2"}},
+		{name: "remote config", filter: ingestionKeyFilter{remoteConfig: &remoteConfig}, want: []string{"3"}},
+		{name: "no remote config", filter: ingestionKeyFilter{keyType: "sensor", remoteConfig: &noRemoteConfig}, want: []string{"2"}},
+		{name: "no match", filter: ingestionKeyFilter{keyType: "unknown"}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, key := range filterIngestionKeys(keys, tt.filter) {
+				got = append(got, key.ID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("filterIngestionKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIngestionKeyFilterRequest(t *testing.T) {
+	noRemoteConfig := false
+	req := ingestionKeyFilter{name: "a", keyType: "rum", remoteConfig: &noRemoteConfig}.request()
+	if req.Name != "a" || req.Type != "rum" || req.RemoteConfig {
+		t.Fatalf("request() = %+v, want name a, type rum, remote config unset", req)
+	}
+}
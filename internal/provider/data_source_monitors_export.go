@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+)
+
+var (
+	_ datasource.DataSource              = &monitorsExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &monitorsExportDataSource{}
+)
+
+func NewMonitorsExportDataSource() datasource.DataSource {
+	return &monitorsExportDataSource{}
+}
+
+type monitorsExportDataSource struct {
+	client ApiClient
+}
+
+type monitorsExportDataSourceModel struct {
+	IDs         types.Set    `tfsdk:"ids"`
+	TitlePrefix types.String `tfsdk:"title_prefix"`
+	Monitors    types.Map    `tfsdk:"monitors"`
+}
+
+func (d *monitorsExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitors_export"
+}
+
+func (d *monitorsExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exports the YAML of the organization's monitors, all of them or a filtered subset, for backups such as a scheduled run that writes each monitor to object storage. " +
+			"The YAML is exactly what the API returns, so it can be passed back as `monitor_yaml` of a `groundcover_monitor` to restore a monitor. Every monitor is fetched in its own request, up to 10 at a time. " +
+			"A monitor deleted while the export runs is left out; any other failure fails the read, so an export is never silently incomplete.",
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.SetAttribute{
+				Description: "Only export the monitors with these IDs. IDs of monitors that do not exist are ignored.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"title_prefix": schema.StringAttribute{
+				Description: "Only export the monitors whose title starts with this prefix (case-sensitive).",
+				Optional:    true,
+			},
+			"monitors": schema.MapAttribute{
+				Description: "The raw YAML of each exported monitor, keyed by monitor ID.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *monitorsExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *monitorsExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config monitorsExportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	if !config.IDs.IsNull() {
+		resp.Diagnostics.Append(config.IDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	all, err := d.client.ListMonitors(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Monitors", fmt.Sprintf("Could not list monitors: %s", err.Error()))
+		return
+	}
+	matched := filterMonitorsForExport(all, ids, config.TitlePrefix.ValueString())
+
+	exported, err := exportMonitors(ctx, d.client, matched)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Exporting Monitors", err.Error())
+		return
+	}
+
+	values := make(map[string]attr.Value, len(exported))
+	for id, monitorYaml := range exported {
+		values[id] = types.StringValue(monitorYaml)
+	}
+	monitors, diags := types.MapValue(types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Monitors = monitors
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Debug(ctx, fmt.Sprintf("Monitors export data source exported %d of %d monitors", len(exported), len(all)))
+}
+
+// filterMonitorsForExport returns the IDs of the monitors that are in ids, when
+// it is not empty, and whose title starts with titlePrefix.
+func filterMonitorsForExport(monitors []*models.MonitorListItem, ids []string, titlePrefix string) []string {
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	var matched []string
+	for _, monitor := range monitors {
+		if monitor == nil || monitor.UUID == "" {
+			continue
+		}
+		id := monitor.UUID.String()
+		if len(wanted) > 0 && !wanted[id] {
+			continue
+		}
+		if !strings.HasPrefix(monitor.Title, titlePrefix) {
+			continue
+		}
+		matched = append(matched, id)
+	}
+	return matched
+}
+
+// exportMonitors fetches the YAML of the monitors with the given IDs, at most
+// monitorPrefetchConcurrency at a time. Monitors deleted since they were listed
+// are skipped; any other error is returned.
+func exportMonitors(ctx context.Context, client ApiClient, ids []string) (map[string]string, error) {
+	exported := make(map[string]string, len(ids))
+	var mu sync.Mutex
+	var errs []error
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, monitorPrefetchConcurrency)
+	for _, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			yamlBytes, err := client.GetMonitor(ctx, id)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case errors.Is(err, ErrNotFound):
+				tflog.Debug(ctx, "Monitors export: skipping monitor deleted since it was listed", map[string]any{"id": id})
+			case err != nil:
+				errs = append(errs, fmt.Errorf("monitor %s: %w", id, err))
+			default:
+				exported[id] = string(yamlBytes)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("could not export %d of %d monitors: %w", len(errs), len(ids), errors.Join(errs...))
+	}
+	return exported, nil
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccMonitorsExportDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-monitors-export")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorResourceConfig(name) + fmt.Sprintf(`
+data "groundcover_monitors_export" "test" {
+  title_prefix = %q
+
+  depends_on = [groundcover_monitor.test]
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.groundcover_monitors_export.test", "monitors.%", "1"),
+					testAccCheckMonitorsExportHasMonitor("data.groundcover_monitors_export.test", "groundcover_monitor.test", regexp.MustCompile(name)),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckMonitorsExportHasMonitor checks that the export holds the YAML
+// of the monitor resource, and that the YAML matches pattern.
+func testAccCheckMonitorsExportHasMonitor(exportName, monitorName string, pattern *regexp.Regexp) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		monitor, ok := s.RootModule().Resources[monitorName]
+		if !ok {
+			return fmt.Errorf("not found: %s", monitorName)
+		}
+		return resource.TestMatchResourceAttr(exportName, "monitors."+monitor.Primary.ID, pattern)(s)
+	}
+}
+
+func TestFilterMonitorsForExport(t *testing.T) {
+	monitors := []*models.MonitorListItem{
+		{UUID: "a", Title: "prod: cpu"},
+		{UUID: "b", Title: "prod: memory"},
+		{UUID: "c", Title: "staging: cpu"},
+		{Title: "no id"},
+		nil,
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, filterMonitorsForExport(monitors, nil, ""))
+	assert.Equal(t, []string{"a", "b"}, filterMonitorsForExport(monitors, nil, "prod: "))
+	assert.Equal(t, []string{"b"}, filterMonitorsForExport(monitors, []string{"b", "c", "missing"}, "prod: "))
+}
+
+func TestExportMonitors(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	a, b := m.addMonitor("title: A\n"), m.addMonitor("title: B\n")
+
+	exported, err := exportMonitors(ctx, client, []string{a, b, "deleted"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{a: "title: A\n", b: "title: B\n"}, exported)
+
+	m.fail(http.MethodGet, "/api/monitors/"+b, http.StatusForbidden, 1)
+	_, err = exportMonitors(ctx, client, []string{a, b})
+	assert.ErrorContains(t, err, "could not export 1 of 2 monitors: monitor "+b+": ")
+}
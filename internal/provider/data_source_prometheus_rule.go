@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
+)
+
+var _ datasource.DataSource = &prometheusRuleDataSource{}
+
+const prometheusRuleDefaultEvaluationInterval = "1m"
+
+// promQLComparison is a PromQL comparison operator and the groundcover
+// threshold operator it maps to.
+type promQLComparison struct {
+	promQL    string
+	threshold string
+}
+
+// promQLComparisons lists the PromQL comparison operators, two-character ones
+// first so they are matched before their one-character prefixes.
+var promQLComparisons = []promQLComparison{
+	{">=", "gte"},
+	{"<=", "lte"},
+	{"==", "eq"},
+	{"!=", "neq"},
+	{">", "gt"},
+	{"<", "lt"},
+}
+
+func NewPrometheusRuleDataSource() datasource.DataSource {
+	return &prometheusRuleDataSource{}
+}
+
+type prometheusRuleDataSource struct{}
+
+type prometheusRuleDataSourceModel struct {
+	Alert              types.String `tfsdk:"alert"`
+	Expr               types.String `tfsdk:"expr"`
+	For                types.String `tfsdk:"for"`
+	Labels             types.Map    `tfsdk:"labels"`
+	Annotations        types.Map    `tfsdk:"annotations"`
+	Severity           types.String `tfsdk:"severity"`
+	EvaluationInterval types.String `tfsdk:"evaluation_interval"`
+	MonitorYaml        types.String `tfsdk:"monitor_yaml"`
+	Warnings           types.List   `tfsdk:"warnings"`
+}
+
+// prometheusRule is a Prometheus alerting rule and the conversion settings.
+type prometheusRule struct {
+	alert              string
+	expr               string
+	forDuration        string
+	labels             map[string]string
+	annotations        map[string]string
+	severity           string
+	evaluationInterval string
+}
+
+// The prometheusRuleMonitor* types render a converted rule as groundcover_monitor
+// YAML, with the keys in the order the monitor examples use.
+type prometheusRuleMonitor struct {
+	Title              string                          `yaml:"title"`
+	Display            prometheusRuleMonitorDisplay    `yaml:"display"`
+	Severity           string                          `yaml:"severity,omitempty"`
+	Labels             map[string]string               `yaml:"labels,omitempty"`
+	Annotations        map[string]string               `yaml:"annotations,omitempty"`
+	Model              prometheusRuleMonitorModel      `yaml:"model"`
+	EvaluationInterval prometheusRuleMonitorEvaluation `yaml:"evaluationInterval"`
+	MeasurementType    string                          `yaml:"measurementType"`
+}
+
+type prometheusRuleMonitorDisplay struct {
+	Header      string `yaml:"header"`
+	Description string `yaml:"description,omitempty"`
+}
+
+type prometheusRuleMonitorModel struct {
+	Queries    []prometheusRuleMonitorQuery     `yaml:"queries"`
+	Thresholds []prometheusRuleMonitorThreshold `yaml:"thresholds"`
+}
+
+type prometheusRuleMonitorQuery struct {
+	Name           string `yaml:"name"`
+	Expression     string `yaml:"expression"`
+	DatasourceType string `yaml:"datasourceType"`
+	QueryType      string `yaml:"queryType"`
+}
+
+type prometheusRuleMonitorThreshold struct {
+	Name      string    `yaml:"name"`
+	InputName string    `yaml:"inputName"`
+	Operator  string    `yaml:"operator"`
+	Values    []float64 `yaml:"values"`
+}
+
+type prometheusRuleMonitorEvaluation struct {
+	Interval   string `yaml:"interval"`
+	PendingFor string `yaml:"pendingFor,omitempty"`
+}
+
+func (d *prometheusRuleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prometheus_rule"
+}
+
+func (d *prometheusRuleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Converts a Prometheus alerting rule into `monitor_yaml` for a `groundcover_monitor`, to migrate existing rule files. " +
+			"The conversion runs in the provider without API calls. When `expr` ends in a comparison with a number, such as `rate(errors[5m]) > 0.5`, " +
+			"the comparison becomes the monitor's threshold; otherwise the monitor fires for every series `expr` returns, as in Prometheus.",
+		Attributes: map[string]schema.Attribute{
+			"alert": schema.StringAttribute{
+				Description: "The rule's `alert` name, used as the monitor title.",
+				Required:    true,
+			},
+			"expr": schema.StringAttribute{
+				Description: "The rule's PromQL expression.",
+				Required:    true,
+			},
+			"for": schema.StringAttribute{
+				Description: "The rule's `for` duration, such as `5m`. Becomes the monitor's `evaluationInterval.pendingFor`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.DurationString(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "The rule's labels, copied to the monitor's `labels`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"annotations": schema.MapAttribute{
+				Description: "The rule's annotations, copied to the monitor's `annotations`. `summary` also becomes the display header and `description` the display description.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"severity": schema.StringAttribute{
+				Description: "The monitor severity. Defaults to the rule's `severity` label.",
+				Optional:    true,
+			},
+			"evaluation_interval": schema.StringAttribute{
+				Description: "How often the monitor is evaluated, the equivalent of the rule group's `interval`. Defaults to `1m`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.DurationString(),
+				},
+			},
+			"monitor_yaml": schema.StringAttribute{
+				Description: "The converted monitor, for `groundcover_monitor` `monitor_yaml`.",
+				Computed:    true,
+			},
+			"warnings": schema.ListAttribute{
+				Description: "Parts of the rule the conversion could not carry over exactly, such as Prometheus templates in annotations. Review them before applying the monitor.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *prometheusRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config prometheusRuleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule := prometheusRule{
+		alert:              config.Alert.ValueString(),
+		expr:               config.Expr.ValueString(),
+		forDuration:        config.For.ValueString(),
+		severity:           config.Severity.ValueString(),
+		evaluationInterval: config.EvaluationInterval.ValueString(),
+	}
+	if !config.Labels.IsNull() {
+		resp.Diagnostics.Append(config.Labels.ElementsAs(ctx, &rule.labels, false)...)
+	}
+	if !config.Annotations.IsNull() {
+		resp.Diagnostics.Append(config.Annotations.ElementsAs(ctx, &rule.annotations, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorYaml, warnings, err := convertPrometheusRule(rule)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Converting Prometheus Rule", fmt.Sprintf("Could not convert alerting rule %q: %s", rule.alert, err.Error()))
+		return
+	}
+
+	config.MonitorYaml = types.StringValue(monitorYaml)
+	warningsList, diags := types.ListValueFrom(ctx, types.StringType, warnings)
+	resp.Diagnostics.Append(diags...)
+	config.Warnings = warningsList
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Debug(ctx, fmt.Sprintf("Converted Prometheus rule %q with %d warnings", rule.alert, len(warnings)))
+}
+
+// convertPrometheusRule returns rule as groundcover_monitor YAML and the
+// parts of the rule it could not carry over exactly.
+func convertPrometheusRule(rule prometheusRule) (string, []string, error) {
+	expr := strings.TrimSpace(rule.expr)
+	if expr == "" {
+		return "", nil, fmt.Errorf("expr is empty")
+	}
+	warnings := []string{}
+
+	threshold := prometheusRuleMonitorThreshold{
+		Name:      "threshold_1",
+		InputName: monitorV2DefaultQueryName,
+	}
+	expression, operator, value, ok := splitPromQLThreshold(expr)
+	if ok {
+		threshold.Operator = operator
+		threshold.Values = []float64{value}
+	} else {
+		// A Prometheus rule fires for every series its expression returns.
+		// Mapping each returned series to 1 keeps its labels and fires on it.
+		expression = fmt.Sprintf("(%s) * 0 + 1", expr)
+		threshold.Operator = "gt"
+		threshold.Values = []float64{0}
+		warnings = append(warnings, "expr does not end in a comparison with a number, so the monitor fires for every series it returns and alerts show 1 instead of the series value")
+	}
+
+	severity := rule.severity
+	if severity == "" {
+		severity = rule.labels["severity"]
+	}
+	if severity == "" {
+		warnings = append(warnings, "no severity is set and the rule has no severity label; set severity before applying the monitor")
+	}
+
+	header := rule.annotations["summary"]
+	if header == "" {
+		header = rule.alert
+	}
+	for _, key := range slices.Sorted(maps.Keys(rule.annotations)) {
+		if strings.Contains(rule.annotations[key], "{{") {
+			warnings = append(warnings, fmt.Sprintf("annotation %q uses a Prometheus template, such as {{ $labels.x }} or {{ $value }}, which groundcover may render differently", key))
+		}
+	}
+
+	interval := rule.evaluationInterval
+	if interval == "" {
+		interval = prometheusRuleDefaultEvaluationInterval
+	}
+
+	monitor := prometheusRuleMonitor{
+		Title: rule.alert,
+		Display: prometheusRuleMonitorDisplay{
+			Header:      header,
+			Description: rule.annotations["description"],
+		},
+		Severity:    severity,
+		Labels:      rule.labels,
+		Annotations: rule.annotations,
+		Model: prometheusRuleMonitorModel{
+			Queries: []prometheusRuleMonitorQuery{{
+				Name:           monitorV2DefaultQueryName,
+				Expression:     expression,
+				DatasourceType: monitorV2DatasourcePrometheus,
+				QueryType:      monitorV2QueryTypeInstant,
+			}},
+			Thresholds: []prometheusRuleMonitorThreshold{threshold},
+		},
+		EvaluationInterval: prometheusRuleMonitorEvaluation{
+			Interval:   interval,
+			PendingFor: rule.forDuration,
+		},
+		MeasurementType: "state",
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(monitor); err != nil {
+		return "", nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", nil, err
+	}
+	return buf.String(), warnings, nil
+}
+
+// splitPromQLThreshold splits expr at its last top-level comparison with a
+// number, such as `rate(errors[5m]) > 0.5`, into the compared expression, the
+// groundcover threshold operator, and the number. ok is false when expr does
+// not end in such a comparison, including comparisons with the bool modifier
+// or between two vectors.
+func splitPromQLThreshold(expr string) (expression, operator string, value float64, ok bool) {
+	index, op := lastTopLevelComparison(expr)
+	if index < 0 {
+		return "", "", 0, false
+	}
+	lhs := strings.TrimSpace(expr[:index])
+	rhs := strings.TrimSpace(expr[index+len(op.promQL):])
+	number, err := strconv.ParseFloat(rhs, 64)
+	if lhs == "" || err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return "", "", 0, false
+	}
+	return lhs, op.threshold, number, true
+}
+
+// lastTopLevelComparison returns the index and operator of the last
+// comparison in expr that is outside parentheses, brackets, braces, and
+// string literals, or -1 when there is none.
+func lastTopLevelComparison(expr string) (int, promQLComparison) {
+	lastIndex := -1
+	var lastOp promQLComparison
+	depth := 0
+	var quote byte
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		if quote != 0 {
+			if c == '\\' && quote != '`' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+			continue
+		case '(', '[', '{':
+			depth++
+			continue
+		case ')', ']', '}':
+			depth--
+			continue
+		}
+		if depth != 0 {
+			continue
+		}
+		for _, op := range promQLComparisons {
+			if strings.HasPrefix(expr[i:], op.promQL) {
+				lastIndex, lastOp = i, op
+				i += len(op.promQL) - 1
+				break
+			}
+		}
+	}
+	return lastIndex, lastOp
+}
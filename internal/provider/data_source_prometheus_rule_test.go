@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSplitPromQLThreshold(t *testing.T) {
+	tests := []struct {
+		expr           string
+		wantExpression string
+		wantOperator   string
+		wantValue      float64
+		wantOK         bool
+	}{
+		{expr: `rate(http_errors_total[5m]) > 0.5`, wantExpression: `rate(http_errors_total[5m])`, wantOperator: "gt", wantValue: 0.5, wantOK: true},
+		{expr: `up{job="api"} == 0`, wantExpression: `up{job="api"}`, wantOperator: "eq", wantValue: 0, wantOK: true},
+		{expr: `sum by (pod) (x{a!="b"}) >= 1e3`, wantExpression: `sum by (pod) (x{a!="b"})`, wantOperator: "gte", wantValue: 1000, wantOK: true},
+		{expr: `disk_free_ratio <= -1`, wantExpression: `disk_free_ratio`, wantOperator: "lte", wantValue: -1, wantOK: true},
+		{expr: `count(x > 5) < 3`, wantExpression: `count(x > 5)`, wantOperator: "lt", wantValue: 3, wantOK: true},
+		{expr: `label_replace(x, "d", ">=", "s", ".*") != 2`, wantExpression: `label_replace(x, "d", ">=", "s", ".*")`, wantOperator: "neq", wantValue: 2, wantOK: true},
+		{expr: `up == bool 0`},
+		{expr: `a > b`},
+		{expr: `a > 5 and b`},
+		{expr: `absent(up{job="api"})`},
+		{expr: `x > +Inf`},
+		{expr: `> 1`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expression, operator, value, ok := splitPromQLThreshold(tt.expr)
+			if ok != tt.wantOK {
+				t.Fatalf("splitPromQLThreshold() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if expression != tt.wantExpression || operator != tt.wantOperator || value != tt.wantValue {
+				t.Fatalf("splitPromQLThreshold() = (%q, %q, %v), want (%q, %q, %v)", expression, operator, value, tt.wantExpression, tt.wantOperator, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestConvertPrometheusRule(t *testing.T) {
+	monitorYaml, warnings, err := convertPrometheusRule(prometheusRule{
+		alert:       "HighErrorRate",
+		expr:        `sum by (service) (rate(http_errors_total[5m])) > 0.5`,
+		forDuration: "10m",
+		labels:      map[string]string{"severity": "critical", "team": "payments"},
+		annotations: map[string]string{"summary": "High error rate", "description": "Errors above 0.5/s", "runbook_url": "https://runbooks/errors"},
+	})
+	if err != nil {
+		t.Fatalf("convertPrometheusRule() error = %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Fatalf("convertPrometheusRule() warnings = %v, want none", warnings)
+	}
+
+	req, _, err := buildCreateMonitorRequest(context.Background(), monitorYaml)
+	if err != nil {
+		t.Fatalf("buildCreateMonitorRequest() error = %v\n%s", err, monitorYaml)
+	}
+	if req.Title == nil || *req.Title != "HighErrorRate" || req.Severity != "critical" || req.MeasurementType != "state" {
+		t.Fatalf("monitor title/severity/measurementType = %v/%q/%q", req.Title, req.Severity, req.MeasurementType)
+	}
+	if req.Display == nil || req.Display.Header != "High error rate" || req.Display.Description != "Errors above 0.5/s" {
+		t.Fatalf("monitor display = %+v", req.Display)
+	}
+	if req.Labels["team"] != "payments" || req.Annotations["runbook_url"] != "https://runbooks/errors" {
+		t.Fatalf("monitor labels = %v, annotations = %v", req.Labels, req.Annotations)
+	}
+	query := req.Model.Queries[0]
+	if query.Expression != `sum by (service) (rate(http_errors_total[5m]))` || query.DatasourceType != "prometheus" || query.QueryType != "instant" {
+		t.Fatalf("monitor query = %+v", query)
+	}
+	threshold := req.Model.Thresholds[0]
+	if *threshold.Operator != "gt" || len(threshold.Values) != 1 || threshold.Values[0] != 0.5 || *threshold.InputName != query.Name {
+		t.Fatalf("monitor threshold = %+v", threshold)
+	}
+	if time.Duration(req.EvaluationInterval.Interval) != time.Minute || req.EvaluationInterval.PendingFor == nil || time.Duration(*req.EvaluationInterval.PendingFor) != 10*time.Minute {
+		t.Fatalf("monitor evaluationInterval = %+v", req.EvaluationInterval)
+	}
+}
+
+func TestConvertPrometheusRuleWithoutThreshold(t *testing.T) {
+	monitorYaml, warnings, err := convertPrometheusRule(prometheusRule{
+		alert:              "InstanceDown",
+		expr:               `absent(up{job="api"})`,
+		annotations:        map[string]string{"summary": "{{ $labels.instance }} is down"},
+		evaluationInterval: "30s",
+	})
+	if err != nil {
+		t.Fatalf("convertPrometheusRule() error = %v", err)
+	}
+	if len(warnings) != 3 {
+		t.Fatalf("convertPrometheusRule() warnings = %v, want threshold, severity, and template warnings", warnings)
+	}
+	if !strings.Contains(monitorYaml, `expression: (absent(up{job="api"})) * 0 + 1`) || !strings.Contains(monitorYaml, "interval: 30s") {
+		t.Fatalf("convertPrometheusRule() YAML:\n%s", monitorYaml)
+	}
+	if _, _, err := buildCreateMonitorRequest(context.Background(), monitorYaml); err != nil {
+		t.Fatalf("buildCreateMonitorRequest() error = %v", err)
+	}
+
+	if _, _, err := convertPrometheusRule(prometheusRule{alert: "Empty", expr: " "}); err == nil {
+		t.Fatalf("convertPrometheusRule() with empty expr: want error")
+	}
+}
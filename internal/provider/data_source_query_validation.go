@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
+)
+
+var (
+	_ datasource.DataSource              = &queryValidationDataSource{}
+	_ datasource.DataSourceWithConfigure = &queryValidationDataSource{}
+)
+
+// queryValidationKeyLimit is how many keys one key lookup returns. The lookup
+// filter is not an exact match, so a short key can match many longer ones.
+const queryValidationKeyLimit = 1000
+
+// queryValidationDataTypes are the data types whose keys can be looked up.
+var queryValidationDataTypes = []string{"logs", "traces", "events", "issues", "entities", "apm"}
+
+func NewQueryValidationDataSource() datasource.DataSource {
+	return &queryValidationDataSource{}
+}
+
+type queryValidationDataSource struct {
+	client ApiClient
+}
+
+type queryValidationDataSourceModel struct {
+	Query       types.String `tfsdk:"query"`
+	DataType    types.String `tfsdk:"data_type"`
+	Valid       types.Bool   `tfsdk:"valid"`
+	Errors      types.List   `tfsdk:"errors"`
+	Keys        types.List   `tfsdk:"keys"`
+	UnknownKeys types.List   `tfsdk:"unknown_keys"`
+	Terms       types.List   `tfsdk:"terms"` // List of queryValidationTermObjectType
+}
+
+var queryValidationTermObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"key":     types.StringType,
+		"value":   types.StringType,
+		"negated": types.BoolType,
+	},
+}
+
+func (d *queryValidationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query_validation"
+}
+
+func (d *queryValidationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Validates a gcQL query, such as the `query` of a notification route, and returns its terms, so a `precondition` can reject a broken query at plan time. " +
+			"The API has no query validation endpoint: the syntax is checked by the provider, and when `data_type` is set, each `key:value` key is looked up among the keys of that data type. " +
+			"An invalid query does not fail the data source; check `valid` and `errors` instead.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Description: "The gcQL query to validate, such as `env:prod AND NOT workload:(checkout OR cart)`.",
+				Required:    true,
+			},
+			"data_type": schema.StringAttribute{
+				Description: fmt.Sprintf("The data type the query filters, whose keys the query's keys are checked against: one of `%s`. When unset, only the syntax is checked.", strings.Join(queryValidationDataTypes, "`, `")),
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(queryValidationDataTypes...)},
+			},
+			"valid": schema.BoolAttribute{
+				Description: "Whether the query is well-formed and, when `data_type` is set, all its keys exist.",
+				Computed:    true,
+			},
+			"errors": schema.ListAttribute{
+				Description: "Why the query is invalid: its syntax error, or one message per unknown key. Empty when `valid` is true.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"keys": schema.ListAttribute{
+				Description: "The distinct keys the query filters on, in order of first use. Empty when the query is malformed.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"unknown_keys": schema.ListAttribute{
+				Description: "The keys that do not exist for `data_type`. Always empty when `data_type` is unset.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"terms": schema.ListNestedAttribute{
+				Description: "The terms of the query, in order. Each value of `key:(a OR b)` is a term of its own. Empty when the query is malformed.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "The key the term filters on. Empty for free text.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the term, unquoted. It may contain `*` wildcards.",
+							Computed:    true,
+						},
+						"negated": schema.BoolAttribute{
+							Description: "Whether the term is negated with `-`, `!`, or NOT.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *queryValidationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *queryValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config queryValidationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := config.Query.ValueString()
+	problems, keys, unknownKeys := []string{}, []string{}, []string{}
+	terms, err := validators.ParseGcQLTerms(query)
+	if err != nil {
+		problems = append(problems, err.Error())
+	}
+	for _, term := range terms {
+		if term.Key != "" && !slices.Contains(keys, term.Key) {
+			keys = append(keys, term.Key)
+		}
+	}
+
+	if dataType := config.DataType.ValueString(); dataType != "" {
+		unknownKeys, err = unknownQueryKeys(ctx, d.client, dataType, keys)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Looking Up Query Keys", fmt.Sprintf("Could not look up the %s keys of query %q: %s", dataType, query, err.Error()))
+			return
+		}
+		for _, key := range unknownKeys {
+			problems = append(problems, fmt.Sprintf("key %q does not exist for %s", key, dataType))
+		}
+	}
+
+	var diags diag.Diagnostics
+	config.Valid = types.BoolValue(len(problems) == 0)
+	config.Errors, diags = types.ListValueFrom(ctx, types.StringType, problems)
+	resp.Diagnostics.Append(diags...)
+	config.Keys, diags = types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	config.UnknownKeys, diags = types.ListValueFrom(ctx, types.StringType, unknownKeys)
+	resp.Diagnostics.Append(diags...)
+	config.Terms, diags = queryValidationTermsList(terms)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	tflog.Debug(ctx, fmt.Sprintf("Validated query %q: %d terms, %d problems", query, len(terms), len(problems)))
+}
+
+// unknownQueryKeys returns the keys that do not exist for dataType, looking
+// each one up by name. A key is only reported when the lookup returned every
+// match, so a truncated result never flags a key that exists.
+func unknownQueryKeys(ctx context.Context, client ApiClient, dataType string, keys []string) ([]string, error) {
+	unknown := []string{}
+	limit := uint32(queryValidationKeyLimit)
+	for _, key := range keys {
+		resp, err := client.GetSearchKeys(ctx, &models.KeysRequest{
+			Filter: key,
+			Limit:  &limit,
+			Type:   models.StringOrStringSlice{dataType},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			resp = &models.KeysResponse{}
+		}
+
+		found := slices.ContainsFunc(resp.Keys, func(item *models.KeyItem) bool {
+			return item != nil && item.Key == key
+		})
+		if found {
+			continue
+		}
+		if resp.IsLimitReached {
+			tflog.Debug(ctx, "Key lookup truncated, assuming the key exists", map[string]any{"key": key, "data_type": dataType})
+			continue
+		}
+		unknown = append(unknown, key)
+	}
+	return unknown, nil
+}
+
+func queryValidationTermsList(terms []validators.GcQLTerm) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := make([]attr.Value, 0, len(terms))
+	for _, term := range terms {
+		obj, objDiags := types.ObjectValue(queryValidationTermObjectType.AttrTypes, map[string]attr.Value{
+			"key":     types.StringValue(term.Key),
+			"value":   types.StringValue(term.Value),
+			"negated": types.BoolValue(term.Negated),
+		})
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return types.ListNull(queryValidationTermObjectType), diags
+		}
+		values = append(values, obj)
+	}
+
+	list, listDiags := types.ListValue(queryValidationTermObjectType, values)
+	diags.Append(listDiags...)
+	return list, diags
+}
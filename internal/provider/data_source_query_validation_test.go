@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+)
+
+// searchKeysClient serves the keys of one data type, matching the filter as
+// a substring like the API does.
+type searchKeysClient struct {
+	ApiClient
+	keys     []string
+	limit    int
+	requests []*models.KeysRequest
+}
+
+func (c *searchKeysClient) GetSearchKeys(_ context.Context, req *models.KeysRequest) (*models.KeysResponse, error) {
+	c.requests = append(c.requests, req)
+	resp := &models.KeysResponse{}
+	for _, key := range c.keys {
+		if !strings.Contains(key, req.Filter) {
+			continue
+		}
+		if len(resp.Keys) == c.limit {
+			resp.IsLimitReached = true
+			break
+		}
+		resp.Keys = append(resp.Keys, &models.KeyItem{Key: key})
+	}
+	return resp, nil
+}
+
+func TestUnknownQueryKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		keys  []string
+		limit int
+		want  []string
+	}{
+		{name: "all known", keys: []string{"env", "workload"}, limit: 10, want: []string{}},
+		{name: "substring is not a match", keys: []string{"environment", "workload"}, limit: 10, want: []string{"env"}},
+		{name: "truncated lookup assumes the key exists", keys: []string{"environment", "env_name", "workload"}, limit: 1, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &searchKeysClient{keys: tt.keys, limit: tt.limit}
+			got, err := unknownQueryKeys(context.Background(), client, "logs", []string{"env", "workload"})
+			if err != nil {
+				t.Fatalf("unknownQueryKeys() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("unknownQueryKeys() = %v, want %v", got, tt.want)
+			}
+			if len(client.requests) != 2 || client.requests[0].Type[0] != "logs" || client.requests[0].Limit == nil {
+				t.Fatalf("unexpected key lookups: %+v", client.requests)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	globpath "path"
+	"slices"
+	"strings"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clusterSelectorLabels are the labels a cluster_selector can match, and the
+// field of the clusters API each one reads.
+var clusterSelectorLabels = map[string]func(*models.ClustersListResult) string{
+	"name":               func(c *models.ClustersListResult) string { return c.Name },
+	"env":                func(c *models.ClustersListResult) string { return c.Env },
+	"cloud_provider":     func(c *models.ClustersListResult) string { return c.CloudProvider },
+	"kubernetes_version": func(c *models.ClustersListResult) string { return c.KubernetesVersion },
+}
+
+func dataIntegrationClusterSelectorAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: "Label matchers selecting the cluster the data integration runs in, instead of naming it in `cluster`. " +
+			"The labels are the cluster's `name`, `env`, `cloud_provider`, and `kubernetes_version`, as reported by the clusters API, and each value is a pattern in which `*` matches any characters, such as `{ env = \"prod\", name = \"eu-*\" }`. " +
+			"At plan time the provider lists the clusters and sets `cluster` to one that matches every label: the current cluster while it still matches, otherwise the first match by name. " +
+			"The clusters API only lists clusters whose sensor is reporting, so when the current cluster is missing from the list the integration stays on it and the plan shows a warning. " +
+			"A plan fails if a cluster has to be selected and none matches. When a different cluster is selected the integration is replaced. Conflicts with `cluster`.",
+		Optional:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Map{
+			mapvalidator.SizeAtLeast(1),
+			mapvalidator.KeysAre(stringvalidator.OneOf(slices.Sorted(maps.Keys(clusterSelectorLabels))...)),
+			mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			mapvalidator.ConflictsWith(path.MatchRoot("cluster")),
+		},
+	}
+}
+
+// clusterMatchesSelector reports whether every label of selector matches the
+// cluster.
+func clusterMatchesSelector(cluster *models.ClustersListResult, selector map[string]string) bool {
+	for label, pattern := range selector {
+		value, ok := clusterSelectorLabels[label]
+		if !ok {
+			return false
+		}
+		if matched, err := globpath.Match(pattern, value(cluster)); err != nil || !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveClusterSelector returns the cluster selector selects: current while it
+// still matches, so an integration stays where it runs, otherwise the first
+// matching cluster by name. The clusters API only lists clusters whose sensor
+// reports, so a current cluster missing from the list is kept, with a warning,
+// rather than taken as no longer matching.
+func (r *dataIntegrationResource) resolveClusterSelector(ctx context.Context, selector types.Map, current string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var labels map[string]string
+	diags.Append(selector.ElementsAs(ctx, &labels, false)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	clusters, err := r.client.ListClusters(ctx)
+	if err != nil {
+		diags.AddAttributeError(path.Root("cluster_selector"), "Failed to List Clusters", fmt.Sprintf("The clusters matching cluster_selector could not be listed: %s", err))
+		return "", diags
+	}
+	var matches []string
+	currentReported := false
+	for _, cluster := range clusters {
+		if cluster == nil || cluster.Name == "" {
+			continue
+		}
+		if cluster.Name == current {
+			currentReported = true
+		}
+		if clusterMatchesSelector(cluster, labels) {
+			matches = append(matches, cluster.Name)
+		}
+	}
+
+	if current != "" && !currentReported {
+		diags.AddAttributeWarning(
+			path.Root("cluster_selector"),
+			"Current Cluster Not Reported",
+			fmt.Sprintf("The integration runs in cluster %q, which is not among the %d clusters reported by groundcover, for example because its sensor stopped reporting. "+
+				"The integration stays in %q; it is only moved once the cluster is reported again and no longer matches %s.", current, len(clusters), current, formatClusterSelector(labels)),
+		)
+		return current, diags
+	}
+	if slices.Contains(matches, current) {
+		return current, diags
+	}
+	if len(matches) == 0 {
+		diags.AddAttributeError(
+			path.Root("cluster_selector"),
+			"No Cluster Matches cluster_selector",
+			fmt.Sprintf("None of the %d clusters reported by groundcover matches %s.", len(clusters), formatClusterSelector(labels)),
+		)
+		return "", diags
+	}
+	return slices.Min(matches), diags
+}
+
+// formatClusterSelector formats selector as label="pattern" pairs sorted by
+// label.
+func formatClusterSelector(selector map[string]string) string {
+	pairs := make([]string, 0, len(selector))
+	for _, label := range slices.Sorted(maps.Keys(selector)) {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", label, selector[label]))
+	}
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
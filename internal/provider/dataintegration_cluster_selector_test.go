@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testClusters = []*models.ClustersListResult{
+	{Name: "us-prod", Env: "prod", CloudProvider: "aws"},
+	{Name: "eu-prod-2", Env: "prod", CloudProvider: "gcp"},
+	{Name: "eu-prod-1", Env: "prod", CloudProvider: "aws"},
+	{Name: "eu-staging", Env: "staging", CloudProvider: "aws"},
+}
+
+func TestClusterMatchesSelector(t *testing.T) {
+	cluster := testClusters[1]
+	assert.True(t, clusterMatchesSelector(cluster, map[string]string{"env": "prod", "name": "eu-*"}))
+	assert.True(t, clusterMatchesSelector(cluster, map[string]string{"cloud_provider": "gcp"}))
+	assert.False(t, clusterMatchesSelector(cluster, map[string]string{"env": "prod", "cloud_provider": "aws"}))
+	assert.False(t, clusterMatchesSelector(cluster, map[string]string{"region": "eu"}))
+	assert.False(t, clusterMatchesSelector(cluster, map[string]string{"name": "["}))
+}
+
+func TestResolveClusterSelector(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	m.setClusters(testClusters...)
+	r := &dataIntegrationResource{client: client}
+	selector := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"env":  types.StringValue("prod"),
+		"name": types.StringValue("eu-*"),
+	})
+
+	cluster, diags := r.resolveClusterSelector(ctx, selector, "")
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "eu-prod-1", cluster, "the first match by name is selected")
+
+	cluster, diags = r.resolveClusterSelector(ctx, selector, "eu-prod-2")
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "eu-prod-2", cluster, "the current cluster is kept while it matches")
+
+	cluster, diags = r.resolveClusterSelector(ctx, selector, "eu-prod-3")
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "eu-prod-3", cluster, "a current cluster missing from the list is kept")
+	require.Len(t, diags.Warnings(), 1)
+	assert.Equal(t, "Current Cluster Not Reported", diags.Warnings()[0].Summary())
+
+	_, diags = r.resolveClusterSelector(ctx, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"env": types.StringValue("dev"),
+	}), "")
+	require.True(t, diags.HasError())
+	assert.Equal(t, `None of the 4 clusters reported by groundcover matches {env="dev"}.`, diags.Errors()[0].Detail())
+}
+
+func TestDataIntegrationModifyPlanResolvesClusterSelector(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	m.setClusters(testClusters...)
+	r := &dataIntegrationResource{client: client}
+
+	model := testDataIntegrationModel(ctx, resourceSchema(ctx, r))
+	model.ClusterSelector = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"env":            types.StringValue("prod"),
+		"cloud_provider": types.StringValue("aws"),
+	})
+	plannedCluster := func(t *testing.T, state *dataIntegrationResourceModel) (string, []path.Path) {
+		resp := testDataIntegrationModifyPlan(t, r, model, state)
+		var cluster types.String
+		require.False(t, resp.Plan.GetAttribute(ctx, path.Root("cluster"), &cluster).HasError())
+		return cluster.ValueString(), resp.RequiresReplace
+	}
+
+	cluster, _ := plannedCluster(t, nil)
+	assert.Equal(t, "eu-prod-1", cluster)
+
+	// The integration stays on its cluster while the cluster matches.
+	model.ID = types.StringValue("integration-1")
+	state := model
+	state.Cluster = types.StringValue("us-prod")
+	cluster, replace := plannedCluster(t, &state)
+	assert.Equal(t, "us-prod", cluster)
+	assert.Empty(t, replace)
+
+	// A cluster that stops reporting drops out of the list; the integration
+	// stays on it.
+	m.setClusters(testClusters[1:]...)
+	cluster, replace = plannedCluster(t, &state)
+	assert.Equal(t, "us-prod", cluster)
+	assert.Empty(t, replace)
+
+	// Once it is reported and no longer matches, another cluster is
+	// selected and the integration is replaced.
+	m.setClusters(append([]*models.ClustersListResult{{Name: "us-prod", Env: "staging", CloudProvider: "aws"}}, testClusters[1:]...)...)
+	cluster, replace = plannedCluster(t, &state)
+	assert.Equal(t, "eu-prod-1", cluster)
+	assert.Equal(t, []path.Path{path.Root("cluster")}, replace)
+}
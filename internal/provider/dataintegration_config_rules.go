@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// dataIntegrationConfigRules describes the parts of an integration type's
+// config that can be checked before the API sees it. The SDK passes config as
+// an opaque string, so the rules are kept here and cover only fields whose
+// meaning is certain; anything else is left to the API.
+type dataIntegrationConfigRules struct {
+	// required are top-level keys that must be present and not empty.
+	required []string
+	// oneOf lists groups of keys of which at least one must be present.
+	oneOf [][]string
+	// stringLists are keys that, when present, must be lists of strings.
+	stringLists []string
+}
+
+// dataIntegrationDurationKeys are config keys holding durations, for every
+// integration type. The API takes either whole nanoseconds or a Go duration
+// string such as "5m".
+var dataIntegrationDurationKeys = []string{"scrapeInterval", "scrapeTimeout"}
+
+var dataIntegrationCommonRules = dataIntegrationConfigRules{
+	stringLists: []string{"exporters"},
+}
+
+var dataIntegrationConfigRulesByType = map[string]dataIntegrationConfigRules{
+	"cloudwatch": {
+		required:    []string{"roleArn", "regions"},
+		stringLists: []string{"regions", "awsNamespaces"},
+	},
+	"gcpmetrics": {
+		required:    []string{"projectIDs"},
+		stringLists: []string{"projectIDs", "regions", "metricPrefixes"},
+	},
+	"azuremetrics": {
+		required:    []string{"subscriptions"},
+		stringLists: []string{"subscriptions", "regions"},
+	},
+	"prometheusscrape": {
+		oneOf:       [][]string{{"staticTargets", "httpDiscovery"}},
+		stringLists: []string{"staticTargets"},
+	},
+}
+
+// validateDataIntegrationConfig reports problems in the config of an
+// integration of type integrationType. Configs that are not JSON objects are
+// left to the config attribute's own validation.
+func validateDataIntegrationConfig(integrationType, config string, diags *diag.Diagnostics) {
+	var values map[string]any
+	decoder := json.NewDecoder(strings.NewReader(config))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil || values == nil {
+		return
+	}
+	configPath := path.Root("config")
+
+	rules := []dataIntegrationConfigRules{dataIntegrationCommonRules}
+	if typeRules, ok := dataIntegrationConfigRulesByType[integrationType]; ok {
+		rules = append(rules, typeRules)
+	}
+
+	var missing []string
+	for _, r := range rules {
+		for _, key := range r.required {
+			if isEmptyConfigValue(values[key]) {
+				missing = append(missing, key)
+			}
+		}
+		for _, group := range r.oneOf {
+			found := false
+			for _, key := range group {
+				if !isEmptyConfigValue(values[key]) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				missing = append(missing, strings.Join(group, " or "))
+			}
+		}
+		for _, key := range r.stringLists {
+			value, ok := values[key]
+			if !ok {
+				continue
+			}
+			if !isStringList(value) {
+				diags.AddAttributeError(configPath, "Invalid Data Integration Config",
+					fmt.Sprintf("%s integration config: %q must be a list of strings.", integrationType, key))
+			}
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		diags.AddAttributeError(configPath, "Invalid Data Integration Config",
+			fmt.Sprintf("%s integration config is missing required fields: %s.", integrationType, strings.Join(missing, ", ")))
+	}
+
+	for _, key := range dataIntegrationDurationKeys {
+		value, ok := values[key]
+		if !ok {
+			continue
+		}
+		validateDataIntegrationDuration(integrationType, key, value, diags)
+	}
+}
+
+// validateDataIntegrationDuration checks that a duration is a positive whole
+// number of nanoseconds or a Go duration string. A number under a second,
+// which is almost always a duration written in seconds, is reported as a
+// warning.
+func validateDataIntegrationDuration(integrationType, key string, value any, diags *diag.Diagnostics) {
+	configPath := path.Root("config")
+
+	switch v := value.(type) {
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil || n <= 0 {
+			diags.AddAttributeError(configPath, "Invalid Data Integration Config",
+				fmt.Sprintf("%s integration config: %q must be a positive whole number of nanoseconds or a duration string such as \"5m\", got %s.", integrationType, key, v))
+			return
+		}
+		if n < int64(time.Second) {
+			diags.AddAttributeWarning(configPath, "Suspicious Data Integration Duration",
+				fmt.Sprintf("%s integration config: %q is %d nanoseconds (%s). Numeric durations are in nanoseconds; for %d seconds use %d or \"%ds\".",
+					integrationType, key, n, time.Duration(n), n, n*int64(time.Second), n))
+		}
+	case string:
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			diags.AddAttributeError(configPath, "Invalid Data Integration Config",
+				fmt.Sprintf("%s integration config: %q must be a positive duration such as \"30s\" or \"5m\", got %q.", integrationType, key, v))
+		}
+	default:
+		diags.AddAttributeError(configPath, "Invalid Data Integration Config",
+			fmt.Sprintf("%s integration config: %q must be a number of nanoseconds or a duration string such as \"5m\".", integrationType, key))
+	}
+}
+
+func isEmptyConfigValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+func isStringList(value any) bool {
+	list, ok := value.([]any)
+	if !ok {
+		return false
+	}
+	for _, item := range list {
+		if _, ok := item.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// dataIntegrationPauseScheduleModel is the pause_schedule attribute of
+// groundcover_dataintegration. The API has no scheduling of its own, so the
+// schedule is evaluated each time Terraform applies a change to the
+// integration.
+type dataIntegrationPauseScheduleModel struct {
+	Timezone   types.String `tfsdk:"timezone"`
+	Timeframes types.Set    `tfsdk:"timeframes"`
+}
+
+func dataIntegrationPauseScheduleAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Pauses the integration during recurring time windows, for example to stop CloudWatch polling outside business hours. " +
+			"The API has no scheduling, so the schedule takes effect when Terraform runs: a plan shows `is_paused` as known after apply whenever the integration is not paused or resumed as the schedule wants at that moment, or has other changes, and the apply then pauses or resumes it according to the time of the apply. " +
+			"Run Terraform on a schedule (for example a CI job at each window boundary) for the pauses to follow the timeframes. Cannot be combined with `is_paused`.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "IANA timezone name the timeframes are evaluated in (e.g. `UTC`, `America/New_York`).",
+				Required:            true,
+			},
+			"timeframes": schema.SetNestedAttribute{
+				MarkdownDescription: "The windows during which the integration is paused. An `end_time` at or before `start_time` ends on the next day, so `18:00`-`08:00` covers the night.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"day": schema.StringAttribute{
+							MarkdownDescription: "The day the window starts: `every_day` or a lowercase weekday name (`monday`..`sunday`).",
+							Required:            true,
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "Window start time, 24-hour `HH:MM` (e.g. `18:00`).",
+							Required:            true,
+						},
+						"end_time": schema.StringAttribute{
+							MarkdownDescription: "Window end time, 24-hour `HH:MM` (e.g. `08:00`).",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateDataIntegrationPauseSchedule checks the timezone and timeframes of
+// a configured pause_schedule.
+func validateDataIntegrationPauseSchedule(ctx context.Context, scheduleValue types.Object, diags *diag.Diagnostics) {
+	if scheduleValue.IsNull() || scheduleValue.IsUnknown() {
+		return
+	}
+	schedulePath := path.Root("pause_schedule")
+
+	var schedule dataIntegrationPauseScheduleModel
+	diags.Append(scheduleValue.As(ctx, &schedule, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return
+	}
+
+	if !schedule.Timezone.IsUnknown() {
+		if _, err := time.LoadLocation(schedule.Timezone.ValueString()); err != nil {
+			diags.AddAttributeError(schedulePath.AtName("timezone"), "Invalid timezone",
+				fmt.Sprintf("timezone must be a valid IANA timezone name (e.g. UTC, America/New_York): %s", err.Error()))
+		}
+	}
+
+	if schedule.Timeframes.IsUnknown() {
+		return
+	}
+	if len(schedule.Timeframes.Elements()) == 0 {
+		diags.AddAttributeError(schedulePath.AtName("timeframes"), "Empty timeframes", "timeframes must contain at least one entry.")
+		return
+	}
+	var timeframes []timeframeModel
+	if elemDiags := schedule.Timeframes.ElementsAs(ctx, &timeframes, false); elemDiags.HasError() {
+		diags.Append(elemDiags...)
+		return
+	}
+	for _, tf := range timeframes {
+		if !tf.Day.IsUnknown() && tf.Day.ValueString() != dailyTimeframeKey && !weekdays[tf.Day.ValueString()] {
+			diags.AddAttributeError(schedulePath.AtName("timeframes"), "Invalid timeframe day",
+				fmt.Sprintf("day must be %q or a lowercase weekday name (monday..sunday), got %q", dailyTimeframeKey, tf.Day.ValueString()))
+		}
+		for attrName, v := range map[string]types.String{"start_time": tf.StartTime, "end_time": tf.EndTime} {
+			if v.IsUnknown() {
+				continue
+			}
+			if _, ok := parseTimeOfDay(v.ValueString()); !ok {
+				diags.AddAttributeError(schedulePath.AtName("timeframes"), "Invalid timeframe time",
+					fmt.Sprintf("%s %q must be in 24-hour HH:MM format (e.g. 09:00)", attrName, v.ValueString()))
+			}
+		}
+	}
+}
+
+// dataIntegrationPausedAt reports whether now falls in one of the schedule's
+// timeframes. The schedule must be known and valid.
+func dataIntegrationPausedAt(ctx context.Context, scheduleValue types.Object, now time.Time) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var schedule dataIntegrationPauseScheduleModel
+	diags.Append(scheduleValue.As(ctx, &schedule, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return false, diags
+	}
+	location, err := time.LoadLocation(schedule.Timezone.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("pause_schedule").AtName("timezone"), "Invalid timezone", err.Error())
+		return false, diags
+	}
+	var timeframes []timeframeModel
+	diags.Append(schedule.Timeframes.ElementsAs(ctx, &timeframes, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	today := strings.ToLower(local.Weekday().String())
+	yesterday := strings.ToLower(local.AddDate(0, 0, -1).Weekday().String())
+
+	for _, tf := range timeframes {
+		start, startOK := parseTimeOfDay(tf.StartTime.ValueString())
+		end, endOK := parseTimeOfDay(tf.EndTime.ValueString())
+		if !startOK || !endOK {
+			continue
+		}
+		day := tf.Day.ValueString()
+		startsToday := day == dailyTimeframeKey || day == today
+		startedYesterday := day == dailyTimeframeKey || day == yesterday
+
+		if end > start {
+			if startsToday && minute >= start && minute < end {
+				return true, diags
+			}
+			continue
+		}
+		// The window crosses midnight: it covers the start day from
+		// start_time and the following day until end_time.
+		if (startsToday && minute >= start) || (startedYesterday && minute < end) {
+			return true, diags
+		}
+	}
+	return false, diags
+}
+
+// planDataIntegrationPaused plans is_paused when pause_schedule is set. The
+// time moves on between plan and apply, and Terraform plans again at apply
+// time, so a value planned from the schedule could differ from the one planned
+// then and fail the apply. is_paused is therefore only planned, along with the
+// rest of the prior state, when the integration has no other change and is
+// already paused or resumed as the schedule wants at now, so nothing is
+// applied. Otherwise it is left unknown and Create or Update evaluate the
+// schedule.
+func planDataIntegrationPaused(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, now time.Time) {
+	var schedule types.Object
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("pause_schedule"), &schedule)...)
+	if resp.Diagnostics.HasError() || schedule.IsNull() {
+		return
+	}
+
+	if !schedule.IsUnknown() && !req.State.Raw.IsNull() {
+		var statePaused types.Bool
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("is_paused"), &statePaused)...)
+		paused, diags := dataIntegrationPausedAt(ctx, schedule, now)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if paused == statePaused.ValueBool() {
+			// The default of is_paused differing from the prior state got
+			// updated_at and updated_by planned as unknown too.
+			unchanged := resp.Plan
+			for _, name := range []string{"is_paused", "updated_at", "updated_by"} {
+				var value attr.Value
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &value)...)
+				resp.Diagnostics.Append(unchanged.SetAttribute(ctx, path.Root(name), value)...)
+			}
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if unchanged.Raw.Equal(req.State.Raw) {
+				resp.Plan = unchanged
+				return
+			}
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_paused"), types.BoolUnknown())...)
+}
+
+// dataIntegrationPausedNow returns the is_paused value to apply: the planned
+// one, or whether pause_schedule pauses the integration now when the plan left
+// it to the apply.
+func dataIntegrationPausedNow(ctx context.Context, plan dataIntegrationResourceModel) (bool, diag.Diagnostics) {
+	if !plan.IsPaused.IsUnknown() || plan.PauseSchedule.IsNull() {
+		return plan.IsPaused.ValueBool(), nil
+	}
+	return dataIntegrationPausedAt(ctx, plan.PauseSchedule, time.Now())
+}
+
+// parseTimeOfDay parses an HH:MM time into minutes after midnight.
+func parseTimeOfDay(value string) (int, bool) {
+	parsed, err := time.Parse("15:04", value)
+	if err != nil || len(value) != 5 {
+		return 0, false
+	}
+	return parsed.Hour()*60 + parsed.Minute(), true
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// mergeDefaultTags returns defaults overlaid with tags, so a key set on the
+// resource takes precedence over the provider's default_tags. It returns nil
+// when both are empty.
+func mergeDefaultTags(defaults, tags map[string]string) map[string]string {
+	if len(defaults) == 0 && len(tags) == 0 {
+		return nil
+	}
+	merged := make(map[string]string, len(defaults)+len(tags))
+	maps.Copy(merged, defaults)
+	maps.Copy(merged, tags)
+	return merged
+}
+
+// withoutDefaultTags returns the entries of remote that are not default tags
+// applied by the provider: those whose key is in defaults with the same value
+// and is not in configured.
+func withoutDefaultTags(remote, configured, defaults map[string]string) map[string]string {
+	result := make(map[string]string, len(remote))
+	for key, value := range remote {
+		if defaultValue, ok := defaults[key]; ok && defaultValue == value {
+			if _, set := configured[key]; !set {
+				continue
+			}
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// stringMapValue returns values as a Terraform map, or a null map when values
+// is nil.
+func stringMapValue(ctx context.Context, values map[string]string) (types.Map, diag.Diagnostics) {
+	if values == nil {
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
+
+// dashboardDefaultTags renders default_tags as `key:value` dashboard tags,
+// sorted, leaving out the keys tags already sets as a `key:` tag.
+func dashboardDefaultTags(defaults map[string]string, tags []string) []string {
+	var rendered []string
+	for _, key := range slices.Sorted(maps.Keys(defaults)) {
+		prefix := key + ":"
+		if slices.ContainsFunc(tags, func(tag string) bool { return strings.HasPrefix(strings.TrimSpace(tag), prefix) }) {
+			continue
+		}
+		rendered = append(rendered, fmt.Sprintf("%s:%s", key, defaults[key]))
+	}
+	return rendered
+}
+
+// dashboardTagsAll returns the tags sent for a dashboard: tags followed by
+// the default tags it does not override, canonicalized like the backend does.
+func dashboardTagsAll(tags []string, defaults map[string]string) []string {
+	return canonicalizeTags(append(slices.Clone(tags), dashboardDefaultTags(defaults, tags)...))
+}
+
+// dashboardTagsWithoutDefaults returns the dashboard tags the API returned
+// without the default tags the provider added for configured.
+func dashboardTagsWithoutDefaults(apiTags, configured []string, defaults map[string]string) []string {
+	added := dashboardDefaultTags(defaults, configured)
+	if len(added) == 0 {
+		return apiTags
+	}
+	result := make([]string, 0, len(apiTags))
+	for _, tag := range apiTags {
+		if !slices.Contains(added, tag) || slices.Contains(configured, tag) {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// monitorLabelsAll returns the labels a groundcover_monitor is sent with: the
+// labels in monitorYaml merged over defaults. ok is false when the YAML does
+// not parse.
+func monitorLabelsAll(monitorYaml string, defaults map[string]string) (labels map[string]string, ok bool) {
+	yamlLabels, err := monitorYamlLabels(monitorYaml)
+	if err != nil {
+		return nil, false
+	}
+	return mergeDefaultTags(defaults, yamlLabels), true
+}
+
+// refreshMonitorLabelsAll returns labels_all after a read: the remote labels
+// whose keys labels_all tracks, which are the keys of the labels in
+// stateYaml and of defaults. Labels the server adds are left out.
+func refreshMonitorLabelsAll(ctx context.Context, current types.Map, stateYaml, remoteYaml string, defaults map[string]string) (types.Map, diag.Diagnostics) {
+	expected, ok := monitorLabelsAll(stateYaml, defaults)
+	if !ok {
+		return current, nil
+	}
+	remote, err := monitorYamlLabels(remoteYaml)
+	if err != nil {
+		return current, nil
+	}
+	if expected == nil {
+		return types.MapNull(types.StringType), nil
+	}
+	refreshed := make(map[string]string, len(expected))
+	for key := range expected {
+		if value, ok := remote[key]; ok {
+			refreshed[key] = value
+		}
+	}
+	return stringMapValue(ctx, refreshed)
+}
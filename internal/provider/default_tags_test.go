@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaults := map[string]string{"team": "platform", "cost_center": "1234"}
+
+	assert.Nil(t, mergeDefaultTags(nil, nil))
+	assert.Equal(t, defaults, mergeDefaultTags(defaults, nil))
+	assert.Equal(t,
+		map[string]string{"team": "payments", "cost_center": "1234", "env": "prod"},
+		mergeDefaultTags(defaults, map[string]string{"team": "payments", "env": "prod"}),
+	)
+}
+
+func TestWithoutDefaultTags(t *testing.T) {
+	defaults := map[string]string{"team": "platform", "cost_center": "1234"}
+	remote := map[string]string{"team": "platform", "cost_center": "9999", "env": "prod"}
+
+	// cost_center differs from the default, so it was changed outside
+	// Terraform and is reported.
+	assert.Equal(t, map[string]string{"cost_center": "9999", "env": "prod"}, withoutDefaultTags(remote, nil, defaults))
+	assert.Equal(t, remote, withoutDefaultTags(remote, map[string]string{"team": "platform"}, defaults))
+}
+
+func TestDashboardTagsAll(t *testing.T) {
+	defaults := map[string]string{"team": "platform", "cost_center": "1234"}
+
+	assert.Equal(t, []string{"cost_center:1234", "team:platform"}, dashboardTagsAll(nil, defaults))
+	assert.Equal(t, []string{"prod", "team:payments", "cost_center:1234"}, dashboardTagsAll([]string{"prod", " team:payments"}, defaults))
+	assert.Equal(t, []string{"prod"}, dashboardTagsAll([]string{"prod", "prod"}, nil))
+}
+
+func TestDashboardTagsWithoutDefaults(t *testing.T) {
+	defaults := map[string]string{"team": "platform", "cost_center": "1234"}
+
+	assert.Equal(t, []string{"prod"}, dashboardTagsWithoutDefaults([]string{"prod", "cost_center:1234", "team:platform"}, []string{"prod"}, defaults))
+	assert.Equal(t, []string{"prod", "team:platform"}, dashboardTagsWithoutDefaults([]string{"prod", "team:platform"}, []string{"prod", "team:platform"}, defaults))
+	assert.Equal(t, []string{"a", "b"}, dashboardTagsWithoutDefaults([]string{"a", "b"}, nil, nil))
+}
+
+func TestRefreshMonitorLabelsAll(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"cost_center": "1234"}
+	stateYaml := "title: errors\nlabels:\n  team: payments\n"
+	remoteYaml := "title: errors\nlabels:\n  team: payments\n  cost_center: \"1234\"\n  managed_by: groundcover\n"
+
+	got, diags := refreshMonitorLabelsAll(ctx, types.MapNull(types.StringType), stateYaml, remoteYaml, defaults)
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team":        types.StringValue("payments"),
+		"cost_center": types.StringValue("1234"),
+	}), got)
+
+	// A default tag removed outside Terraform is missing, so the plan
+	// restores it.
+	got, diags = refreshMonitorLabelsAll(ctx, types.MapNull(types.StringType), stateYaml, "title: errors\nlabels:\n  team: payments\n", defaults)
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("payments")}), got)
+}
+
+func TestMonitorCreateAddsDefaultTags(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	r := &monitorResource{client: client, defaultTags: map[string]string{"team": "platform", "cost_center": "1234"}}
+
+	created, diags := testMonitorCreate(ctx, r, testMonitorModel("title: Checkout errors\nlabels:\n  team: payments\n"))
+	require.False(t, diags.HasError(), "%v", diags)
+
+	var state monitorResourceModel
+	require.False(t, created.Get(ctx, &state).HasError())
+	labels, err := monitorYamlLabels(m.monitor(state.Id.ValueString()))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "payments", "cost_center": "1234"}, labels)
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team":        types.StringValue("payments"),
+		"cost_center": types.StringValue("1234"),
+	}), state.LabelsAll)
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// forceDeleteAttribute returns the force_delete attribute of a resource whose
+// delete first checks that nothing references it. dependents names what the
+// check looks for.
+func forceDeleteAttribute(dependents string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("Before it is deleted, the provider checks that no %s reference it, and fails listing them instead of sending a delete the API would reject. When `true`, the check is skipped and the delete is sent anyway. Defaults to `false`. As with any setting read at delete time, it must be applied before the destroy.", dependents),
+		Optional:    true,
+	}
+}
+
+// connectedAppDependentsDescription names the dependents connectedAppDependents
+// lists, for force_delete descriptions.
+const connectedAppDependentsDescription = "notification routes or workflows"
+
+// deleteDependent is an object that references the object being deleted.
+type deleteDependent struct {
+	kind string
+	id   string
+	name string
+}
+
+func (d deleteDependent) String() string {
+	if d.name == "" {
+		return fmt.Sprintf("%s %s", d.kind, d.id)
+	}
+	return fmt.Sprintf("%s %q (%s)", d.kind, d.name, d.id)
+}
+
+// checkDeleteDependents adds an error to diags when the kind object with the
+// given ID has dependents, or they could not be listed, unless forceDelete is
+// set. It reports whether the delete may go ahead.
+func checkDeleteDependents(ctx context.Context, forceDelete types.Bool, kind, id string, list func(context.Context) ([]deleteDependent, error), diags *diag.Diagnostics) bool {
+	if forceDelete.ValueBool() {
+		return true
+	}
+	dependents, err := list(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Checking Dependents Before Delete",
+			fmt.Sprintf("Could not check whether anything references %s %s: %s\n\nSet force_delete = true to delete it without the check.", kind, id, err),
+		)
+		return false
+	}
+	if len(dependents) == 0 {
+		return true
+	}
+
+	lines := make([]string, 0, len(dependents))
+	for _, dependent := range dependents {
+		lines = append(lines, "  - "+dependent.String())
+	}
+	diags.AddError(
+		"Cannot Delete Referenced Object",
+		fmt.Sprintf("The %s %s is still referenced by:\n%s\n\nRemove these references first, or set force_delete = true to send the delete anyway.", kind, id, strings.Join(lines, "\n")),
+	)
+	return false
+}
+
+// connectedAppDependents lists the notification routes and workflows that
+// deliver to the connected app with the given ID.
+func connectedAppDependents(client ApiClient, appID string) func(context.Context) ([]deleteDependent, error) {
+	return func(ctx context.Context) ([]deleteDependent, error) {
+		routes, err := client.ListNotificationRoutes(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing notification routes: %w", err)
+		}
+		workflows, err := client.ListWorkflows(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing workflows: %w", err)
+		}
+
+		var dependents []deleteDependent
+		for _, ref := range notificationRoutesReferencingConnectedApp(routes, appID) {
+			dependents = append(dependents, deleteDependent{kind: "notification route", id: ref.id, name: ref.name})
+		}
+		for _, ref := range workflowsReferencingConnectedApp(workflows, appID) {
+			dependents = append(dependents, deleteDependent{kind: "workflow", id: ref.id, name: ref.name})
+		}
+		return dependents, nil
+	}
+}
+
+// policyDependents lists the service accounts assigned the policy with the
+// given UUID, and the active API keys that hold it through their service
+// account.
+func policyDependents(client ApiClient, policyUUID string) func(context.Context) ([]deleteDependent, error) {
+	return func(ctx context.Context) ([]deleteDependent, error) {
+		serviceAccounts, err := client.ListServiceAccounts(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing service accounts: %w", err)
+		}
+		withRevoked, withExpired := false, false
+		apiKeys, err := client.ListApiKeys(ctx, &withRevoked, &withExpired)
+		if err != nil {
+			return nil, fmt.Errorf("listing API keys: %w", err)
+		}
+
+		var dependents []deleteDependent
+		for _, sa := range serviceAccounts {
+			if sa != nil && !sa.Deleted && policyRefsContain(sa.Policies, policyUUID) {
+				dependents = append(dependents, deleteDependent{kind: "service account", id: sa.ServiceAccountID, name: sa.Name})
+			}
+		}
+		for _, key := range apiKeys {
+			if key != nil && policyRefsContain(key.Policies, policyUUID) {
+				dependents = append(dependents, deleteDependent{kind: "API key", id: key.ID, name: key.Name})
+			}
+		}
+		return dependents, nil
+	}
+}
+
+func policyRefsContain(refs []*models.PolicyRef, policyUUID string) bool {
+	for _, ref := range refs {
+		if ref != nil && ref.UUID == policyUUID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDeleteDependentsOfConnectedApp(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+
+	appName, appType := "pager", "pagerduty"
+	app, err := client.CreateConnectedApp(ctx, &models.CreateConnectedAppRequest{Name: &appName, Type: &appType, Data: map[string]any{}})
+	require.NoError(t, err)
+	routeName, query := "oncall", "*"
+	route, err := client.CreateNotificationRoute(ctx, &models.CreateNotificationRouteRequest{
+		Name:   &routeName,
+		Query:  &query,
+		Routes: []*models.RouteRuleRequest{{ConnectedApps: []*models.RouteConnectedAppRequest{{ID: &app.ID, Type: &appType}}}},
+	})
+	require.NoError(t, err)
+	m.addWorkflow(&models.Workflow{ID: "w1", Name: "escalate", Providers: []*models.Provider{{ID: app.ID}}})
+
+	var diags diag.Diagnostics
+	assert.False(t, checkDeleteDependents(ctx, types.BoolNull(), "connected app", app.ID, connectedAppDependents(client, app.ID), &diags))
+	require.True(t, diags.HasError())
+	assert.Equal(t, fmt.Sprintf(`The connected app %s is still referenced by:
+  - notification route "oncall" (%s)
+  - workflow "escalate" (w1)
+
+Remove these references first, or set force_delete = true to send the delete anyway.`, app.ID, route.ID), diags.Errors()[0].Detail())
+
+	diags = nil
+	assert.True(t, checkDeleteDependents(ctx, types.BoolNull(), "connected app", "unused", connectedAppDependents(client, "unused"), &diags))
+	assert.True(t, checkDeleteDependents(ctx, types.BoolValue(true), "connected app", app.ID, connectedAppDependents(client, app.ID), &diags))
+	assert.False(t, diags.HasError())
+
+	m.fail(http.MethodPost, "/api/notification-routes/v1/list", http.StatusForbidden, 1)
+	assert.False(t, checkDeleteDependents(ctx, types.BoolValue(false), "connected app", app.ID, connectedAppDependents(client, app.ID), &diags))
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "listing notification routes: ")
+}
+
+func TestPolicyDependents(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+
+	createPolicy := func(name string) string {
+		policy, err := client.CreatePolicy(ctx, &models.CreatePolicyRequest{Name: &name, Role: models.RoleMap{"read": "read"}})
+		require.NoError(t, err)
+		return policy.UUID
+	}
+	policy, other := createPolicy("policy"), createPolicy("other")
+	createServiceAccount := func(name string, policies ...string) string {
+		email := name + "@example.com"
+		account, err := client.CreateServiceAccount(ctx, &models.CreateServiceAccountRequest{Name: &name, Email: &email, PolicyUUIDs: policies})
+		require.NoError(t, err)
+		return *account.ServiceAccountID
+	}
+	createAPIKey := func(name, serviceAccountID string) string {
+		key, err := client.CreateApiKey(ctx, &models.CreateAPIKeyRequest{Name: &name, ServiceAccountID: &serviceAccountID})
+		require.NoError(t, err)
+		return key.ID
+	}
+
+	ci := createServiceAccount("ci", other, policy)
+	deleted := createServiceAccount("deleted", policy)
+	// The API can list a deleted service account, flagged as deleted.
+	m.serviceAccounts[deleted].Deleted = true
+	unrelated := createServiceAccount("unrelated", other)
+	ciKey := createAPIKey("ci-key", ci)
+	require.NoError(t, client.DeleteApiKey(ctx, createAPIKey("revoked-key", ci)))
+	createAPIKey("unrelated-key", unrelated)
+
+	dependents, err := policyDependents(client, policy)(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []deleteDependent{
+		{kind: "service account", id: ci, name: "ci"},
+		{kind: "API key", id: ciKey, name: "ci-key"},
+	}, dependents)
+}
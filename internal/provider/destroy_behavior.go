@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	destroyBehaviorDelete  = "delete"
+	destroyBehaviorAbandon = "abandon"
+)
+
+// destroyBehaviorAttribute returns the destroy_behavior attribute of a
+// resource whose remote object is called noun in its description.
+func destroyBehaviorAttribute(noun string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("What destroying this resource does to the remote %[1]s: `\"delete\"` deletes it, `\"abandon\"` only removes it from Terraform state and leaves the %[1]s in groundcover, for example when another workspace takes it over. The value in state is the one used, so apply a change to `\"abandon\"` before destroying. Defaults to `\"delete\"`, or to `\"abandon\"` when the provider's `features` block sets `abandon_on_destroy_default`.", noun),
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(destroyBehaviorDelete, destroyBehaviorAbandon),
+		},
+	}
+}
+
+// abandonOnDestroy reports whether destroy_behavior asks to leave the remote
+// object in place. It adds a warning naming the abandoned object when it does.
+func abandonOnDestroy(destroyBehavior types.String, kind, id string, diags *diag.Diagnostics) bool {
+	if destroyBehavior.ValueString() != destroyBehaviorAbandon {
+		return false
+	}
+	diags.AddWarning(
+		fmt.Sprintf("%s Abandoned", kind),
+		fmt.Sprintf("destroy_behavior is \"abandon\", so %s %s was removed from Terraform state but not deleted in groundcover.", kind, id),
+	)
+	return true
+}
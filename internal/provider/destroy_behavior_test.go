@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAbandonOnDestroy(t *testing.T) {
+	tests := []struct {
+		name            string
+		destroyBehavior types.String
+		want            bool
+	}{
+		{name: "unset", destroyBehavior: types.StringNull(), want: false},
+		{name: "delete", destroyBehavior: types.StringValue(destroyBehaviorDelete), want: false},
+		{name: "abandon", destroyBehavior: types.StringValue(destroyBehaviorAbandon), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := abandonOnDestroy(tt.destroyBehavior, "Dashboard", "abc", &diags)
+			if got != tt.want {
+				t.Fatalf("abandonOnDestroy() = %v, want %v", got, tt.want)
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if (diags.WarningsCount() == 1) != tt.want {
+				t.Fatalf("expected a warning only when abandoning, got %v", diags)
+			}
+		})
+	}
+}
+
+func TestProviderFeaturesDestroyBehavior(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    *providerFeaturesModel
+		behavior types.String
+		want     string
+		wantErr  bool
+	}{
+		{name: "no features block", behavior: types.StringNull(), want: ""},
+		{
+			name:     "abandon by default",
+			model:    &providerFeaturesModel{AbandonOnDestroyDefault: types.BoolValue(true)},
+			behavior: types.StringNull(),
+			want:     destroyBehaviorAbandon,
+		},
+		{
+			name:     "explicit delete wins",
+			model:    &providerFeaturesModel{AbandonOnDestroyDefault: types.BoolValue(true)},
+			behavior: types.StringValue(destroyBehaviorDelete),
+			want:     destroyBehaviorDelete,
+		},
+		{
+			name:    "protection conflicts with abandon",
+			model:   &providerFeaturesModel{AbandonOnDestroyDefault: types.BoolValue(true), DeleteProtectionDefault: types.BoolValue(true)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			features, diags := providerFeaturesFromModel(tt.model)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("providerFeaturesFromModel() diagnostics = %v, want error %v", diags, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := features.destroyBehavior(tt.behavior).ValueString(); got != tt.want {
+				t.Fatalf("destroyBehavior() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	features, _ := providerFeaturesFromModel(&providerFeaturesModel{SuppressMonitorFormattingDrift: types.BoolValue(false)})
+	if !features.planMonitorReformatting {
+		t.Fatal("suppress_monitor_formatting_drift = false must plan reformatting")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 )
 
 // NormalizeJSON normalizes a JSON string by parsing and re-marshaling it with sorted keys
@@ -86,6 +87,75 @@ func CompareJSONSemantically(json1, json2 string) (bool, error) {
 	return reflect.DeepEqual(data1, data2), nil
 }
 
+// CompareJSONSemanticallyIgnoringPaths compares two JSON strings semantically
+// after removing ignorePaths from both (see RemoveJSONPaths).
+func CompareJSONSemanticallyIgnoringPaths(json1, json2 string, ignorePaths []string) (bool, error) {
+	if len(ignorePaths) == 0 {
+		return CompareJSONSemantically(json1, json2)
+	}
+	stripped1, err := RemoveJSONPaths(json1, ignorePaths)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse first JSON: %w", err)
+	}
+	stripped2, err := RemoveJSONPaths(json2, ignorePaths)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse second JSON: %w", err)
+	}
+	return CompareJSONSemantically(stripped1, stripped2)
+}
+
+// RemoveJSONPaths removes the value at each of paths from jsonString. A path is
+// a dot-separated list of object keys, such as `layout.updatedAt`; a `*` segment
+// matches every element of an array or every key of an object, so
+// `widgets.*.id` removes the id of each widget. Paths that do not exist are
+// ignored.
+func RemoveJSONPaths(jsonString string, paths []string) (string, error) {
+	var data interface{}
+	if err := json.Unmarshal([]byte(jsonString), &data); err != nil {
+		return "", fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	for _, p := range paths {
+		removeJSONPath(data, strings.Split(p, "."))
+	}
+	result, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	return string(result), nil
+}
+
+func removeJSONPath(data interface{}, segments []string) {
+	if len(segments) == 0 {
+		return
+	}
+	segment, rest := segments[0], segments[1:]
+	switch v := data.(type) {
+	case map[string]interface{}:
+		if segment == "*" {
+			for key, child := range v {
+				if len(rest) == 0 {
+					delete(v, key)
+				} else {
+					removeJSONPath(child, rest)
+				}
+			}
+			return
+		}
+		if len(rest) == 0 {
+			delete(v, segment)
+		} else if child, ok := v[segment]; ok {
+			removeJSONPath(child, rest)
+		}
+	case []interface{}:
+		if segment != "*" || len(rest) == 0 {
+			return
+		}
+		for _, item := range v {
+			removeJSONPath(item, rest)
+		}
+	}
+}
+
 // FilterJSONKeysBasedOnTemplate filters sourceJSON to only include keys that exist in templateJSON
 func FilterJSONKeysBasedOnTemplate(ctx context.Context, sourceJSON, templateJSON string) (string, error) {
 	if sourceJSON == "" {
@@ -242,3 +242,41 @@ func TestNormalizeJSON_ThenCompare(t *testing.T) {
 		t.Errorf("Normalized JSONs should be semantically the same")
 	}
 }
+
+func TestCompareJSONSemanticallyIgnoringPaths(t *testing.T) {
+	state := `{"layout":[{"id":"a1","x":0},{"id":"a2","x":6}],"updatedAt":"2024-01-01","title":"Checkout"}`
+	remote := `{"title":"Checkout","updatedAt":"2024-06-01","layout":[{"x":0,"id":"b7"},{"x":6,"id":"b8"}]}`
+
+	tests := []struct {
+		name     string
+		remote   string
+		paths    []string
+		expected bool
+	}{
+		{name: "no paths", remote: remote, expected: false},
+		{name: "volatile keys ignored", remote: remote, paths: []string{"layout.*.id", "updatedAt"}, expected: true},
+		{name: "only some volatile keys ignored", remote: remote, paths: []string{"updatedAt"}, expected: false},
+		{name: "missing paths are skipped", remote: remote, paths: []string{"layout.*.id", "updatedAt", "variables.*", "title.length"}, expected: true},
+		{
+			name:     "other changes still differ",
+			remote:   `{"title":"Payments","updatedAt":"2024-06-01","layout":[{"x":0,"id":"b7"},{"x":6,"id":"b8"}]}`,
+			paths:    []string{"layout.*.id", "updatedAt"},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := CompareJSONSemanticallyIgnoringPaths(state, tt.remote, tt.paths)
+			if err != nil {
+				t.Fatalf("CompareJSONSemanticallyIgnoringPaths() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("CompareJSONSemanticallyIgnoringPaths() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	if _, err := CompareJSONSemanticallyIgnoringPaths(state, `{"title":`, []string{"updatedAt"}); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
@@ -0,0 +1,1324 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// Mock API mode runs the acceptance tests against mockAPI, an in-memory fake
+// of the groundcover API, instead of a real workspace:
+//
+//	GROUNDCOVER_MOCK_API=1 go test ./internal/provider -run '^TestAcc'
+//
+// No credentials or TF_ACC are needed, only a Terraform CLI. Tests of
+// resources the mock does not serve are skipped.
+const mockAPIEnvVar = "GROUNDCOVER_MOCK_API"
+
+// mockAPITests matches the acceptance tests whose resources and data sources
+// mockAPI serves.
+var mockAPITests = regexp.MustCompile(`^TestAcc(PolicyResource|PolicyBundleResource|ServiceAccountResource|ApiKeyResource|ApiKeyDataSource|IngestionKeyResource|SecretResource|ConnectedApp|IncidentWebhookResource|NotificationRoute|MonitorResource|MonitorsExportDataSource|DataIntegrationResource)`)
+
+// mockAPIKey and mockAPIBackendID are the credentials mockAPI accepts.
+const (
+	mockAPIKey       = "mock-api-key"
+	mockAPIBackendID = "mock-backend"
+)
+
+func mockAPIEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(mockAPIEnvVar))
+	return enabled
+}
+
+// useMockAPI points the provider of the acceptance test t at a new mockAPI,
+// or skips t when the mock does not serve its resources.
+func useMockAPI(t *testing.T) *mockAPI {
+	t.Helper()
+	if !mockAPITests.MatchString(t.Name()) {
+		t.Skipf("%s is not served by the mock API", t.Name())
+	}
+	m := newMockAPI(t)
+	t.Setenv("GROUNDCOVER_API_URL", m.URL())
+	t.Setenv("GROUNDCOVER_API_KEY", mockAPIKey)
+	t.Setenv("GROUNDCOVER_BACKEND_ID", mockAPIBackendID)
+	return m
+}
+
+// mockAPI is an in-memory fake of the API endpoints behind the policy,
+// service account, API key, ingestion key, secret, connected app,
+// notification route, monitor, silence, and data integration resources, of
+// the dashboard reads, and of the workflow and cluster lists. It follows the status codes and payloads of the SDK, checks the
+// credentials of every request, and can be told to fail requests to exercise
+// retries and error mapping.
+type mockAPI struct {
+	server *httptest.Server
+
+	mu                 sync.Mutex
+	nextID             int
+	requests           []string
+	faults             map[string][]int
+	policies           map[string]*models.Policy
+	serviceAccounts    map[string]*models.ServiceAccountsWithPolicy
+	apiKeys            map[string]*models.ListAPIKeysResponseItem
+	ingestionKeys      map[string]*models.IngestionKeyResult
+	secrets            map[string]*mockSecret
+	connectedApps      map[string]*models.ConnectedAppResponse
+	notificationRoutes map[string]*models.NotificationRouteResponse
+	monitors           map[string]string
+	silences           map[string]*models.Silence
+	dashboards         map[string]*models.View
+	dataIntegrations   map[string]*models.DataIntegrationConfig
+	workflows          map[string]*models.Workflow
+	clusters           map[string]*models.ClustersListResult
+}
+
+type mockSecret struct {
+	models.SecretHashResponse
+	content string
+}
+
+// mockResponse is the status and body a mockAPI handler responds with. A
+// []byte body is sent as YAML, anything else as JSON.
+type mockResponse struct {
+	status int
+	body   any
+}
+
+func newMockAPI(t *testing.T) *mockAPI {
+	t.Helper()
+	m := &mockAPI{
+		faults:             map[string][]int{},
+		policies:           map[string]*models.Policy{},
+		serviceAccounts:    map[string]*models.ServiceAccountsWithPolicy{},
+		apiKeys:            map[string]*models.ListAPIKeysResponseItem{},
+		ingestionKeys:      map[string]*models.IngestionKeyResult{},
+		secrets:            map[string]*mockSecret{},
+		connectedApps:      map[string]*models.ConnectedAppResponse{},
+		notificationRoutes: map[string]*models.NotificationRouteResponse{},
+		monitors:           map[string]string{},
+		silences:           map[string]*models.Silence{},
+		dashboards:         map[string]*models.View{},
+		dataIntegrations:   map[string]*models.DataIntegrationConfig{},
+		workflows:          map[string]*models.Workflow{},
+		clusters:           map[string]*models.ClustersListResult{},
+	}
+
+	mux := http.NewServeMux()
+	handlers := map[string]func(*http.Request) mockResponse{
+		"POST /api/rbac/policy/create":                        m.createPolicy,
+		"GET /api/rbac/policy/{id}":                           m.getPolicy,
+		"PUT /api/rbac/policy/{id}":                           m.updatePolicy,
+		"DELETE /api/rbac/policy/{id}":                        m.deletePolicy,
+		"GET /api/rbac/policies/list":                         m.listPolicies,
+		"POST /api/rbac/service-account/create":               m.createServiceAccount,
+		"PUT /api/rbac/service-account/update":                m.updateServiceAccount,
+		"DELETE /api/rbac/service-account/{id}":               m.deleteServiceAccount,
+		"GET /api/rbac/service-accounts/list":                 m.listServiceAccounts,
+		"POST /api/rbac/apikey/create":                        m.createAPIKey,
+		"DELETE /api/rbac/apikey/{id}":                        m.deleteAPIKey,
+		"GET /api/rbac/apikeys/list":                          m.listAPIKeys,
+		"POST /api/rbac/ingestion-keys/create":                m.createIngestionKey,
+		"DELETE /api/rbac/ingestion-keys/delete":              m.deleteIngestionKey,
+		"POST /api/rbac/ingestion-keys/list":                  m.listIngestionKeys,
+		"POST /api/secret":                                    m.createSecret,
+		"PUT /api/secret/{id}":                                m.updateSecret,
+		"DELETE /api/secret/{id}":                             m.deleteSecret,
+		"GET /api/secret/{id}/hash":                           m.getSecretHash,
+		"POST /api/connected-apps/v1":                         m.createConnectedApp,
+		"GET /api/connected-apps/v1/{id}":                     m.getConnectedApp,
+		"PUT /api/connected-apps/v1/{id}":                     m.updateConnectedApp,
+		"DELETE /api/connected-apps/v1/{id}":                  m.deleteConnectedApp,
+		"POST /api/connected-apps/v1/list":                    m.listConnectedApps,
+		"POST /api/notification-routes/v1":                    m.createNotificationRoute,
+		"GET /api/notification-routes/v1/{id}":                m.getNotificationRoute,
+		"PUT /api/notification-routes/v1/{id}":                m.updateNotificationRoute,
+		"DELETE /api/notification-routes/v1/{id}":             m.deleteNotificationRoute,
+		"POST /api/notification-routes/v1/list":               m.listNotificationRoutes,
+		"POST /api/monitors":                                  m.createMonitor,
+		"GET /api/monitors/{id}":                              m.getMonitor,
+		"PUT /api/monitors/{id}":                              m.updateMonitor,
+		"DELETE /api/monitors/{id}":                           m.deleteMonitor,
+		"POST /api/monitors/list":                             m.listMonitors,
+		"POST /api/monitors/silences":                         m.createSilence,
+		"GET /api/monitors/silences/{id}":                     m.getSilence,
+		"PUT /api/monitors/silences/{id}":                     m.updateSilence,
+		"DELETE /api/monitors/silences/{id}":                  m.deleteSilence,
+		"GET /api/dashboards":                                 m.listDashboards,
+		"GET /api/dashboards/{id}":                            m.getDashboard,
+		"POST /api/integrations/v1/data/config/{type}":        m.createDataIntegration,
+		"GET /api/integrations/v1/data/config/{type}/{id}":    m.getDataIntegration,
+		"PUT /api/integrations/v1/data/config/{type}/{id}":    m.updateDataIntegration,
+		"DELETE /api/integrations/v1/data/config/{type}/{id}": m.deleteDataIntegration,
+		"POST /api/workflows/list":                            m.listWorkflows,
+		"POST /api/k8s/v3/clusters/list":                      m.listClusters,
+	}
+	for pattern, handler := range handlers {
+		mux.HandleFunc(pattern, m.serve(handler))
+	}
+	mux.HandleFunc("/", m.serve(func(r *http.Request) mockResponse {
+		return mockError(http.StatusNotImplemented, "%s %s is not implemented by the mock API", r.Method, r.URL.Path)
+	}))
+
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+// URL returns the base URL of the mock API.
+func (m *mockAPI) URL() string {
+	return m.server.URL
+}
+
+// transport returns a transport that serves requests from the mock API
+// in-process, without a network connection.
+func (m *mockAPI) transport() http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		recorder := httptest.NewRecorder()
+		m.server.Config.Handler.ServeHTTP(recorder, req)
+		return recorder.Result(), nil
+	})
+}
+
+// fail makes the next times requests to method and path fail with status.
+func (m *mockAPI) fail(method, path string, status, times int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := method + " " + path
+	for range times {
+		m.faults[key] = append(m.faults[key], status)
+	}
+}
+
+// requestCount returns how many requests were made to method and path,
+// including failed ones.
+func (m *mockAPI) requestCount(method, path string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := method + " " + path
+	count := 0
+	for _, request := range m.requests {
+		if request == key {
+			count++
+		}
+	}
+	return count
+}
+
+func (m *mockAPI) serve(handler func(*http.Request) mockResponse) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp := m.dispatch(r, handler)
+		switch body := resp.body.(type) {
+		case nil:
+			w.WriteHeader(resp.status)
+		case []byte:
+			w.Header().Set("Content-Type", yamlContentType)
+			w.WriteHeader(resp.status)
+			_, _ = w.Write(body)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(resp.status)
+			_ = json.NewEncoder(w).Encode(body)
+		}
+	}
+}
+
+// dispatch runs handler for r under the lock, after recording r, checking
+// its credentials, and applying any injected failure.
+func (m *mockAPI) dispatch(r *http.Request, handler func(*http.Request) mockResponse) mockResponse {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := r.Method + " " + r.URL.Path
+	m.requests = append(m.requests, key)
+	if r.Header.Get("Authorization") != "Bearer "+mockAPIKey {
+		return mockError(http.StatusUnauthorized, "invalid API key")
+	}
+	if r.Header.Get("X-Backend-Id") != mockAPIBackendID {
+		return mockError(http.StatusForbidden, "unknown backend")
+	}
+	if faults := m.faults[key]; len(faults) > 0 {
+		m.faults[key] = faults[1:]
+		return mockError(faults[0], "injected failure")
+	}
+	return handler(r)
+}
+
+func mockError(status int, format string, args ...any) mockResponse {
+	return mockResponse{status: status, body: map[string]string{"message": fmt.Sprintf(format, args...)}}
+}
+
+func mockDecode(r *http.Request, v any) *mockResponse {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		resp := mockError(http.StatusBadRequest, "invalid request body: %s", err.Error())
+		return &resp
+	}
+	return nil
+}
+
+// newID returns a new UUID. IDs are sequential so failures are reproducible.
+func (m *mockAPI) newID() string {
+	m.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", m.nextID)
+}
+
+func mockNow() strfmt.DateTime {
+	return strfmt.DateTime(time.Now().UTC().Truncate(time.Second))
+}
+
+func mockHash(content string) string {
+	h := fnv.New64a()
+	_, _ = io.WriteString(h, content)
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+// sortedValues returns the values of items ordered by key, so list responses
+// are stable.
+func sortedValues[V any](items map[string]V) []V {
+	values := make([]V, 0, len(items))
+	for _, key := range slices.Sorted(maps.Keys(items)) {
+		values = append(values, items[key])
+	}
+	return values
+}
+
+// Policies
+
+func (m *mockAPI) createPolicy(r *http.Request) mockResponse {
+	var req models.CreatePolicyRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.Name == nil || *req.Name == "" {
+		return mockError(http.StatusBadRequest, "name is required")
+	}
+	for _, policy := range m.policies {
+		if *policy.Name == *req.Name {
+			return mockError(http.StatusConflict, "policy name %q is already in use", *req.Name)
+		}
+	}
+
+	now := mockNow()
+	policy := &models.Policy{
+		UUID:             m.newID(),
+		Name:             req.Name,
+		Description:      req.Description,
+		ClaimRole:        req.ClaimRole,
+		Role:             req.Role,
+		DataScope:        req.DataScope,
+		RevisionNumber:   1,
+		ReadOnly:         new(bool),
+		CreatedBy:        "terraform",
+		CreatedTimestamp: now,
+		UpdatedBy:        "terraform",
+		UpdatedTimestamp: now,
+		TenantUUID:       mockAPIBackendID,
+	}
+	m.policies[policy.UUID] = policy
+	return mockResponse{http.StatusCreated, policy}
+}
+
+func (m *mockAPI) getPolicy(r *http.Request) mockResponse {
+	policy, ok := m.policies[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "policy not found")
+	}
+	return mockResponse{http.StatusOK, policy}
+}
+
+func (m *mockAPI) updatePolicy(r *http.Request) mockResponse {
+	policy, ok := m.policies[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "policy not found")
+	}
+	var req models.UpdatePolicyRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.CurrentRevision != 0 && req.CurrentRevision != policy.RevisionNumber {
+		return mockError(http.StatusConflict, "conflict: policy revision is %d, not %d", policy.RevisionNumber, req.CurrentRevision)
+	}
+
+	updated := *policy
+	updated.Name = req.Name
+	updated.Description = req.Description
+	updated.ClaimRole = req.ClaimRole
+	updated.Role = req.Role
+	updated.DataScope = req.DataScope
+	updated.RevisionNumber++
+	updated.UpdatedTimestamp = mockNow()
+	m.policies[updated.UUID] = &updated
+	return mockResponse{http.StatusAccepted, &updated}
+}
+
+func (m *mockAPI) deletePolicy(r *http.Request) mockResponse {
+	id := r.PathValue("id")
+	if _, ok := m.policies[id]; !ok {
+		return mockError(http.StatusNotFound, "policy not found")
+	}
+	delete(m.policies, id)
+	return mockResponse{http.StatusOK, map[string]string{}}
+}
+
+func (m *mockAPI) listPolicies(_ *http.Request) mockResponse {
+	policies := []*models.PolicyWithEntityCount{}
+	for _, policy := range sortedValues(m.policies) {
+		policies = append(policies, &models.PolicyWithEntityCount{Policy: *policy})
+	}
+	return mockResponse{http.StatusOK, policies}
+}
+
+// policyRefs returns references to the policies with the given UUIDs.
+func (m *mockAPI) policyRefs(uuids []string) ([]*models.PolicyRef, *mockResponse) {
+	refs := []*models.PolicyRef{}
+	for _, uuid := range uuids {
+		policy, ok := m.policies[uuid]
+		if !ok {
+			resp := mockError(http.StatusBadRequest, "policy %q does not exist", uuid)
+			return nil, &resp
+		}
+		refs = append(refs, &models.PolicyRef{UUID: uuid, Name: *policy.Name})
+	}
+	return refs, nil
+}
+
+// Service accounts
+
+func (m *mockAPI) createServiceAccount(r *http.Request) mockResponse {
+	var req models.CreateServiceAccountRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.Name == nil || req.Email == nil {
+		return mockError(http.StatusBadRequest, "name and email are required")
+	}
+	for _, account := range m.serviceAccounts {
+		if account.Name == *req.Name {
+			return mockError(http.StatusConflict, "service account name %q is already in use", *req.Name)
+		}
+	}
+	policies, errResp := m.policyRefs(req.PolicyUUIDs)
+	if errResp != nil {
+		return *errResp
+	}
+
+	id := m.newID()
+	m.serviceAccounts[id] = &models.ServiceAccountsWithPolicy{
+		ServiceAccountID: id,
+		Name:             *req.Name,
+		Email:            *req.Email,
+		Policies:         policies,
+	}
+	return mockResponse{http.StatusOK, &models.ServiceAccountCreatePayload{ServiceAccountID: &id}}
+}
+
+func (m *mockAPI) updateServiceAccount(r *http.Request) mockResponse {
+	var req models.UpdateServiceAccountRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.ServiceAccountID == nil {
+		return mockError(http.StatusBadRequest, "serviceAccountId is required")
+	}
+	account, ok := m.serviceAccounts[*req.ServiceAccountID]
+	if !ok {
+		return mockError(http.StatusNotFound, "service account not found")
+	}
+
+	updated := *account
+	if req.Email != "" {
+		updated.Email = req.Email
+	}
+	if req.PolicyUUIDs != nil {
+		uuids := req.PolicyUUIDs
+		if !req.OverridePolicies {
+			for _, ref := range account.Policies {
+				if !slices.Contains(uuids, ref.UUID) {
+					uuids = append(uuids, ref.UUID)
+				}
+			}
+		}
+		policies, errResp := m.policyRefs(uuids)
+		if errResp != nil {
+			return *errResp
+		}
+		updated.Policies = policies
+	}
+	m.serviceAccounts[updated.ServiceAccountID] = &updated
+	return mockResponse{http.StatusOK, &models.UpdateServiceAccountResponse{ServiceAccountID: updated.ServiceAccountID}}
+}
+
+func (m *mockAPI) deleteServiceAccount(r *http.Request) mockResponse {
+	id := r.PathValue("id")
+	if _, ok := m.serviceAccounts[id]; !ok {
+		return mockError(http.StatusNotFound, "service account not found")
+	}
+	delete(m.serviceAccounts, id)
+	for keyID, key := range m.apiKeys {
+		if key.ServiceAccountID == id {
+			delete(m.apiKeys, keyID)
+		}
+	}
+	return mockResponse{http.StatusAccepted, map[string]string{}}
+}
+
+func (m *mockAPI) listServiceAccounts(_ *http.Request) mockResponse {
+	return mockResponse{http.StatusOK, sortedValues(m.serviceAccounts)}
+}
+
+// API keys
+
+func (m *mockAPI) createAPIKey(r *http.Request) mockResponse {
+	var req models.CreateAPIKeyRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.Name == nil || req.ServiceAccountID == nil {
+		return mockError(http.StatusBadRequest, "name and serviceAccountId are required")
+	}
+	account, ok := m.serviceAccounts[*req.ServiceAccountID]
+	if !ok {
+		return mockError(http.StatusBadRequest, "service account %q does not exist", *req.ServiceAccountID)
+	}
+	for _, key := range m.apiKeys {
+		if key.Name == *req.Name {
+			return mockError(http.StatusConflict, "API key name %q is already in use", *req.Name)
+		}
+	}
+
+	key := &models.ListAPIKeysResponseItem{
+		ID:                 m.newID(),
+		Name:               *req.Name,
+		Description:        req.Description,
+		ServiceAccountID:   account.ServiceAccountID,
+		ServiceAccountName: account.Name,
+		Policies:           account.Policies,
+		CreatedBy:          "terraform",
+		CreationDate:       mockNow(),
+	}
+	if req.ExpirationDate != nil {
+		key.ExpiredAt = *req.ExpirationDate
+	}
+	m.apiKeys[key.ID] = key
+	return mockResponse{http.StatusOK, &models.CreateAPIKeyResponse{ID: key.ID, APIKey: "gcsa_" + key.ID}}
+}
+
+// deleteAPIKey revokes the key. Revoking a revoked key succeeds, as it does
+// in the API.
+func (m *mockAPI) deleteAPIKey(r *http.Request) mockResponse {
+	key, ok := m.apiKeys[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "API key not found")
+	}
+	if time.Time(key.RevokedAt).IsZero() {
+		key.RevokedAt = mockNow()
+	}
+	return mockResponse{http.StatusAccepted, map[string]string{}}
+}
+
+func (m *mockAPI) listAPIKeys(r *http.Request) mockResponse {
+	withRevoked, _ := strconv.ParseBool(r.URL.Query().Get("withRevoked"))
+	withExpired, _ := strconv.ParseBool(r.URL.Query().Get("withExpired"))
+	now := time.Now()
+
+	keys := []*models.ListAPIKeysResponseItem{}
+	for _, key := range sortedValues(m.apiKeys) {
+		if !withRevoked && !time.Time(key.RevokedAt).IsZero() {
+			continue
+		}
+		if expiry := time.Time(key.ExpiredAt); !withExpired && !expiry.IsZero() && expiry.Before(now) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return mockResponse{http.StatusOK, keys}
+}
+
+// Ingestion keys, which the API addresses by name
+
+func (m *mockAPI) createIngestionKey(r *http.Request) mockResponse {
+	var req models.CreateIngestionKeyRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.Name == nil || req.Type == nil {
+		return mockError(http.StatusBadRequest, "name and type are required")
+	}
+	if _, ok := m.ingestionKeys[*req.Name]; ok {
+		return mockError(http.StatusConflict, "ingestion key name %q is already in use", *req.Name)
+	}
+
+	id := m.newID()
+	key := &models.IngestionKeyResult{
+		ID:        id,
+		Name:      *req.Name,
+		Type:      *req.Type,
+		Tags:      req.Tags,
+		Key:       "gcik_" + id,
+		CreatedBy: "terraform",
+	}
+	if req.RemoteConfig != nil {
+		key.RemoteConfig = *req.RemoteConfig
+	}
+	if key.Tags == nil {
+		key.Tags = []string{}
+	}
+	m.ingestionKeys[key.Name] = key
+	return mockResponse{http.StatusCreated, key}
+}
+
+func (m *mockAPI) deleteIngestionKey(r *http.Request) mockResponse {
+	var req models.DeleteIngestionKeyRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.Name == nil {
+		return mockError(http.StatusBadRequest, "name is required")
+	}
+	if _, ok := m.ingestionKeys[*req.Name]; !ok {
+		return mockError(http.StatusNotFound, "ingestion key not found")
+	}
+	delete(m.ingestionKeys, *req.Name)
+	return mockResponse{http.StatusAccepted, map[string]string{}}
+}
+
+func (m *mockAPI) listIngestionKeys(r *http.Request) mockResponse {
+	var req models.ListIngestionKeysRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	keys := []*models.IngestionKeyResult{}
+	for _, key := range sortedValues(m.ingestionKeys) {
+		if (req.Name != "" && key.Name != req.Name) || (req.Type != "" && key.Type != req.Type) || (req.RemoteConfig && !key.RemoteConfig) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return mockResponse{http.StatusOK, keys}
+}
+
+// Secrets
+
+func (m *mockAPI) createSecret(r *http.Request) mockResponse {
+	var req models.CreateSecretRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.Name == nil || req.Type == nil || req.Content == nil {
+		return mockError(http.StatusBadRequest, "name, type, and content are required")
+	}
+
+	secret := &mockSecret{content: *req.Content}
+	secret.ID = m.newID()
+	m.secrets[secret.ID] = secret
+	m.setSecret(secret, *req.Name, *req.Type, *req.Content, req.ManagedByProvider)
+	return mockResponse{http.StatusCreated, &models.SecretResponse{ID: secret.ID, Name: secret.Name, Type: secret.Type}}
+}
+
+func (m *mockAPI) setSecret(secret *mockSecret, name, secretType, content, managedBy string) {
+	secret.Name = name
+	secret.Type = secretType
+	secret.ManagedByProvider = managedBy
+	secret.content = content
+	secret.ContentHash = mockHash(content)
+}
+
+func (m *mockAPI) updateSecret(r *http.Request) mockResponse {
+	secret, ok := m.secrets[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "secret not found")
+	}
+	var req models.UpdateSecretRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.Name == nil || req.Type == nil || req.Content == nil {
+		return mockError(http.StatusBadRequest, "name, type, and content are required")
+	}
+	m.setSecret(secret, *req.Name, *req.Type, *req.Content, req.ManagedByProvider)
+	return mockResponse{http.StatusOK, &models.SecretResponse{ID: secret.ID, Name: secret.Name, Type: secret.Type}}
+}
+
+func (m *mockAPI) deleteSecret(r *http.Request) mockResponse {
+	id := r.PathValue("id")
+	if _, ok := m.secrets[id]; !ok {
+		return mockError(http.StatusNotFound, "secret not found")
+	}
+	delete(m.secrets, id)
+	return mockResponse{status: http.StatusNoContent}
+}
+
+func (m *mockAPI) getSecretHash(r *http.Request) mockResponse {
+	secret, ok := m.secrets[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "secret not found")
+	}
+	return mockResponse{http.StatusOK, &secret.SecretHashResponse}
+}
+
+// Connected apps
+
+func (m *mockAPI) createConnectedApp(r *http.Request) mockResponse {
+	var req models.CreateConnectedAppRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.Name == nil || req.Type == nil {
+		return mockError(http.StatusBadRequest, "name and type are required")
+	}
+	for _, app := range m.connectedApps {
+		if app.Name == *req.Name {
+			return mockError(http.StatusConflict, "connected app name %q is already in use", *req.Name)
+		}
+	}
+
+	now := mockNow()
+	app := &models.ConnectedAppResponse{
+		ID:        m.newID(),
+		Name:      *req.Name,
+		Type:      *req.Type,
+		Data:      req.Data,
+		DataHash:  mockJSONHash(req.Data),
+		CreatedBy: "terraform",
+		CreatedAt: now,
+		UpdatedBy: "terraform",
+		UpdatedAt: now,
+	}
+	m.connectedApps[app.ID] = app
+	return mockResponse{http.StatusCreated, &models.CreateConnectedAppResponse{ID: app.ID, Type: app.Type, DataHash: app.DataHash}}
+}
+
+func mockJSONHash(v any) string {
+	data, _ := json.Marshal(v)
+	return mockHash(string(data))
+}
+
+func (m *mockAPI) getConnectedApp(r *http.Request) mockResponse {
+	app, ok := m.connectedApps[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "connected app not found")
+	}
+	return mockResponse{http.StatusOK, app}
+}
+
+func (m *mockAPI) updateConnectedApp(r *http.Request) mockResponse {
+	app, ok := m.connectedApps[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "connected app not found")
+	}
+	var req models.UpdateConnectedAppRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.Name == nil || req.Type == nil {
+		return mockError(http.StatusBadRequest, "name and type are required")
+	}
+
+	updated := *app
+	updated.Name = *req.Name
+	updated.Type = *req.Type
+	updated.Data = req.Data
+	updated.DataHash = mockJSONHash(req.Data)
+	updated.UpdatedAt = mockNow()
+	m.connectedApps[updated.ID] = &updated
+	return mockResponse{http.StatusOK, &updated}
+}
+
+func (m *mockAPI) deleteConnectedApp(r *http.Request) mockResponse {
+	id := r.PathValue("id")
+	if _, ok := m.connectedApps[id]; !ok {
+		return mockError(http.StatusNotFound, "connected app not found")
+	}
+	if len(m.connectedAppRoutes(id)) > 0 {
+		return mockError(http.StatusConflict, "connected app is used by notification routes")
+	}
+	delete(m.connectedApps, id)
+	return mockResponse{status: http.StatusNoContent}
+}
+
+// connectedAppRoutes returns the notification routes that notify the
+// connected app with the given ID.
+func (m *mockAPI) connectedAppRoutes(id string) []*models.UsedByResponse {
+	usedBy := []*models.UsedByResponse{}
+	for _, route := range sortedValues(m.notificationRoutes) {
+		uses := slices.ContainsFunc(route.Routes, func(rule *models.RouteRuleResponse) bool {
+			return slices.ContainsFunc(rule.ConnectedApps, func(app *models.RouteConnectedAppResponse) bool {
+				return app.ID == id
+			})
+		})
+		if uses {
+			usedBy = append(usedBy, &models.UsedByResponse{ID: route.ID, Name: route.Name, Type: "notification_route"})
+		}
+	}
+	return usedBy
+}
+
+func (m *mockAPI) listConnectedApps(_ *http.Request) mockResponse {
+	apps := []*models.ConnectedAppListItemWithRoutesResponse{}
+	for _, app := range sortedValues(m.connectedApps) {
+		apps = append(apps, &models.ConnectedAppListItemWithRoutesResponse{
+			ID:        app.ID,
+			Name:      app.Name,
+			Type:      app.Type,
+			Data:      app.Data,
+			DataHash:  app.DataHash,
+			CreatedBy: app.CreatedBy,
+			CreatedAt: app.CreatedAt,
+			UsedBy:    m.connectedAppRoutes(app.ID),
+		})
+	}
+	return mockResponse{http.StatusOK, &models.ListConnectedAppsResponse{ConnectedApps: apps}}
+}
+
+// Notification routes
+
+// notificationRoute builds the stored form of a notification route request,
+// resolving the names of its connected apps.
+func (m *mockAPI) notificationRoute(route *models.NotificationRouteResponse, name, query *string, rules []*models.RouteRuleRequest, settings *models.NotificationSettingsRequest) *mockResponse {
+	if name == nil || query == nil {
+		resp := mockError(http.StatusBadRequest, "name and query are required")
+		return &resp
+	}
+	route.Name = *name
+	route.Query = *query
+	route.Routes = []*models.RouteRuleResponse{}
+	for _, rule := range rules {
+		apps := []*models.RouteConnectedAppResponse{}
+		for _, appReq := range rule.ConnectedApps {
+			if appReq == nil || appReq.ID == nil {
+				continue
+			}
+			app, ok := m.connectedApps[*appReq.ID]
+			if !ok {
+				resp := mockError(http.StatusNotFound, "connected app %q not found", *appReq.ID)
+				return &resp
+			}
+			apps = append(apps, &models.RouteConnectedAppResponse{ID: app.ID, Name: app.Name, Type: app.Type, Params: appReq.Params})
+		}
+		route.Routes = append(route.Routes, &models.RouteRuleResponse{ConnectedApps: apps, Status: rule.Status})
+	}
+	route.NotificationSettings = nil
+	if settings != nil {
+		route.NotificationSettings = &models.NotificationSettingsResponse{
+			DisableRenotification:  settings.DisableRenotification,
+			RenotificationInterval: settings.RenotificationInterval,
+		}
+	}
+	route.ModifiedAt = mockNow()
+	route.ModifiedBy = "terraform"
+	return nil
+}
+
+func (m *mockAPI) createNotificationRoute(r *http.Request) mockResponse {
+	var req models.CreateNotificationRouteRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	route := &models.NotificationRouteResponse{CreatedAt: mockNow(), CreatedBy: "terraform"}
+	if resp := m.notificationRoute(route, req.Name, req.Query, req.Routes, req.NotificationSettings); resp != nil {
+		return *resp
+	}
+	route.ID = m.newID()
+	m.notificationRoutes[route.ID] = route
+	return mockResponse{http.StatusCreated, &models.CreateNotificationRouteResponse{ID: route.ID}}
+}
+
+func (m *mockAPI) getNotificationRoute(r *http.Request) mockResponse {
+	route, ok := m.notificationRoutes[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "notification route not found")
+	}
+	return mockResponse{http.StatusOK, route}
+}
+
+func (m *mockAPI) updateNotificationRoute(r *http.Request) mockResponse {
+	route, ok := m.notificationRoutes[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "notification route not found")
+	}
+	var req models.UpdateNotificationRouteRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	updated := *route
+	if resp := m.notificationRoute(&updated, req.Name, req.Query, req.Routes, req.NotificationSettings); resp != nil {
+		return *resp
+	}
+	m.notificationRoutes[updated.ID] = &updated
+	return mockResponse{http.StatusOK, &updated}
+}
+
+func (m *mockAPI) deleteNotificationRoute(r *http.Request) mockResponse {
+	id := r.PathValue("id")
+	if _, ok := m.notificationRoutes[id]; !ok {
+		return mockError(http.StatusNotFound, "notification route not found")
+	}
+	delete(m.notificationRoutes, id)
+	return mockResponse{status: http.StatusNoContent}
+}
+
+func (m *mockAPI) listNotificationRoutes(_ *http.Request) mockResponse {
+	routes := []*models.NotificationRouteListItemResponse{}
+	for _, route := range sortedValues(m.notificationRoutes) {
+		routes = append(routes, &models.NotificationRouteListItemResponse{
+			ID:         route.ID,
+			Name:       route.Name,
+			Query:      route.Query,
+			Routes:     route.Routes,
+			CreatedAt:  route.CreatedAt,
+			CreatedBy:  route.CreatedBy,
+			ModifiedAt: route.ModifiedAt,
+			ModifiedBy: route.ModifiedBy,
+		})
+	}
+	return mockResponse{http.StatusOK, &models.NotificationRouteListResponse{NotificationRoutes: routes}}
+}
+
+// Monitors, which are sent and returned as YAML
+
+// addMonitor stores a monitor with the given YAML and returns its ID.
+func (m *mockAPI) addMonitor(monitorYaml string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := m.newID()
+	m.monitors[id] = monitorYaml
+	return id
+}
+
+// setMonitor replaces the YAML of monitor id, as an edit outside Terraform
+// does.
+func (m *mockAPI) setMonitor(id, monitorYaml string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.monitors[id] = monitorYaml
+}
+
+// monitor returns the stored YAML of monitor id.
+func (m *mockAPI) monitor(id string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.monitors[id]
+}
+
+func mockMonitorTitle(monitorYaml string) (string, error) {
+	var monitor struct {
+		Title string `yaml:"title"`
+	}
+	if err := yaml.Unmarshal([]byte(monitorYaml), &monitor); err != nil {
+		return "", err
+	}
+	return monitor.Title, nil
+}
+
+func mockReadMonitor(r *http.Request) (string, *mockResponse) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		resp := mockError(http.StatusBadRequest, "failed to read monitor: %s", err.Error())
+		return "", &resp
+	}
+	title, err := mockMonitorTitle(string(body))
+	if err != nil {
+		resp := mockError(http.StatusBadRequest, "invalid monitor YAML: %s", err.Error())
+		return "", &resp
+	}
+	if strings.TrimSpace(title) == "" {
+		resp := mockError(http.StatusUnprocessableEntity, "monitor title is required")
+		return "", &resp
+	}
+
+	// Like the API, which encodes monitors with omitempty, store the monitor
+	// without the empty and false fields the SDK sends, or the isProvisioned
+	// flag of the request.
+	var doc yaml.Node
+	if err := yaml.Unmarshal(body, &doc); err != nil {
+		resp := mockError(http.StatusBadRequest, "invalid monitor YAML: %s", err.Error())
+		return "", &resp
+	}
+	mockCompactYaml(&doc)
+	compacted, err := yaml.Marshal(&doc)
+	if err != nil {
+		resp := mockError(http.StatusInternalServerError, "failed to store monitor: %s", err.Error())
+		return "", &resp
+	}
+	return string(compacted), nil
+}
+
+// mockCompactYaml removes the mapping keys of node whose values are false,
+// empty strings, or empty sequences or mappings, and isProvisioned.
+func mockCompactYaml(node *yaml.Node) {
+	for _, child := range node.Content {
+		mockCompactYaml(child)
+	}
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+	content := node.Content[:0]
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		value := node.Content[i+1]
+		empty := (value.Kind == yaml.ScalarNode && value.Tag == "!!str" && value.Value == "") ||
+			(value.Kind == yaml.ScalarNode && value.Tag == "!!bool" && value.Value == "false") ||
+			((value.Kind == yaml.SequenceNode || value.Kind == yaml.MappingNode) && len(value.Content) == 0)
+		if !empty && node.Content[i].Value != "isProvisioned" {
+			content = append(content, node.Content[i], value)
+		}
+	}
+	node.Content = content
+}
+
+func (m *mockAPI) createMonitor(r *http.Request) mockResponse {
+	monitorYaml, errResp := mockReadMonitor(r)
+	if errResp != nil {
+		return *errResp
+	}
+	id := m.newID()
+	m.monitors[id] = monitorYaml
+	return mockResponse{http.StatusOK, &models.CreateMonitorResponse{MonitorID: id}}
+}
+
+func (m *mockAPI) getMonitor(r *http.Request) mockResponse {
+	monitorYaml, ok := m.monitors[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "monitor not found")
+	}
+	return mockResponse{http.StatusOK, []byte(monitorYaml)}
+}
+
+func (m *mockAPI) updateMonitor(r *http.Request) mockResponse {
+	id := r.PathValue("id")
+	if _, ok := m.monitors[id]; !ok {
+		return mockError(http.StatusNotFound, "monitor not found")
+	}
+	monitorYaml, errResp := mockReadMonitor(r)
+	if errResp != nil {
+		return *errResp
+	}
+	m.monitors[id] = monitorYaml
+	return mockResponse{http.StatusAccepted, map[string]string{}}
+}
+
+func (m *mockAPI) deleteMonitor(r *http.Request) mockResponse {
+	id := r.PathValue("id")
+	if _, ok := m.monitors[id]; !ok {
+		return mockError(http.StatusNotFound, "monitor not found")
+	}
+	delete(m.monitors, id)
+	return mockResponse{http.StatusOK, map[string]string{}}
+}
+
+// listMonitors returns the page of monitors the request's skip and limit
+// select, in ID order, and sets done on the last page.
+func (m *mockAPI) listMonitors(r *http.Request) mockResponse {
+	var req models.MonitorListRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	ids := slices.Sorted(maps.Keys(m.monitors))
+	start := min(int(req.Skip), len(ids))
+	end := len(ids)
+	if req.Limit > 0 {
+		end = min(start+int(req.Limit), len(ids))
+	}
+
+	items := []*models.MonitorListItem{}
+	for _, id := range ids[start:end] {
+		title, _ := mockMonitorTitle(m.monitors[id])
+		items = append(items, &models.MonitorListItem{UUID: strfmt.UUID(id), Title: title})
+	}
+	return mockResponse{http.StatusOK, &models.MonitorListResponse{Monitors: items, Done: end == len(ids)}}
+}
+
+// Silences
+
+func (m *mockAPI) createSilence(r *http.Request) mockResponse {
+	var req models.CreateSilenceRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.StartsAt == nil || req.EndsAt == nil {
+		return mockError(http.StatusBadRequest, "startsAt and endsAt are required")
+	}
+	silence := &models.Silence{
+		UUID:      strfmt.UUID(m.newID()),
+		StartsAt:  *req.StartsAt,
+		EndsAt:    *req.EndsAt,
+		Comment:   req.Comment,
+		Matchers:  req.Matchers,
+		CreatedBy: "terraform",
+	}
+	m.silences[silence.UUID.String()] = silence
+	return mockResponse{http.StatusOK, silence}
+}
+
+func (m *mockAPI) getSilence(r *http.Request) mockResponse {
+	silence, ok := m.silences[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "silence not found")
+	}
+	return mockResponse{http.StatusOK, silence}
+}
+
+func (m *mockAPI) updateSilence(r *http.Request) mockResponse {
+	silence, ok := m.silences[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "silence not found")
+	}
+	var req models.UpdateSilenceRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	updated := *silence
+	updated.StartsAt, updated.EndsAt, updated.Comment, updated.Matchers = req.StartsAt, req.EndsAt, req.Comment, req.Matchers
+	m.silences[updated.UUID.String()] = &updated
+	return mockResponse{http.StatusOK, &updated}
+}
+
+// deleteSilence responds 404 to a silence that does not exist, where the API
+// responds 500, which the client retries for deletes.
+func (m *mockAPI) deleteSilence(r *http.Request) mockResponse {
+	id := r.PathValue("id")
+	if _, ok := m.silences[id]; !ok {
+		return mockError(http.StatusNotFound, "silence not found")
+	}
+	delete(m.silences, id)
+	return mockResponse{http.StatusOK, map[string]string{}}
+}
+
+// Dashboards, which the mock only lists and reads
+
+// addDashboard stores dashboard under a new UUID and returns the UUID.
+func (m *mockAPI) addDashboard(dashboard models.View) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	dashboard.UUID = m.newID()
+	m.dashboards[dashboard.UUID] = &dashboard
+	return dashboard.UUID
+}
+
+func (m *mockAPI) listDashboards(_ *http.Request) mockResponse {
+	return mockResponse{http.StatusOK, sortedValues(m.dashboards)}
+}
+
+func (m *mockAPI) getDashboard(r *http.Request) mockResponse {
+	dashboard, ok := m.dashboards[r.PathValue("id")]
+	if !ok {
+		return mockError(http.StatusNotFound, "dashboard not found")
+	}
+	return mockResponse{http.StatusOK, dashboard}
+}
+
+// Data integrations, which the API addresses by type and ID
+
+func (m *mockAPI) createDataIntegration(r *http.Request) mockResponse {
+	var req models.CreateDataIntegrationConfigRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	if req.Config == "" {
+		return mockError(http.StatusBadRequest, "config is required")
+	}
+	integration := &models.DataIntegrationConfig{ID: m.newID(), Type: r.PathValue("type")}
+	mockSetDataIntegration(integration, &req)
+	m.dataIntegrations[integration.ID] = integration
+	return mockResponse{http.StatusCreated, integration}
+}
+
+// dataIntegration returns the integration of the type and ID in the path of r.
+func (m *mockAPI) dataIntegration(r *http.Request) (*models.DataIntegrationConfig, bool) {
+	integration, ok := m.dataIntegrations[r.PathValue("id")]
+	return integration, ok && integration.Type == r.PathValue("type")
+}
+
+func (m *mockAPI) getDataIntegration(r *http.Request) mockResponse {
+	integration, ok := m.dataIntegration(r)
+	if !ok {
+		return mockError(http.StatusNotFound, "data integration not found")
+	}
+	return mockResponse{http.StatusOK, integration}
+}
+
+func (m *mockAPI) updateDataIntegration(r *http.Request) mockResponse {
+	integration, ok := m.dataIntegration(r)
+	if !ok {
+		return mockError(http.StatusNotFound, "data integration not found")
+	}
+	var req models.CreateDataIntegrationConfigRequest
+	if resp := mockDecode(r, &req); resp != nil {
+		return *resp
+	}
+	updated := *integration
+	mockSetDataIntegration(&updated, &req)
+	m.dataIntegrations[updated.ID] = &updated
+	return mockResponse{http.StatusOK, &updated}
+}
+
+func (m *mockAPI) deleteDataIntegration(r *http.Request) mockResponse {
+	integration, ok := m.dataIntegration(r)
+	if !ok {
+		return mockError(http.StatusNotFound, "data integration not found")
+	}
+	delete(m.dataIntegrations, integration.ID)
+	return mockResponse{http.StatusOK, map[string]string{}}
+}
+
+func mockSetDataIntegration(integration *models.DataIntegrationConfig, req *models.CreateDataIntegrationConfigRequest) {
+	integration.Cluster = req.Cluster
+	integration.Config = req.Config
+	integration.IsPaused = req.IsPaused
+	integration.Tags = req.Tags
+	integration.UpdateTimestamp = mockNow()
+	integration.UpdatedBy = "terraform"
+}
+
+// Workflows, which the mock only lists
+
+// addWorkflow stores workflow, which must have an ID.
+func (m *mockAPI) addWorkflow(workflow *models.Workflow) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.workflows[workflow.ID] = workflow
+}
+
+func (m *mockAPI) listWorkflows(_ *http.Request) mockResponse {
+	return mockResponse{http.StatusOK, &models.WorkflowsResponse{Workflows: sortedValues(m.workflows)}}
+}
+
+// Clusters, which the API lists while their sensors report
+
+// setClusters replaces the clusters the mock reports.
+func (m *mockAPI) setClusters(clusters ...*models.ClustersListResult) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.clusters = map[string]*models.ClustersListResult{}
+	for _, cluster := range clusters {
+		m.clusters[cluster.Name] = cluster
+	}
+}
+
+func (m *mockAPI) listClusters(_ *http.Request) mockResponse {
+	clusters := sortedValues(m.clusters)
+	return mockResponse{http.StatusOK, &models.ClustersListResponse{Clusters: clusters, TotalCount: int64(len(clusters))}}
+}
+
+func newMockAPIClient(t *testing.T, opts ...sdkClientOption) (*mockAPI, ApiClient) {
+	t.Helper()
+	m := newMockAPI(t)
+	client, err := NewSdkClientWrapper(context.Background(), m.URL(), mockAPIKey, mockAPIBackendID, append(opts, withTransport(m.transport()))...)
+	require.NoError(t, err)
+	return m, client
+}
+
+func TestMockAPICrud(t *testing.T) {
+	ctx := context.Background()
+	_, client := newMockAPIClient(t)
+
+	name := "on-call"
+	created, err := client.CreatePolicy(ctx, &models.CreatePolicyRequest{Name: &name, Role: models.RoleMap{"admin": "admin"}})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.UUID)
+
+	name = "on-call-renamed"
+	updated, err := client.UpdatePolicy(ctx, created.UUID, &models.UpdatePolicyRequest{Name: &name, CurrentRevision: created.RevisionNumber})
+	require.NoError(t, err)
+	assert.Equal(t, created.RevisionNumber+1, updated.RevisionNumber)
+
+	read, err := client.GetPolicy(ctx, created.UUID)
+	require.NoError(t, err)
+	assert.Equal(t, "on-call-renamed", *read.Name)
+
+	require.NoError(t, client.DeletePolicy(ctx, created.UUID))
+	_, err = client.GetPolicy(ctx, created.UUID)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestMockAPIListMonitorsFollowsPagination(t *testing.T) {
+	m, client := newMockAPIClient(t)
+	for i := range 2500 {
+		m.addMonitor(fmt.Sprintf("title: monitor-%d\n", i))
+	}
+
+	monitors, err := client.ListMonitors(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, monitors, 2500)
+	assert.Equal(t, 3, m.requestCount(http.MethodPost, "/api/monitors/list"))
+}
+
+func TestMockAPIRetriesRateLimitedRequests(t *testing.T) {
+	if testing.Short() {
+		t.Skip("waits for the retry backoff")
+	}
+	m, client := newMockAPIClient(t)
+	m.fail(http.MethodPost, "/api/rbac/policy/create", http.StatusTooManyRequests, 1)
+
+	name := "rate-limited"
+	policy, err := client.CreatePolicy(context.Background(), &models.CreatePolicyRequest{Name: &name})
+	require.NoError(t, err)
+	assert.NotEmpty(t, policy.UUID)
+	assert.Equal(t, 2, m.requestCount(http.MethodPost, "/api/rbac/policy/create"))
+}
+
+func TestMockAPIErrorMapping(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+
+	_, err := client.GetPolicy(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	m.fail(http.MethodGet, "/api/rbac/policies/list", http.StatusUnauthorized, 1)
+	_, err = client.ListPolicies(ctx)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	m.fail(http.MethodGet, "/api/rbac/policies/list", http.StatusForbidden, 1)
+	_, err = client.ListPolicies(ctx)
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+
+	name := "taken"
+	_, err = client.CreatePolicy(ctx, &models.CreatePolicyRequest{Name: &name})
+	require.NoError(t, err)
+	_, err = client.CreatePolicy(ctx, &models.CreatePolicyRequest{Name: &name})
+	assert.ErrorContains(t, err, "policy name 'taken' was previously used")
+
+	appName, appType := "pager", "pagerduty"
+	app, err := client.CreateConnectedApp(ctx, &models.CreateConnectedAppRequest{Name: &appName, Type: &appType, Data: map[string]any{}})
+	require.NoError(t, err)
+	routeName, query := "route", "*"
+	_, err = client.CreateNotificationRoute(ctx, &models.CreateNotificationRouteRequest{
+		Name:   &routeName,
+		Query:  &query,
+		Routes: []*models.RouteRuleRequest{{ConnectedApps: []*models.RouteConnectedAppRequest{{ID: &app.ID, Type: &appType}}}},
+	})
+	require.NoError(t, err)
+	err = client.DeleteConnectedApp(ctx, app.ID)
+	assert.ErrorContains(t, err, "referenced by one or more notification routes")
+
+	// Deleting a monitor that does not exist is not an error.
+	assert.NoError(t, client.DeleteMonitor(ctx, "00000000-0000-4000-8000-999999999999"))
+}
+
+func TestMockAPIRejectsWrongCredentials(t *testing.T) {
+	m := newMockAPI(t)
+	client, err := NewSdkClientWrapper(context.Background(), m.URL(), "wrong-key", mockAPIBackendID)
+	require.NoError(t, err)
+
+	_, err = client.ListPolicies(context.Background())
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
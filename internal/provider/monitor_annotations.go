@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// monitorAnnotations returns the entries of groundcover_monitor's annotations
+// attribute. A null map yields no entries.
+func monitorAnnotations(ctx context.Context, annotations types.Map) (map[string]string, diag.Diagnostics) {
+	values := map[string]string{}
+	if annotations.IsNull() || annotations.IsUnknown() {
+		return values, nil
+	}
+	diags := annotations.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+// mergeMonitorAnnotations adds annotations to the annotations of a monitor
+// request built from YAML.
+func mergeMonitorAnnotations(target map[string]string, annotations map[string]string) map[string]string {
+	if len(annotations) == 0 {
+		return target
+	}
+	if target == nil {
+		target = make(map[string]string, len(annotations))
+	}
+	for key, value := range annotations {
+		target[key] = value
+	}
+	return target
+}
+
+// refreshMonitorAnnotations returns the annotations attribute after a read:
+// each managed key takes its value from the remote YAML, and keys the remote
+// monitor no longer has are dropped. Annotations the attribute does not manage
+// are left to monitor_yaml.
+func refreshMonitorAnnotations(ctx context.Context, current types.Map, remoteYaml string) (types.Map, diag.Diagnostics) {
+	if current.IsNull() || current.IsUnknown() {
+		return current, nil
+	}
+
+	var diags diag.Diagnostics
+	remote, err := monitorYamlStringMap(remoteYaml, "annotations")
+	if err != nil {
+		return current, diags
+	}
+
+	refreshed := make(map[string]attr.Value, len(current.Elements()))
+	for key := range current.Elements() {
+		if value, ok := remote[key]; ok {
+			refreshed[key] = types.StringValue(value)
+		}
+	}
+	result, mapDiags := types.MapValue(types.StringType, refreshed)
+	diags.Append(mapDiags...)
+	return result, diags
+}
+
+// checkMonitorAnnotationConflicts rejects a planned groundcover_monitor whose
+// annotations attribute sets a key that monitor_yaml already sets. The
+// attribute would override the YAML value and the monitor would drift on every
+// refresh.
+func checkMonitorAnnotationConflicts(ctx context.Context, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plannedYaml monitorYamlValue
+	var annotations types.Map
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("monitor_yaml"), &plannedYaml)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("annotations"), &annotations)...)
+	if diags.HasError() || plannedYaml.IsNull() || plannedYaml.IsUnknown() || annotations.IsNull() || annotations.IsUnknown() {
+		return
+	}
+
+	yamlAnnotations, err := monitorYamlStringMap(plannedYaml.ValueString(), "annotations")
+	if err != nil {
+		return
+	}
+	var conflicts []string
+	for key := range annotations.Elements() {
+		if _, ok := yamlAnnotations[key]; ok {
+			conflicts = append(conflicts, key)
+		}
+	}
+	if len(conflicts) == 0 {
+		return
+	}
+	sort.Strings(conflicts)
+	diags.AddAttributeError(
+		path.Root("annotations"),
+		"Monitor Annotation Set Twice",
+		fmt.Sprintf("The annotations %s are set both in monitor_yaml and in the annotations attribute. Set each annotation in only one place.", formatLabelKeys(conflicts)),
+	)
+}
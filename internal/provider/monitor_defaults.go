@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
+)
+
+// The monitor YAML keys the provider's monitor_defaults fill in, as
+// dot-separated paths.
+const (
+	monitorDefaultEvaluationInterval  = "evaluationInterval.interval"
+	monitorDefaultPendingFor          = "evaluationInterval.pendingFor"
+	monitorDefaultNoDataState         = "noDataState"
+	monitorDefaultExecutionErrorState = "executionErrorState"
+)
+
+// providerMonitorDefaultsModel is the provider's monitor_defaults block.
+type providerMonitorDefaultsModel struct {
+	EvaluationInterval  types.String `tfsdk:"evaluation_interval"`
+	PendingFor          types.String `tfsdk:"pending_for"`
+	NoDataState         types.String `tfsdk:"no_data_state"`
+	ExecutionErrorState types.String `tfsdk:"execution_error_state"`
+}
+
+func providerMonitorDefaultsBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Values added to the YAML of every `groundcover_monitor` that does not set them itself, so behavior such as how often monitors are evaluated can be standardized without editing each monitor. " +
+			"A key set in `monitor_yaml` takes precedence. The defaults a monitor receives are exposed as its computed `defaults_applied`, so changing a default is planned as an update of each monitor it applies to.",
+		Attributes: map[string]schema.Attribute{
+			"evaluation_interval": schema.StringAttribute{
+				MarkdownDescription: "How often monitors are evaluated, such as `\"1m\"`, sent as `evaluationInterval.interval`.",
+				Optional:            true,
+				Validators:          []validator.String{validators.DurationString()},
+			},
+			"pending_for": schema.StringAttribute{
+				MarkdownDescription: "How long a monitor's condition must hold before it fires, such as `\"5m\"`, sent as `evaluationInterval.pendingFor`.",
+				Optional:            true,
+				Validators:          []validator.String{validators.DurationString()},
+			},
+			"no_data_state": schema.StringAttribute{
+				MarkdownDescription: "The state of a monitor whose query returns no data, sent as `noDataState`: one of `OK`, `NoData`, or `Alerting`.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("OK", "NoData", "Alerting")},
+			},
+			"execution_error_state": schema.StringAttribute{
+				MarkdownDescription: "The state of a monitor whose query fails, sent as `executionErrorState`: one of `OK`, `Error`, or `Alerting`.",
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("OK", "Error", "Alerting")},
+			},
+		},
+	}
+}
+
+// monitorDefaultsFromModel returns the configured monitor_defaults keyed by
+// YAML path. A nil model is an omitted monitor_defaults block.
+func monitorDefaultsFromModel(model *providerMonitorDefaultsModel) map[string]string {
+	if model == nil {
+		return nil
+	}
+	defaults := map[string]string{}
+	for key, value := range map[string]types.String{
+		monitorDefaultEvaluationInterval:  model.EvaluationInterval,
+		monitorDefaultPendingFor:          model.PendingFor,
+		monitorDefaultNoDataState:         model.NoDataState,
+		monitorDefaultExecutionErrorState: model.ExecutionErrorState,
+	} {
+		if !value.IsNull() && !value.IsUnknown() {
+			defaults[key] = value.ValueString()
+		}
+	}
+	if len(defaults) == 0 {
+		return nil
+	}
+	return defaults
+}
+
+func monitorDefaultsAppliedAttribute() resourceschema.MapAttribute {
+	return resourceschema.MapAttribute{
+		MarkdownDescription: "The provider's `monitor_defaults` this monitor is sent with, keyed by YAML path, such as `{ \"evaluationInterval.interval\" = \"1m\" }`: the defaults whose keys `monitor_yaml` does not set. " +
+			"Refresh reads these keys back from the monitor, so a default changed outside Terraform is restored by the next apply. Null when no default applies.",
+		ElementType: types.StringType,
+		Computed:    true,
+	}
+}
+
+// monitorYamlValueAt returns the value at the dot-separated path in doc, and
+// whether the path is set.
+func monitorYamlValueAt(doc map[string]any, key string) (any, bool) {
+	var value any = doc
+	for _, name := range strings.Split(key, ".") {
+		mapping, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = mapping[name]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// monitorDefaultsApplied returns the defaults whose keys monitorYaml does not
+// set. ok is false when the YAML does not parse.
+func monitorDefaultsApplied(monitorYaml string, defaults map[string]string) (applied map[string]string, ok bool) {
+	var doc map[string]any
+	if err := yaml.Unmarshal([]byte(monitorYaml), &doc); err != nil {
+		return nil, false
+	}
+	for key, value := range defaults {
+		if _, set := monitorYamlValueAt(doc, key); set {
+			continue
+		}
+		if applied == nil {
+			applied = map[string]string{}
+		}
+		applied[key] = value
+	}
+	return applied, true
+}
+
+// setMonitorYamlValues returns monitorYaml with the value at each
+// dot-separated path in values set, adding the mappings on the way that are
+// missing. YAML that values leaves unchanged is returned as is.
+func setMonitorYamlValues(monitorYaml string, values map[string]string) (string, error) {
+	if len(values) == 0 {
+		return monitorYaml, nil
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(monitorYaml), &doc); err != nil {
+		return "", fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		node := doc.Content[0]
+		for _, name := range strings.Split(key, ".") {
+			for node.Kind == yaml.AliasNode {
+				node = node.Alias
+			}
+			if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+				// An empty `evaluationInterval:` is filled in.
+				*node = yaml.Node{Kind: yaml.MappingNode}
+			}
+			if node.Kind != yaml.MappingNode {
+				return "", fmt.Errorf("cannot set %s: its parent is not a mapping", key)
+			}
+			child := yamlMappingValue(node, name)
+			if child == nil {
+				child = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: name}, child)
+			}
+			node = child
+		}
+		*node = yaml.Node{Kind: yaml.ScalarNode, Value: values[key]}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return "", fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// withMonitorDefaults returns apiMonitorYaml with the defaults in
+// defaultsApplied set. They are set even where apiMonitorYaml has a value:
+// defaults_applied only holds keys the configured YAML leaves unset, and the
+// planned YAML may be the remote one, holding a previous default.
+func withMonitorDefaults(ctx context.Context, apiMonitorYaml string, defaultsApplied types.Map) (string, error) {
+	if defaultsApplied.IsNull() || defaultsApplied.IsUnknown() {
+		return apiMonitorYaml, nil
+	}
+	var values map[string]string
+	if diags := defaultsApplied.ElementsAs(ctx, &values, false); diags.HasError() {
+		return "", fmt.Errorf("unable to read defaults_applied")
+	}
+	withDefaults, err := setMonitorYamlValues(apiMonitorYaml, values)
+	if err != nil {
+		return "", fmt.Errorf("unable to apply the provider's monitor_defaults: %w", err)
+	}
+	return withDefaults, nil
+}
+
+// planDefaultsApplied sets defaults_applied from the configured YAML and the
+// provider's monitor_defaults. The configured YAML is used because the planned
+// one may be the remote YAML, which already holds the defaults. It stays
+// unknown while the YAML is unknown or does not parse.
+func (r *monitorResource) planDefaultsApplied(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configuredYaml monitorYamlValue
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("monitor_yaml"), &configuredYaml)...)
+	if resp.Diagnostics.HasError() || configuredYaml.IsNull() || configuredYaml.IsUnknown() {
+		return
+	}
+	applied, ok := monitorDefaultsApplied(configuredYaml.ValueString(), r.monitorDefaults)
+	if !ok {
+		return
+	}
+	defaultsApplied, diags := stringMapValue(ctx, applied)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("defaults_applied"), defaultsApplied)...)
+}
+
+// resolvedDefaultsApplied returns planned, or when it is unknown the
+// defaults_applied of the applied YAML.
+func (r *monitorResource) resolvedDefaultsApplied(ctx context.Context, planned types.Map, monitorYaml string, diags *diag.Diagnostics) types.Map {
+	if !planned.IsUnknown() {
+		return planned
+	}
+	applied, ok := monitorDefaultsApplied(monitorYaml, r.monitorDefaults)
+	if !ok {
+		return types.MapNull(types.StringType)
+	}
+	defaultsApplied, mapDiags := stringMapValue(ctx, applied)
+	diags.Append(mapDiags...)
+	return defaultsApplied
+}
+
+// refreshMonitorDefaultsApplied returns defaults_applied after a read: the
+// remote values of the keys it tracks. A key the remote monitor no longer
+// sets is dropped, so the next apply sends the default again.
+func refreshMonitorDefaultsApplied(ctx context.Context, current types.Map, remoteYaml string) (types.Map, diag.Diagnostics) {
+	if current.IsNull() || current.IsUnknown() {
+		return current, nil
+	}
+	var doc map[string]any
+	if err := yaml.Unmarshal([]byte(remoteYaml), &doc); err != nil {
+		return current, nil
+	}
+	refreshed := make(map[string]string, len(current.Elements()))
+	for key, currentValue := range current.Elements() {
+		value, ok := monitorYamlValueAt(doc, key)
+		if !ok || value == nil {
+			continue
+		}
+		refreshed[key] = fmt.Sprint(value)
+		// The API may write a duration in another form, such as "1m0s".
+		if configured, ok := currentValue.(types.String); ok && sameDuration(configured.ValueString(), refreshed[key]) {
+			refreshed[key] = configured.ValueString()
+		}
+	}
+	return stringMapValue(ctx, refreshed)
+}
+
+// sameDuration reports whether a and b are both durations of the same length.
+func sameDuration(a, b string) bool {
+	da, errA := strfmt.ParseDuration(a)
+	db, errB := strfmt.ParseDuration(b)
+	return errA == nil && errB == nil && da == db
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMonitorDefaultsFromModel(t *testing.T) {
+	assert.Nil(t, monitorDefaultsFromModel(nil))
+	assert.Nil(t, monitorDefaultsFromModel(&providerMonitorDefaultsModel{}))
+	assert.Equal(t, map[string]string{
+		monitorDefaultEvaluationInterval: "1m",
+		monitorDefaultNoDataState:        "OK",
+	}, monitorDefaultsFromModel(&providerMonitorDefaultsModel{
+		EvaluationInterval: types.StringValue("1m"),
+		NoDataState:        types.StringValue("OK"),
+	}))
+}
+
+func TestMonitorDefaultsApplied(t *testing.T) {
+	defaults := map[string]string{
+		monitorDefaultEvaluationInterval:  "1m",
+		monitorDefaultPendingFor:          "5m",
+		monitorDefaultNoDataState:         "OK",
+		monitorDefaultExecutionErrorState: "Error",
+	}
+
+	applied, ok := monitorDefaultsApplied("title: errors\nnoDataState: Alerting\nevaluationInterval:\n  interval: 30s\n", defaults)
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{
+		monitorDefaultPendingFor:          "5m",
+		monitorDefaultExecutionErrorState: "Error",
+	}, applied)
+
+	applied, ok = monitorDefaultsApplied("title: errors\nnoDataState: OK\nexecutionErrorState: OK\nevaluationInterval:\n  interval: 1m\n  pendingFor: 0s\n", defaults)
+	require.True(t, ok)
+	assert.Nil(t, applied)
+
+	_, ok = monitorDefaultsApplied("title: [", defaults)
+	assert.False(t, ok)
+}
+
+func TestSetMonitorYamlValues(t *testing.T) {
+	values := map[string]string{
+		monitorDefaultEvaluationInterval: "1m",
+		monitorDefaultNoDataState:        "OK",
+	}
+
+	got, err := setMonitorYamlValues("title: errors\n", values)
+	require.NoError(t, err)
+	assert.Equal(t, "title: errors\nevaluationInterval:\n  interval: 1m\nnoDataState: OK\n", got)
+
+	// An existing value, such as a previous default, is replaced.
+	got, err = setMonitorYamlValues("title: errors\nevaluationInterval:\n  pendingFor: 5m\n  interval: 5m\nnoDataState:\n", values)
+	require.NoError(t, err)
+	assert.Equal(t, "title: errors\nevaluationInterval:\n  pendingFor: 5m\n  interval: 1m\nnoDataState: OK\n", got)
+
+	_, err = setMonitorYamlValues("title: errors\nevaluationInterval: 1m\n", values)
+	assert.ErrorContains(t, err, "its parent is not a mapping")
+
+	got, err = setMonitorYamlValues("title: errors\n", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "title: errors\n", got)
+}
+
+func TestRefreshMonitorDefaultsApplied(t *testing.T) {
+	ctx := context.Background()
+	current := types.MapValueMust(types.StringType, map[string]attr.Value{
+		monitorDefaultEvaluationInterval: types.StringValue("1m"),
+		monitorDefaultNoDataState:        types.StringValue("OK"),
+	})
+
+	// A duration written in another form is kept as configured; a value
+	// changed outside Terraform is read back, so the plan restores it.
+	got, diags := refreshMonitorDefaultsApplied(ctx, current, "title: errors\nevaluationInterval:\n  interval: 1m0s\nnoDataState: Alerting\n")
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		monitorDefaultEvaluationInterval: types.StringValue("1m"),
+		monitorDefaultNoDataState:        types.StringValue("Alerting"),
+	}), got)
+
+	// A default removed outside Terraform is dropped.
+	got, diags = refreshMonitorDefaultsApplied(ctx, current, "title: errors\nnoDataState: OK\n")
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		monitorDefaultNoDataState: types.StringValue("OK"),
+	}), got)
+
+	got, diags = refreshMonitorDefaultsApplied(ctx, types.MapNull(types.StringType), "title: errors\nnoDataState: OK\n")
+	require.False(t, diags.HasError())
+	assert.True(t, got.IsNull())
+}
+
+func TestMonitorCreateAddsMonitorDefaults(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	r := &monitorResource{client: client, monitorDefaults: map[string]string{
+		monitorDefaultEvaluationInterval:  "1m",
+		monitorDefaultExecutionErrorState: "Error",
+	}}
+
+	created, diags := testMonitorCreate(ctx, r, testMonitorModel("title: Checkout errors\nexecutionErrorState: Alerting\n"))
+	require.False(t, diags.HasError(), "%v", diags)
+
+	var state monitorResourceModel
+	require.False(t, created.Get(ctx, &state).HasError())
+	var stored struct {
+		EvaluationInterval struct {
+			Interval string `yaml:"interval"`
+		} `yaml:"evaluationInterval"`
+		ExecutionErrorState string `yaml:"executionErrorState"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(m.monitor(state.Id.ValueString())), &stored))
+	assert.Equal(t, "1m0s", stored.EvaluationInterval.Interval)
+	assert.Equal(t, "Alerting", stored.ExecutionErrorState)
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		monitorDefaultEvaluationInterval: types.StringValue("1m"),
+	}), state.DefaultsApplied)
+	assert.Equal(t, "title: Checkout errors\nexecutionErrorState: Alerting\n", state.MonitorYaml.ValueString())
+}
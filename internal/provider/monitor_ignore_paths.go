@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var monitorIgnoreYamlPathPattern = regexp.MustCompile(`^[^.\s]+(\.[^.\s]+)*$`)
+
+func monitorIgnoreYamlPathsAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: "Paths in `monitor_yaml` whose values are managed outside Terraform, such as `display.description` or `labels.owner` set by an enrichment bot. " +
+			"A path is a dot-separated list of mapping keys; list items cannot be addressed. " +
+			"Changes at these paths, remote or in configuration, are not reported as drift and do not cause an update, and an update for other changes keeps the monitor's current values at these paths.",
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(stringvalidator.RegexMatches(monitorIgnoreYamlPathPattern, "must be dot-separated YAML mapping keys, such as `labels.owner`")),
+		},
+	}
+}
+
+// monitorIgnoreYamlPaths returns the entries of groundcover_monitor's
+// ignore_yaml_paths attribute. A null or unknown list yields no paths.
+func monitorIgnoreYamlPaths(ctx context.Context, paths types.List) ([]string, diag.Diagnostics) {
+	if paths.IsNull() || paths.IsUnknown() {
+		return nil, nil
+	}
+	var values []string
+	diags := paths.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+// overlayIgnoredYamlPaths returns targetYaml with the values at paths taken from
+// sourceYaml. YAML that does not parse is returned unchanged, leaving the
+// error to the comparison or request that uses it.
+func overlayIgnoredYamlPaths(ctx context.Context, targetYaml, sourceYaml string, paths []string) string {
+	if len(paths) == 0 {
+		return targetYaml
+	}
+	overlaid, err := OverlayYamlPaths(targetYaml, sourceYaml, paths)
+	if err != nil {
+		tflog.Debug(ctx, "Not applying ignore_yaml_paths to unparseable monitor YAML", map[string]any{"error": err.Error()})
+		return targetYaml
+	}
+	return overlaid
+}
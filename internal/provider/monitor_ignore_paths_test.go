@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const ignorePathsStateYaml = `title: Checkout errors
+display:
+  header: errors
+  description: checkout
+labels:
+  team: payments
+`
+
+const ignorePathsRemoteYaml = `title: Checkout errors
+display:
+  header: errors
+  description: enriched by bot
+labels:
+  team: payments
+  owner: bot
+`
+
+// createIgnorePathsMonitor creates a monitor that ignores the description and
+// owner label through the mock API, then enriches those paths remotely.
+func createIgnorePathsMonitor(t *testing.T) (*mockAPI, *monitorResource, tfsdk.State, string) {
+	t.Helper()
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	r := &monitorResource{client: client}
+	model := testMonitorModel(ignorePathsStateYaml)
+	model.IgnoreYamlPaths = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("display.description"), types.StringValue("labels.owner")})
+	state, diags := testMonitorCreate(ctx, r, model)
+	require.False(t, diags.HasError(), "%v", diags)
+	var created monitorResourceModel
+	require.False(t, state.Get(ctx, &created).HasError())
+	id := created.Id.ValueString()
+	m.setMonitor(id, ignorePathsRemoteYaml)
+	return m, r, state, id
+}
+
+func TestMonitorReadIgnoresYamlPaths(t *testing.T) {
+	ctx := context.Background()
+	_, r, state, _ := createIgnorePathsMonitor(t)
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var refreshed monitorResourceModel
+	require.False(t, resp.State.Get(ctx, &refreshed).HasError())
+	same, err := CompareYamlSemantically(refreshed.MonitorYaml.ValueString(), ignorePathsStateYaml)
+	require.NoError(t, err)
+	assert.True(t, same, "changes at ignored paths must not be drift, got\n%s", refreshed.MonitorYaml.ValueString())
+}
+
+func TestMonitorUpdateKeepsIgnoredYamlPaths(t *testing.T) {
+	ctx := context.Background()
+	m, r, prior, id := createIgnorePathsMonitor(t)
+
+	var planned monitorResourceModel
+	require.False(t, prior.Get(ctx, &planned).HasError())
+	planned.MonitorYaml = newMonitorYamlValue("title: Checkout failures\ndisplay:\n  header: errors\n  description: checkout\nlabels:\n  team: payments\n")
+	plan, diags := testMonitorPlan(ctx, r, planned)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	resp := resource.UpdateResponse{State: prior}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: prior}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var updated struct {
+		Title   string `yaml:"title"`
+		Display struct {
+			Description string `yaml:"description"`
+		} `yaml:"display"`
+		Labels map[string]string `yaml:"labels"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(m.monitor(id)), &updated))
+	assert.Equal(t, "Checkout failures", updated.Title)
+	assert.Equal(t, "enriched by bot", updated.Display.Description)
+	assert.Equal(t, "bot", updated.Labels["owner"])
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// notificationRouteCache lists notification routes once per provider process,
+// so resolving the routes of many monitors during one plan costs one API call.
+// Failed lists are not cached.
+type notificationRouteCache struct {
+	mu     sync.Mutex
+	routes []*models.NotificationRouteListItemResponse
+	loaded bool
+}
+
+func (c *notificationRouteCache) list(ctx context.Context, client ApiClient) ([]*models.NotificationRouteListItemResponse, error) {
+	if c == nil {
+		return client.ListNotificationRoutes(ctx)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loaded {
+		return c.routes, nil
+	}
+	routes, err := client.ListNotificationRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.routes, c.loaded = routes, true
+	return routes, nil
+}
+
+var monitorNotificationRouteAttrTypes = map[string]attr.Type{
+	"id":   types.StringType,
+	"name": types.StringType,
+}
+
+func monitorNotificationRoutesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "The notification routes whose `query` can match this monitor's alerts, sorted by name. " +
+			"Queries are matched against the `labels` in `monitor_yaml`; a term on a label the monitor does not set (for example one its alerts take from query results) or on free text is assumed to possibly match, so a route is only left out when it cannot match. " +
+			"An empty list means no route delivers the monitor's alerts, which a `precondition` or `check` can guard against. " +
+			"Computed at plan time from the routes that exist then, and refreshed on read.",
+		Computed:      true,
+		PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					MarkdownDescription: "The notification route ID.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The notification route name.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// matchingNotificationRoutes returns the routes whose query matches or may
+// match labels, sorted by name and ID. Routes with a query that does not parse
+// are kept, since the API may accept queries the matcher does not.
+func matchingNotificationRoutes(routes []*models.NotificationRouteListItemResponse, labels map[string]string) []*models.NotificationRouteListItemResponse {
+	var matching []*models.NotificationRouteListItemResponse
+	for _, route := range routes {
+		if route == nil {
+			continue
+		}
+		if result, err := validators.MatchGcQLQuery(route.Query, labels); err == nil && result == validators.GcQLNoMatch {
+			continue
+		}
+		matching = append(matching, route)
+	}
+	sort.SliceStable(matching, func(i, j int) bool {
+		if matching[i].Name != matching[j].Name {
+			return matching[i].Name < matching[j].Name
+		}
+		return matching[i].ID < matching[j].ID
+	})
+	return matching
+}
+
+// monitorNotificationRoutes returns the notification_routes value for a
+// monitor YAML. The value is unknown when the YAML does not parse or the
+// routes cannot be listed; it is only informational, so neither is an error.
+func (r *monitorResource) monitorNotificationRoutes(ctx context.Context, monitorYaml string) (types.List, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: monitorNotificationRouteAttrTypes}
+
+	if r.client == nil {
+		return types.ListUnknown(elemType), nil
+	}
+	labels, err := monitorYamlLabels(monitorYaml)
+	if err != nil {
+		tflog.Debug(ctx, "Not resolving notification routes for unparseable monitor YAML", map[string]any{"error": err.Error()})
+		return types.ListUnknown(elemType), nil
+	}
+	routes, err := r.notificationRoutes.list(ctx, r.client)
+	if err != nil {
+		tflog.Warn(ctx, "Could not list notification routes to resolve the monitor's routes", map[string]any{"error": err.Error()})
+		return types.ListUnknown(elemType), nil
+	}
+
+	elements := []attr.Value{}
+	var diags diag.Diagnostics
+	for _, route := range matchingNotificationRoutes(routes, labels) {
+		element, objDiags := types.ObjectValue(monitorNotificationRouteAttrTypes, map[string]attr.Value{
+			"id":   types.StringValue(route.ID),
+			"name": types.StringValue(route.Name),
+		})
+		diags.Append(objDiags...)
+		elements = append(elements, element)
+	}
+	list, listDiags := types.ListValue(elemType, elements)
+	diags.Append(listDiags...)
+	return list, diags
+}
+
+// planNotificationRoutes resolves notification_routes for a planned create,
+// or for an update that changes the YAML. Otherwise the state value is kept
+// and only a refresh changes it.
+func (r *monitorResource) planNotificationRoutes(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plannedYaml, stateYaml monitorYamlValue
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("monitor_yaml"), &plannedYaml)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("monitor_yaml"), &stateYaml)...)
+	}
+	if resp.Diagnostics.HasError() || plannedYaml.IsUnknown() || plannedYaml.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() && plannedYaml.ValueString() == stateYaml.ValueString() {
+		return
+	}
+
+	routes, diags := r.monitorNotificationRoutes(ctx, plannedYaml.ValueString())
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("notification_routes"), routes)...)
+}
+
+// resolvedNotificationRoutes returns planned, or when it is unknown the routes
+// resolved after apply. Routes that cannot be resolved are stored as null.
+func (r *monitorResource) resolvedNotificationRoutes(ctx context.Context, planned types.List, monitorYaml string, diags *diag.Diagnostics) types.List {
+	if !planned.IsUnknown() {
+		return planned
+	}
+	routes, routeDiags := r.monitorNotificationRoutes(ctx, monitorYaml)
+	diags.Append(routeDiags...)
+	if routes.IsUnknown() {
+		return types.ListNull(types.ObjectType{AttrTypes: monitorNotificationRouteAttrTypes})
+	}
+	return routes
+}
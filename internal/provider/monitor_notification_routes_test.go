@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingRouteClient serves ListNotificationRoutes from a fixed list or
+// error and counts the calls.
+type countingRouteClient struct {
+	ApiClient
+	routes []*models.NotificationRouteListItemResponse
+	err    error
+	calls  int
+}
+
+func (c *countingRouteClient) ListNotificationRoutes(_ context.Context) ([]*models.NotificationRouteListItemResponse, error) {
+	c.calls++
+	return c.routes, c.err
+}
+
+func TestMatchingNotificationRoutes(t *testing.T) {
+	routes := []*models.NotificationRouteListItemResponse{
+		{ID: "r-staging", Name: "staging", Query: "env:staging"},
+		{ID: "r-prod", Name: "prod", Query: "env:prod AND severity:critical"},
+		{ID: "r-all", Name: "all", Query: "*"},
+		{ID: "r-other-team", Name: "search", Query: "team:search"},
+		{ID: "r-bad", Name: "unparseable", Query: "env:prod AND"},
+		nil,
+	}
+
+	matching := matchingNotificationRoutes(routes, map[string]string{"env": "prod", "team": "payments"})
+	var names []string
+	for _, route := range matching {
+		names = append(names, route.Name)
+	}
+	assert.Equal(t, []string{"all", "prod", "unparseable"}, names)
+
+	assert.Empty(t, matchingNotificationRoutes(routes[:1], map[string]string{"env": "prod"}))
+}
+
+func TestMonitorNotificationRoutes(t *testing.T) {
+	ctx := context.Background()
+	monitorYaml := "title: Checkout errors\nlabels:\n  env: prod\n"
+
+	client := &countingRouteClient{routes: []*models.NotificationRouteListItemResponse{
+		{ID: "r-prod", Name: "prod", Query: "env:prod"},
+		{ID: "r-staging", Name: "staging", Query: "env:staging"},
+	}}
+	r := &monitorResource{client: client, notificationRoutes: &notificationRouteCache{}}
+
+	for range 2 {
+		routes, diags := r.monitorNotificationRoutes(ctx, monitorYaml)
+		require.False(t, diags.HasError(), "%v", diags)
+		require.Len(t, routes.Elements(), 1)
+		assert.Contains(t, routes.Elements()[0].String(), `"r-prod"`)
+	}
+	assert.Equal(t, 1, client.calls, "routes must be listed once per provider process")
+
+	failing := &monitorResource{client: &countingRouteClient{err: errors.New("unavailable")}, notificationRoutes: &notificationRouteCache{}}
+	routes, diags := failing.monitorNotificationRoutes(ctx, monitorYaml)
+	require.False(t, diags.HasError())
+	assert.True(t, routes.IsUnknown(), "a failed list must leave the routes unknown, not empty")
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The labels groundcover_monitor's owner attributes are stored in.
+const (
+	monitorOwnerTeamLabel         = "owner_team"
+	monitorOwnerSlackChannelLabel = "owner_slack_channel"
+)
+
+func monitorOwnerAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"owner_team": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The team that owns the monitor, sent as the `%s` label. Use it with `owner_query` to route a team's alerts without repeating the label name. `monitor_yaml` must not set the label too.", monitorOwnerTeamLabel),
+			Optional:            true,
+		},
+		"owner_slack_channel": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The Slack channel of the team that owns the monitor, such as `#payments-oncall`, sent as the `%s` label. `monitor_yaml` must not set the label too.", monitorOwnerSlackChannelLabel),
+			Optional:            true,
+		},
+		"owner_query": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The gcQL query matching the alerts of the monitors `owner_team` owns, such as `%s:payments`, for the `query` of a `groundcover_notification_route`. Null when `owner_team` is unset.", monitorOwnerTeamLabel),
+			Computed:            true,
+		},
+	}
+}
+
+// ownerLabels returns the labels the owner attributes of m are sent as.
+func (m monitorResourceModel) ownerLabels() map[string]string {
+	labels := map[string]string{}
+	if !m.OwnerTeam.IsNull() && !m.OwnerTeam.IsUnknown() {
+		labels[monitorOwnerTeamLabel] = m.OwnerTeam.ValueString()
+	}
+	if !m.OwnerSlackChannel.IsNull() && !m.OwnerSlackChannel.IsUnknown() {
+		labels[monitorOwnerSlackChannelLabel] = m.OwnerSlackChannel.ValueString()
+	}
+	return labels
+}
+
+// labelDefaults returns the labels added to the labels in monitor_yaml of m:
+// its owner labels over the provider's default_tags.
+func (r *monitorResource) labelDefaults(m monitorResourceModel) map[string]string {
+	return mergeDefaultTags(r.defaultTags, m.ownerLabels())
+}
+
+// monitorOwnerQuery returns owner_query for ownerTeam.
+func monitorOwnerQuery(ownerTeam types.String) types.String {
+	if ownerTeam.IsUnknown() {
+		return types.StringUnknown()
+	}
+	if ownerTeam.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(gcqlEquals(monitorOwnerTeamLabel, ownerTeam.ValueString()))
+}
+
+// refreshMonitorOwner sets the configured owner attributes of data to the
+// owner labels of the remote monitor. An attribute whose label was removed
+// becomes null, so the next apply restores it.
+func refreshMonitorOwner(data *monitorResourceModel, remoteYaml string) {
+	remote, err := monitorYamlLabels(remoteYaml)
+	if err != nil {
+		return
+	}
+	refresh := func(current types.String, label string) types.String {
+		if current.IsNull() || current.IsUnknown() {
+			return current
+		}
+		if value, ok := remote[label]; ok {
+			return types.StringValue(value)
+		}
+		return types.StringNull()
+	}
+	data.OwnerTeam = refresh(data.OwnerTeam, monitorOwnerTeamLabel)
+	data.OwnerSlackChannel = refresh(data.OwnerSlackChannel, monitorOwnerSlackChannelLabel)
+	data.OwnerQuery = monitorOwnerQuery(data.OwnerTeam)
+}
+
+// checkMonitorOwnerLabelConflicts rejects a planned groundcover_monitor
+// whose monitor_yaml sets a label an owner attribute also sets.
+func checkMonitorOwnerLabelConflicts(ctx context.Context, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plannedYaml monitorYamlValue
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("monitor_yaml"), &plannedYaml)...)
+	if diags.HasError() || plannedYaml.IsNull() || plannedYaml.IsUnknown() {
+		return
+	}
+	yamlLabels, err := monitorYamlLabels(plannedYaml.ValueString())
+	if err != nil {
+		return
+	}
+
+	for _, owner := range []struct{ attribute, label string }{
+		{"owner_team", monitorOwnerTeamLabel},
+		{"owner_slack_channel", monitorOwnerSlackChannelLabel},
+	} {
+		attribute, label := owner.attribute, owner.label
+		var value types.String
+		diags.Append(req.Plan.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if value.IsNull() {
+			continue
+		}
+		if _, ok := yamlLabels[label]; ok {
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"Monitor Owner Set Twice",
+				fmt.Sprintf("%s is sent as the %q label, which monitor_yaml also sets. Set the owner in only one place.", attribute, label),
+			)
+		}
+	}
+}
+
+// planOwnerQuery sets owner_query from the planned owner_team.
+func planOwnerQuery(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var ownerTeam types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("owner_team"), &ownerTeam)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("owner_query"), monitorOwnerQuery(ownerTeam))...)
+}
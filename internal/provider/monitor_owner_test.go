@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ownedMonitorModel(monitorYaml string) monitorResourceModel {
+	model := testMonitorModel(monitorYaml)
+	model.OwnerTeam = types.StringValue("payments")
+	model.OwnerSlackChannel = types.StringValue("#payments-oncall")
+	return model
+}
+
+func TestMonitorCreateSendsOwnerLabels(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	r := &monitorResource{client: client, defaultTags: map[string]string{"owner_team": "platform", "env": "prod"}}
+
+	created, diags := testMonitorCreate(ctx, r, ownedMonitorModel("title: Checkout errors\nlabels:\n  service: checkout\n"))
+	require.False(t, diags.HasError(), "%v", diags)
+
+	var state monitorResourceModel
+	require.False(t, created.Get(ctx, &state).HasError())
+	labels, err := monitorYamlLabels(m.monitor(state.Id.ValueString()))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"service":             "checkout",
+		"env":                 "prod",
+		"owner_team":          "payments",
+		"owner_slack_channel": "#payments-oncall",
+	}, labels, "owner_team must take precedence over a default tag")
+	assert.Equal(t, "owner_team:payments", state.OwnerQuery.ValueString())
+	assert.Equal(t, types.StringValue("payments"), state.LabelsAll.Elements()["owner_team"])
+}
+
+func TestMonitorReadRefreshesOwner(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	r := &monitorResource{client: client}
+	state, diags := testMonitorCreate(ctx, r, ownedMonitorModel("title: Checkout errors\n"))
+	require.False(t, diags.HasError(), "%v", diags)
+	var created monitorResourceModel
+	require.False(t, state.Get(ctx, &created).HasError())
+	m.setMonitor(created.Id.ValueString(), "title: Checkout errors\nlabels:\n  owner_team: search\n")
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var refreshed monitorResourceModel
+	require.False(t, resp.State.Get(ctx, &refreshed).HasError())
+	assert.Equal(t, types.StringValue("search"), refreshed.OwnerTeam)
+	assert.Equal(t, "owner_team:search", refreshed.OwnerQuery.ValueString())
+	assert.True(t, refreshed.OwnerSlackChannel.IsNull(), "a removed owner label must be restored by the next apply")
+}
+
+func TestMonitorOwnerLabelSetTwice(t *testing.T) {
+	ctx := context.Background()
+	r := &monitorResource{}
+	s := resourceSchema(ctx, r)
+
+	plan, diags := testMonitorPlan(ctx, r, ownedMonitorModel("title: Checkout errors\nlabels:\n  owner_team: payments\n"))
+	require.False(t, diags.HasError(), "%v", diags)
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Monitor Owner Set Twice", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func monitorReconcileAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"reconcile": schema.BoolAttribute{
+			MarkdownDescription: "When `false`, Terraform only audits the monitor: a refresh that finds it changed outside Terraform, for example in the UI, reports a warning and sets `drift_detected` and `remote_monitor_yaml`, but keeps `monitor_yaml`, `annotations`, and `labels_all` in state, so the next apply does not overwrite the change. " +
+				"A configuration change is still applied, unless the monitor was changed outside Terraform since the last apply: the apply then fails instead of overwriting that change. To accept the change, copy `remote_monitor_yaml` into `monitor_yaml` and apply, then make further changes. Defaults to `true`.",
+			Optional: true,
+		},
+		"drift_detected": schema.BoolAttribute{
+			MarkdownDescription: "Whether the last refresh found the monitor changed outside Terraform. Always `false` unless `reconcile` is `false`; with reconciliation, drift is shown in the plan instead.",
+			Computed:            true,
+		},
+		"remote_monitor_yaml": schema.StringAttribute{
+			MarkdownDescription: "The monitor as it was found when `drift_detected` is `true`, in the key order of `monitor_yaml`. Null otherwise.",
+			Computed:            true,
+		},
+	}
+}
+
+// reconciles reports whether refresh and apply bring the monitor back to the
+// configuration, the default; reconcile = false only reports drift.
+func (m monitorResourceModel) reconciles() bool {
+	return m.Reconcile.IsNull() || m.Reconcile.IsUnknown() || m.Reconcile.ValueBool()
+}
+
+// clearDrift marks the monitor as matching what Terraform applied.
+func (m *monitorResourceModel) clearDrift() {
+	m.DriftDetected = types.BoolValue(false)
+	m.RemoteMonitorYaml = types.StringNull()
+}
+
+// remoteMonitorDrifted reports whether remoteYaml, with ignore_yaml_paths
+// already overlaid, describes a different monitor than monitorYaml of m.
+func (m monitorResourceModel) remoteMonitorDrifted(ctx context.Context, monitorYaml, remoteYaml string) bool {
+	apiYaml, err := m.monitorYamlForAPI(monitorYaml)
+	if err != nil {
+		apiYaml = monitorYaml
+	}
+	return !monitorYamlSemanticallyEqual(ctx, apiYaml, remoteYaml)
+}
+
+// auditDrift records on data whether remoteYaml differs from the monitor in
+// state, without storing it, and warns when it does.
+func (r *monitorResource) auditDrift(ctx context.Context, data *monitorResourceModel, remoteYaml string, diags *diag.Diagnostics) {
+	stateYaml := data.MonitorYaml.ValueString()
+	if !data.remoteMonitorDrifted(ctx, stateYaml, remoteYaml) {
+		data.clearDrift()
+		return
+	}
+
+	if ordered, err := OrderYamlKeysLikeTemplate(remoteYaml, stateYaml); err == nil {
+		remoteYaml = ordered
+	}
+	tflog.Info(ctx, "Monitor changed outside Terraform, keeping state because reconcile is false", map[string]any{"id": data.Id.ValueString()})
+	data.DriftDetected = types.BoolValue(true)
+	data.RemoteMonitorYaml = types.StringValue(remoteYaml)
+	diags.AddAttributeWarning(
+		path.Root("reconcile"),
+		"Monitor Changed Outside Terraform",
+		fmt.Sprintf("Monitor %s no longer matches its configuration. reconcile is false, so the change is kept and not overwritten by apply; see remote_monitor_yaml for the monitor as found.", data.Id.ValueString()),
+	)
+}
+
+// checkUnreconciledUpdate guards an update of a monitor with reconcile =
+// false against overwriting a change made outside Terraform. It returns true
+// when the update must not be sent: either it failed, or the remote monitor
+// already matches the plan.
+func (r *monitorResource) checkUnreconciledUpdate(ctx context.Context, plan, state monitorResourceModel, remoteYaml string, diags *diag.Diagnostics) bool {
+	if !state.remoteMonitorDrifted(ctx, state.MonitorYaml.ValueString(), remoteYaml) {
+		return false
+	}
+	if !plan.remoteMonitorDrifted(ctx, plan.MonitorYaml.ValueString(), remoteYaml) {
+		tflog.Debug(ctx, "Remote monitor already matches the planned YAML, skipping the update", map[string]any{"id": state.Id.ValueString()})
+		return true
+	}
+	diags.AddAttributeError(
+		path.Root("monitor_yaml"),
+		"Monitor Changed Outside Terraform",
+		fmt.Sprintf("Monitor %s was changed outside Terraform since the last apply, and reconcile is false, so the update was not sent to avoid overwriting that change. "+
+			"To keep it, set monitor_yaml to remote_monitor_yaml and apply before making other changes; to overwrite it, set reconcile to true.", state.Id.ValueString()),
+	)
+	return true
+}
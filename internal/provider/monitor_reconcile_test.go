@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const reconcileStateYaml = `title: Checkout errors
+severity: S2
+labels:
+  team: payments
+`
+
+const reconcileEditedInUIYaml = `title: Checkout errors
+severity: S1
+labels:
+  team: payments
+`
+
+// createUnreconciledMonitor creates a monitor with reconcile = false and the
+// state YAML through the mock API and returns its resource, state and ID.
+func createUnreconciledMonitor(t *testing.T) (*mockAPI, *monitorResource, tfsdk.State, string) {
+	t.Helper()
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	r := &monitorResource{client: client}
+	model := testMonitorModel(reconcileStateYaml)
+	model.Reconcile = types.BoolValue(false)
+	state, diags := testMonitorCreate(ctx, r, model)
+	require.False(t, diags.HasError(), "%v", diags)
+	var created monitorResourceModel
+	require.False(t, state.Get(ctx, &created).HasError())
+	return m, r, state, created.Id.ValueString()
+}
+
+func TestMonitorReadWithoutReconcileReportsDrift(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteYaml string
+		wantDrift  bool
+	}{
+		{name: "changed in the UI", remoteYaml: reconcileEditedInUIYaml, wantDrift: true},
+		{name: "only reformatted", remoteYaml: "labels: {team: payments}\nseverity: S2\ntitle: Checkout errors\n", wantDrift: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			m, r, state, id := createUnreconciledMonitor(t)
+			m.setMonitor(id, tt.remoteYaml)
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var refreshed monitorResourceModel
+			require.False(t, resp.State.Get(ctx, &refreshed).HasError())
+			assert.Equal(t, reconcileStateYaml, refreshed.MonitorYaml.ValueString(), "state must keep the applied YAML")
+			assert.Equal(t, tt.wantDrift, refreshed.DriftDetected.ValueBool())
+			assert.Equal(t, tt.wantDrift, resp.Diagnostics.WarningsCount() == 1)
+			if tt.wantDrift {
+				assert.Contains(t, refreshed.RemoteMonitorYaml.ValueString(), "severity: S1")
+			} else {
+				assert.True(t, refreshed.RemoteMonitorYaml.IsNull())
+			}
+		})
+	}
+}
+
+func TestMonitorUpdateWithoutReconcile(t *testing.T) {
+	const plannedYaml = `title: Checkout failures
+severity: S2
+labels:
+  team: payments
+`
+	tests := []struct {
+		name        string
+		remoteYaml  string
+		plannedYaml string
+		wantUpdate  bool
+		wantError   bool
+	}{
+		{name: "unchanged remotely", plannedYaml: plannedYaml, wantUpdate: true},
+		{name: "changed remotely", remoteYaml: reconcileEditedInUIYaml, plannedYaml: plannedYaml, wantError: true},
+		{name: "remote change accepted in configuration", remoteYaml: reconcileEditedInUIYaml, plannedYaml: reconcileEditedInUIYaml},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			m, r, prior, id := createUnreconciledMonitor(t)
+			if tt.remoteYaml != "" {
+				m.setMonitor(id, tt.remoteYaml)
+			}
+
+			var planned monitorResourceModel
+			require.False(t, prior.Get(ctx, &planned).HasError())
+			planned.MonitorYaml = newMonitorYamlValue(tt.plannedYaml)
+			planned.DriftDetected = types.BoolUnknown()
+			planned.RemoteMonitorYaml = types.StringUnknown()
+			plan, diags := testMonitorPlan(ctx, r, planned)
+			require.False(t, diags.HasError(), "%v", diags)
+
+			resp := resource.UpdateResponse{State: prior}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: prior}, &resp)
+			require.Equal(t, tt.wantError, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, tt.wantUpdate, m.requestCount(http.MethodPut, "/api/monitors/"+id) == 1, "update sent")
+			if tt.wantError {
+				return
+			}
+
+			var updated monitorResourceModel
+			require.False(t, resp.State.Get(ctx, &updated).HasError())
+			assert.Equal(t, tt.plannedYaml, updated.MonitorYaml.ValueString())
+			assert.False(t, updated.DriftDetected.ValueBool())
+		})
+	}
+}
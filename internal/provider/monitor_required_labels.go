@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// missingMonitorLabels returns the entries of required that labels does not
+// set to a non-empty value, in the order they are required.
+func missingMonitorLabels(required []string, labels map[string]string) []string {
+	var missing []string
+	for _, key := range required {
+		if strings.TrimSpace(labels[key]) == "" {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
+// addMissingMonitorLabelsError reports the labels required by the provider's
+// required_monitor_labels setting that a planned monitor does not set.
+func addMissingMonitorLabelsError(diags *diag.Diagnostics, attrPath path.Path, required, missing []string) {
+	diags.AddAttributeError(
+		attrPath,
+		"Missing Required Monitor Labels",
+		fmt.Sprintf("The provider's required_monitor_labels setting requires every monitor to set the labels %s, but this monitor does not set %s.",
+			formatLabelKeys(required), formatLabelKeys(missing)),
+	)
+}
+
+func formatLabelKeys(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = fmt.Sprintf("%q", key)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// monitorYamlLabels returns the top-level `labels` of a monitor YAML document.
+// Scalar label values are rendered as strings.
+func monitorYamlLabels(monitorYaml string) (map[string]string, error) {
+	return monitorYamlStringMap(monitorYaml, "labels")
+}
+
+// monitorYamlStringMap returns the top-level mapping key of a monitor YAML
+// document, with scalar values rendered as strings and null values left out.
+func monitorYamlStringMap(monitorYaml, key string) (map[string]string, error) {
+	var doc map[string]any
+	if err := yaml.Unmarshal([]byte(monitorYaml), &doc); err != nil {
+		return nil, err
+	}
+	raw, _ := doc[key].(map[string]any)
+	values := make(map[string]string, len(raw))
+	for k, value := range raw {
+		if value != nil {
+			values[k] = fmt.Sprint(value)
+		}
+	}
+	return values, nil
+}
+
+// plannedMonitorLabels returns the labels of a planned labels map. Labels
+// whose value is not known yet count as set. ok is false when the map itself
+// is unknown and cannot be checked.
+func plannedMonitorLabels(labels types.Map) (result map[string]string, ok bool) {
+	if labels.IsUnknown() {
+		return nil, false
+	}
+	result = make(map[string]string, len(labels.Elements()))
+	for key, value := range labels.Elements() {
+		str, isString := value.(types.String)
+		switch {
+		case !isString || str.IsUnknown():
+			result[key] = "(known after apply)"
+		case !str.IsNull():
+			result[key] = str.ValueString()
+		}
+	}
+	return result, true
+}
+
+// checkMonitorV2RequiredLabels enforces required_monitor_labels on a planned
+// groundcover_monitor_v2 or groundcover_monitor_v2_json. Destroys and plans
+// that leave the monitor unchanged are not checked, so tightening the setting
+// does not block unrelated applies.
+func checkMonitorV2RequiredLabels(ctx context.Context, required []string, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	if len(required) == 0 || req.Plan.Raw.IsNull() || planMatchesState(req.Plan, req.State) {
+		return
+	}
+
+	var labels types.Map
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("labels"), &labels)...)
+	if diags.HasError() {
+		return
+	}
+	planned, ok := plannedMonitorLabels(labels)
+	if !ok {
+		return
+	}
+	if missing := missingMonitorLabels(required, planned); len(missing) > 0 {
+		addMissingMonitorLabelsError(diags, path.Root("labels"), required, missing)
+	}
+}
+
+// planMatchesState reports whether a plan leaves an existing resource as it is.
+func planMatchesState(plan tfsdk.Plan, state tfsdk.State) bool {
+	return !state.Raw.IsNull() && plan.Raw.Equal(state.Raw)
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMonitorCreateStartPaused(t *testing.T) {
+	const monitorYaml = "title: Checkout errors\ndisplay:\n  header: errors\n"
+
+	tests := []struct {
+		name        string
+		monitorYaml string
+		startPaused types.Bool
+		wantPaused  bool
+	}{
+		{name: "unset", monitorYaml: monitorYaml, startPaused: types.BoolNull()},
+		{name: "start paused", monitorYaml: monitorYaml, startPaused: types.BoolValue(true), wantPaused: true},
+		{name: "yaml isPaused wins", monitorYaml: monitorYaml + "isPaused: false\n", startPaused: types.BoolValue(true)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			m, client := newMockAPIClient(t)
+			r := &monitorResource{client: client}
+
+			model := testMonitorModel(tt.monitorYaml)
+			model.StartPaused = tt.startPaused
+			state, diags := testMonitorCreate(ctx, r, model)
+			require.False(t, diags.HasError(), "%v", diags)
+
+			var created monitorResourceModel
+			require.False(t, state.Get(ctx, &created).HasError())
+			var stored struct {
+				IsPaused bool `yaml:"isPaused"`
+			}
+			require.NoError(t, yaml.Unmarshal([]byte(m.monitor(created.Id.ValueString())), &stored))
+			assert.Equal(t, tt.wantPaused, stored.IsPaused)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+func monitorThresholdOverridesAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "Threshold values that replace those in `monitor_yaml`, keyed by threshold `name`, such as `{ threshold_1 = 95 }`. Lets one `monitor_yaml` serve several environments with different thresholds. " +
+			"Each named threshold must exist in `model.thresholds` and have a single value. `monitor_yaml` keeps the value it is written with: refresh reads the overridden thresholds into this map, so a threshold changed outside Terraform shows up here rather than as a YAML diff.",
+		ElementType: types.Float64Type,
+		Optional:    true,
+	}
+}
+
+// monitorThresholdOverrides returns the entries of groundcover_monitor's
+// threshold_overrides attribute. Null and unknown entries are left out.
+func monitorThresholdOverrides(overrides types.Map) map[string]float64 {
+	values := map[string]float64{}
+	for name, value := range overrides.Elements() {
+		if number, ok := value.(types.Float64); ok && !number.IsNull() && !number.IsUnknown() {
+			values[name] = number.ValueFloat64()
+		}
+	}
+	return values
+}
+
+// monitorThresholdValues returns the values of each named threshold in the
+// monitor's model.thresholds.
+func monitorThresholdValues(monitorYaml string) (map[string][]float64, error) {
+	var doc struct {
+		Model struct {
+			Thresholds []struct {
+				Name   string    `yaml:"name"`
+				Values []float64 `yaml:"values"`
+			} `yaml:"thresholds"`
+		} `yaml:"model"`
+	}
+	if err := yaml.Unmarshal([]byte(monitorYaml), &doc); err != nil {
+		return nil, err
+	}
+	values := make(map[string][]float64, len(doc.Model.Thresholds))
+	for _, threshold := range doc.Model.Thresholds {
+		values[threshold.Name] = threshold.Values
+	}
+	return values, nil
+}
+
+// thresholdOverrideError explains why override cannot be applied to a
+// monitor with thresholds, or returns nil.
+func thresholdOverrideError(thresholds map[string][]float64, name string) error {
+	values, ok := thresholds[name]
+	if !ok {
+		return fmt.Errorf("monitor_yaml has no threshold named %q; its thresholds are %q", name, slices.Sorted(maps.Keys(thresholds)))
+	}
+	if len(values) != 1 {
+		return fmt.Errorf("threshold %q has %d values, and only single-value thresholds can be overridden", name, len(values))
+	}
+	return nil
+}
+
+// applyMonitorThresholdOverrides returns monitorYaml with the values of the
+// thresholds named in overrides replaced by the override.
+func applyMonitorThresholdOverrides(monitorYaml string, overrides map[string]float64) (string, error) {
+	if len(overrides) == 0 {
+		return monitorYaml, nil
+	}
+	thresholds, err := monitorThresholdValues(monitorYaml)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		if err := thresholdOverrideError(thresholds, name); err != nil {
+			return "", err
+		}
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(monitorYaml), &doc); err != nil {
+		return "", fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	var root *yaml.Node
+	if len(doc.Content) > 0 {
+		root = doc.Content[0]
+	}
+	list := lookupYamlPath(root, []string{"model", "thresholds"})
+	for list != nil && list.Kind == yaml.AliasNode {
+		list = list.Alias
+	}
+	if list != nil {
+		for _, threshold := range list.Content {
+			name := yamlMappingValue(threshold, "name")
+			if name == nil {
+				continue
+			}
+			override, ok := overrides[name.Value]
+			if !ok {
+				continue
+			}
+			values := yamlMappingValue(threshold, "values")
+			*values = yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Value: strconv.FormatFloat(override, 'f', -1, 64)},
+			}}
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return "", fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// refreshMonitorThresholdOverrides returns the threshold_overrides attribute
+// after a read: each overridden threshold takes its value from the remote
+// YAML, and thresholds the remote monitor no longer has, or no longer has
+// with a single value, are dropped.
+func refreshMonitorThresholdOverrides(current types.Map, remoteYaml string) (types.Map, diag.Diagnostics) {
+	if current.IsNull() || current.IsUnknown() {
+		return current, nil
+	}
+
+	remote, err := monitorThresholdValues(remoteYaml)
+	if err != nil {
+		return current, nil
+	}
+	refreshed := make(map[string]attr.Value, len(current.Elements()))
+	for name := range current.Elements() {
+		if values := remote[name]; len(values) == 1 {
+			refreshed[name] = types.Float64Value(values[0])
+		}
+	}
+	return types.MapValue(types.Float64Type, refreshed)
+}
+
+// restoreOverriddenThresholds returns remoteYaml with the overridden
+// thresholds set back to their values in stateYaml, so an override is not
+// stored in monitor_yaml. YAML that does not parse is returned unchanged.
+func restoreOverriddenThresholds(ctx context.Context, remoteYaml, stateYaml string, overrides types.Map) string {
+	if len(overrides.Elements()) == 0 {
+		return remoteYaml
+	}
+	base, err := monitorThresholdValues(stateYaml)
+	if err != nil {
+		return remoteYaml
+	}
+	remote, err := monitorThresholdValues(remoteYaml)
+	if err != nil {
+		return remoteYaml
+	}
+
+	restored := map[string]float64{}
+	for name := range overrides.Elements() {
+		if len(base[name]) == 1 && len(remote[name]) == 1 {
+			restored[name] = base[name][0]
+		}
+	}
+	result, err := applyMonitorThresholdOverrides(remoteYaml, restored)
+	if err != nil {
+		tflog.Debug(ctx, "Keeping overridden thresholds in the remote monitor YAML", map[string]any{"error": err.Error()})
+		return remoteYaml
+	}
+	return result
+}
+
+// checkMonitorThresholdOverrides rejects a planned groundcover_monitor whose
+// threshold_overrides names a threshold monitor_yaml does not have, or one
+// with several values. YAML that is unknown or does not parse is left to
+// Create and Update.
+func checkMonitorThresholdOverrides(ctx context.Context, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plannedYaml monitorYamlValue
+	var expandAnchors types.Bool
+	var overrides types.Map
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("monitor_yaml"), &plannedYaml)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("expand_yaml_anchors"), &expandAnchors)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("threshold_overrides"), &overrides)...)
+	if diags.HasError() || plannedYaml.IsNull() || plannedYaml.IsUnknown() || overrides.IsNull() || overrides.IsUnknown() {
+		return
+	}
+
+	monitorYaml, err := monitorResourceModel{ExpandYamlAnchors: expandAnchors}.monitorYamlForAPI(plannedYaml.ValueString())
+	if err != nil {
+		return
+	}
+	thresholds, err := monitorThresholdValues(monitorYaml)
+	if err != nil {
+		return
+	}
+	for _, name := range slices.Sorted(maps.Keys(overrides.Elements())) {
+		if err := thresholdOverrideError(thresholds, name); err != nil {
+			diags.AddAttributeError(
+				path.Root("threshold_overrides").AtMapKey(name),
+				"Invalid Threshold Override",
+				err.Error()+".",
+			)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const thresholdOverridesYaml = `title: Checkout latency
+model:
+  thresholds:
+    - name: critical
+      inputName: latency
+      operator: gt
+      values:
+        - 500
+    - name: band
+      inputName: latency
+      operator: within_range
+      values:
+        - 100
+        - 200
+`
+
+func thresholdOverridesModel(overrides map[string]float64) monitorResourceModel {
+	elements := map[string]attr.Value{}
+	for name, value := range overrides {
+		elements[name] = types.Float64Value(value)
+	}
+	model := testMonitorModel(thresholdOverridesYaml)
+	model.ThresholdOverrides = types.MapValueMust(types.Float64Type, elements)
+	return model
+}
+
+func TestApplyMonitorThresholdOverrides(t *testing.T) {
+	overridden, err := applyMonitorThresholdOverrides(thresholdOverridesYaml, map[string]float64{"critical": 750.5})
+	require.NoError(t, err)
+	values, err := monitorThresholdValues(overridden)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{750.5}, values["critical"])
+	assert.Equal(t, []float64{100, 200}, values["band"])
+
+	_, err = applyMonitorThresholdOverrides(thresholdOverridesYaml, map[string]float64{"warning": 1})
+	assert.ErrorContains(t, err, `no threshold named "warning"`)
+	_, err = applyMonitorThresholdOverrides(thresholdOverridesYaml, map[string]float64{"band": 1})
+	assert.ErrorContains(t, err, "has 2 values")
+}
+
+func TestMonitorCreateAppliesThresholdOverrides(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	r := &monitorResource{client: client}
+
+	state, diags := testMonitorCreate(ctx, r, thresholdOverridesModel(map[string]float64{"critical": 900}))
+	require.False(t, diags.HasError(), "%v", diags)
+
+	var created monitorResourceModel
+	require.False(t, state.Get(ctx, &created).HasError())
+	assert.Equal(t, thresholdOverridesYaml, created.MonitorYaml.ValueString(), "monitor_yaml must keep its own threshold")
+	values, err := monitorThresholdValues(m.monitor(created.Id.ValueString()))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]float64{"critical": {900}, "band": {100, 200}}, values)
+}
+
+func TestMonitorReadKeepsThresholdOverridesOutOfYaml(t *testing.T) {
+	tests := []struct {
+		name        string
+		remoteValue float64
+	}{
+		{name: "applied override", remoteValue: 900},
+		{name: "changed outside Terraform", remoteValue: 650},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			m, client := newMockAPIClient(t)
+			r := &monitorResource{client: client}
+			state, diags := testMonitorCreate(ctx, r, thresholdOverridesModel(map[string]float64{"critical": 900}))
+			require.False(t, diags.HasError(), "%v", diags)
+			var created monitorResourceModel
+			require.False(t, state.Get(ctx, &created).HasError())
+
+			remoteYaml, err := applyMonitorThresholdOverrides(thresholdOverridesYaml, map[string]float64{"critical": tt.remoteValue})
+			require.NoError(t, err)
+			m.setMonitor(created.Id.ValueString(), remoteYaml)
+
+			resp := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var refreshed monitorResourceModel
+			require.False(t, resp.State.Get(ctx, &refreshed).HasError())
+			same, err := CompareYamlSemantically(refreshed.MonitorYaml.ValueString(), thresholdOverridesYaml)
+			require.NoError(t, err)
+			assert.True(t, same, "overridden thresholds must not be drift in monitor_yaml, got\n%s", refreshed.MonitorYaml.ValueString())
+			assert.Equal(t, types.Float64Value(tt.remoteValue), refreshed.ThresholdOverrides.Elements()["critical"])
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ basetypes.StringTypable                    = monitorYamlType{}
+	_ basetypes.StringValuableWithSemanticEquals = monitorYamlValue{}
+)
+
+// monitorYamlType is the type of groundcover_monitor's monitor_yaml. Two values
+// are semantically equal when they describe the same monitor after keys the
+// prior value does not set (server-added defaults) are dropped from the new one
+// and both are normalized with NormalizeMonitorYaml, so refreshes and applies
+// keep the user's YAML layout.
+type monitorYamlType struct {
+	basetypes.StringType
+}
+
+func (t monitorYamlType) String() string {
+	return "provider.monitorYamlType"
+}
+
+func (t monitorYamlType) ValueType(_ context.Context) attr.Value {
+	return monitorYamlValue{}
+}
+
+func (t monitorYamlType) Equal(o attr.Type) bool {
+	other, ok := o.(monitorYamlType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t monitorYamlType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return monitorYamlValue{StringValue: in}, nil
+}
+
+func (t monitorYamlType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+// monitorYamlValue is a value of monitorYamlType.
+type monitorYamlValue struct {
+	basetypes.StringValue
+}
+
+func newMonitorYamlValue(value string) monitorYamlValue {
+	return monitorYamlValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v monitorYamlValue) Type(_ context.Context) attr.Type {
+	return monitorYamlType{}
+}
+
+func (v monitorYamlValue) Equal(o attr.Value) bool {
+	other, ok := o.(monitorYamlValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v monitorYamlValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(monitorYamlValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: %T\nGot Value Type: %T", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return monitorYamlSemanticallyEqual(ctx, v.ValueString(), newValue.ValueString()), diags
+}
+
+// monitorYamlSemanticallyEqual reports whether other describes the same monitor
+// as configured. Keys of other that configured does not set are ignored, and
+// both sides are normalized before a semantic comparison. Unparseable YAML
+// falls back to comparing the unnormalized strings.
+func monitorYamlSemanticallyEqual(ctx context.Context, configured, other string) bool {
+	if configured == other {
+		return true
+	}
+
+	filteredOther, err := FilterYamlKeysBasedOnTemplate(ctx, other, configured)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to filter monitor YAML based on the configured template, using unfiltered YAML", map[string]interface{}{
+			"error": err.Error(),
+		})
+		filteredOther = other
+	}
+
+	normalizedConfigured, err := NormalizeMonitorYaml(ctx, configured)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to normalize configured monitor YAML", map[string]interface{}{
+			"error": err.Error(),
+		})
+		normalizedConfigured = configured
+	}
+
+	normalizedOther, err := NormalizeMonitorYaml(ctx, filteredOther)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to normalize compared monitor YAML", map[string]interface{}{
+			"error": err.Error(),
+		})
+		normalizedOther = filteredOther
+	}
+
+	areSemanticallySame, err := CompareYamlSemantically(normalizedConfigured, normalizedOther)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to perform semantic YAML comparison, falling back to string comparison", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return normalizedConfigured == normalizedOther
+	}
+	return areSemanticallySame
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestMonitorYamlStringSemanticEquals(t *testing.T) {
+	configured := `title: "CPU high"
+severity: S2
+evaluationInterval:
+  interval: 1m
+  pendingFor: 0s
+labels:
+  team: infra
+`
+	tests := []struct {
+		name   string
+		remote string
+		want   bool
+	}{
+		{
+			name:   "identical",
+			remote: configured,
+			want:   true,
+		},
+		{
+			name: "reordered and reformatted",
+			remote: `labels: {team: infra}
+severity: "S2"
+title: CPU high
+evaluationInterval: {pendingFor: 0s, interval: 1m}
+`,
+			want: true,
+		},
+		{
+			name: "server-added fields",
+			remote: configured + `isPaused: false
+measurementType: state
+`,
+			want: true,
+		},
+		{
+			name: "changed value",
+			remote: `title: "CPU high"
+severity: S1
+evaluationInterval:
+  interval: 1m
+  pendingFor: 0s
+labels:
+  team: infra
+`,
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := newMonitorYamlValue(configured).StringSemanticEquals(context.Background(), newMonitorYamlValue(tt.remote))
+			if diags.HasError() {
+				t.Fatalf("StringSemanticEquals() diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Fatalf("StringSemanticEquals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonitorYamlStringSemanticEqualsWrongType(t *testing.T) {
+	_, diags := newMonitorYamlValue("title: x").StringSemanticEquals(context.Background(), basetypes.NewStringValue("title: x"))
+	if !diags.HasError() {
+		t.Fatal("StringSemanticEquals() with a plain string value: expected an error diagnostic")
+	}
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Resources that manage the same remote object under a different type accept
+// their sibling's state through `moved` blocks, so a configuration can switch
+// types without destroying and recreating the object.
+var (
+	_ resource.ResourceWithMoveState = &monitorV2Resource{}
+	_ resource.ResourceWithMoveState = &monitorV2JsonResource{}
+	_ resource.ResourceWithMoveState = &connectedAppResource{}
+	_ resource.ResourceWithMoveState = &connectedAppJsonResource{}
+	_ resource.ResourceWithMoveState = &dataIntegrationResource{}
+)
+
+// resourceSchema returns the schema of r, for use as a StateMover's
+// SourceSchema.
+func resourceSchema(ctx context.Context, r resource.Resource) *schema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return &resp.Schema
+}
+
+// moveStateByID returns a StateMover that accepts the state of sourceTypeName
+// by carrying over only its id. The rest of the state is filled by the
+// refresh that follows the move, as after an import.
+func moveStateByID(sourceTypeName string) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != sourceTypeName || req.SourceRawState == nil {
+				return
+			}
+
+			var raw map[string]json.RawMessage
+			if err := json.Unmarshal(req.SourceRawState.JSON, &raw); err != nil {
+				resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("Could not parse the %s state: %s", sourceTypeName, err))
+				return
+			}
+			var id string
+			if err := json.Unmarshal(raw["id"], &id); err != nil || id == "" {
+				resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("The %s state has no id.", sourceTypeName))
+				return
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), id)...)
+		},
+	}
+}
+
+func (r *monitorV2Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveStateByID("groundcover_monitor"),
+		{
+			SourceSchema: resourceSchema(ctx, &monitorV2JsonResource{}),
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "groundcover_monitor_v2_json" {
+					return
+				}
+				var source monitorV2JsonResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				target := source.toTyped(ctx, &resp.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, target)...)
+			},
+		},
+	}
+}
+
+// groundcover_data_integration and the deprecated groundcover_dataintegration
+// share a schema, so their state moves over unchanged in either direction.
+func (r *dataIntegrationResource) MoveState(ctx context.Context) []resource.StateMover {
+	source := &dataIntegrationResource{renamed: !r.renamed}
+	sourceTypeName := "groundcover" + source.typeNameSuffix()
+	return []resource.StateMover{
+		{
+			SourceSchema: resourceSchema(ctx, source),
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != sourceTypeName {
+					return
+				}
+				var state dataIntegrationResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+			},
+		},
+	}
+}
+
+func (r *monitorV2JsonResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveStateByID("groundcover_monitor"),
+		{
+			SourceSchema: resourceSchema(ctx, &monitorV2Resource{}),
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "groundcover_monitor_v2" {
+					return
+				}
+				var source monitorV2ResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				target := monitorV2JsonModelFromTyped(ctx, &source, &resp.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, target)...)
+			},
+		},
+	}
+}
+
+// The API redacts connected app data on read, so the connected app movers
+// convert data between its dynamic and JSON string forms instead of leaving
+// it to the refresh.
+
+func (r *connectedAppResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: resourceSchema(ctx, &connectedAppJsonResource{}),
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "groundcover_connected_app_json" {
+					return
+				}
+				var source connectedAppJsonResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				target := connectedAppResourceModel{
+					Id:              source.Id,
+					Name:            source.Name,
+					Type:            source.Type,
+					Data:            types.DynamicNull(),
+					DataHash:        source.DataHash,
+					RotationTrigger: source.RotationTrigger,
+					CreatedBy:       source.CreatedBy,
+					CreatedAt:       source.CreatedAt,
+					UpdatedBy:       source.UpdatedBy,
+					UpdatedAt:       source.UpdatedAt,
+				}
+				if !source.Data.IsNull() {
+					data, diags := jsonStringToMap(source.Data)
+					resp.Diagnostics.Append(diags...)
+					if resp.Diagnostics.HasError() {
+						return
+					}
+					dynamic, err := mapToDynamicValue(ctx, data)
+					if err != nil {
+						resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("Could not convert connected app data: %s", err))
+						return
+					}
+					target.Data = dynamic
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+			},
+		},
+	}
+}
+
+func (r *connectedAppJsonResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: resourceSchema(ctx, &connectedAppResource{}),
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "groundcover_connected_app" {
+					return
+				}
+				var source connectedAppResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				target := connectedAppJsonResourceModel{
+					Id:              source.Id,
+					Name:            source.Name,
+					Type:            source.Type,
+					Data:            types.StringNull(),
+					DataHash:        source.DataHash,
+					RotationTrigger: source.RotationTrigger,
+					CreatedBy:       source.CreatedBy,
+					CreatedAt:       source.CreatedAt,
+					UpdatedBy:       source.UpdatedBy,
+					UpdatedAt:       source.UpdatedAt,
+				}
+				if !source.Data.IsNull() {
+					data, diags := dynamicValueToMap(ctx, source.Data)
+					resp.Diagnostics.Append(diags...)
+					if resp.Diagnostics.HasError() {
+						return
+					}
+					encoded, err := json.Marshal(data)
+					if err != nil {
+						resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("Could not encode connected app data: %s", err))
+						return
+					}
+					target.Data = types.StringValue(string(encoded))
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+			},
+		},
+	}
+}
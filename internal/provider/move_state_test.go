@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runStateMovers calls target's state movers like the framework does and
+// returns the target state, or nil when no mover accepted the source.
+func runStateMovers(t *testing.T, target resource.ResourceWithMoveState, req resource.MoveStateRequest) *tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	targetSchema := resourceSchema(ctx, target)
+	for _, mover := range target.MoveState(ctx) {
+		// The framework only decodes SourceState for movers that declare a
+		// matching SourceSchema.
+		moverReq := req
+		if mover.SourceSchema == nil {
+			moverReq.SourceState = nil
+		} else if req.SourceState == nil || !req.SourceState.Schema.Type().Equal(mover.SourceSchema.Type()) {
+			continue
+		}
+		resp := resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: targetSchema,
+				Raw:    tftypes.NewValue(targetSchema.Type().TerraformType(ctx), nil),
+			},
+		}
+		mover.StateMover(ctx, moverReq, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		if !resp.TargetState.Raw.IsNull() {
+			return &resp.TargetState
+		}
+	}
+	return nil
+}
+
+// testSourceState returns the state of source holding model.
+func testSourceState(t *testing.T, source resource.Resource, model any) *tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	sourceSchema := resourceSchema(ctx, source)
+	state := tfsdk.State{
+		Schema: sourceSchema,
+		Raw:    tftypes.NewValue(sourceSchema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, model)
+	require.False(t, diags.HasError(), "%v", diags)
+	return &state
+}
+
+func TestMoveStateByID(t *testing.T) {
+	req := resource.MoveStateRequest{
+		SourceTypeName: "groundcover_monitor",
+		SourceRawState: &tfprotov6.RawState{JSON: []byte(`{"id":"monitor-1","monitor_yaml":"title: x"}`)},
+	}
+
+	state := runStateMovers(t, &monitorV2Resource{}, req)
+	require.NotNil(t, state)
+	var id types.String
+	require.False(t, state.GetAttribute(context.Background(), path.Root("id"), &id).HasError())
+	assert.Equal(t, "monitor-1", id.ValueString())
+
+	req.SourceTypeName = "groundcover_dashboard"
+	assert.Nil(t, runStateMovers(t, &monitorV2Resource{}, req), "other resource types must not be accepted")
+}
+
+func TestMoveStateConnectedAppRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	jsonModel := connectedAppJsonResourceModel{
+		Id:              types.StringValue("app-1"),
+		Name:            types.StringValue("slack"),
+		Type:            types.StringValue("slack-webhook"),
+		Data:            types.StringValue(`{"url":"https://hooks.slack.com/services/T/W/U"}`),
+		DataHash:        types.StringValue("hash"),
+		RotationTrigger: types.MapValueMust(types.StringType, map[string]attr.Value{"rotated_at": types.StringValue("2026-01-01")}),
+		CreatedBy:       types.StringValue("user@example.com"),
+		CreatedAt:       types.StringValue("2026-01-01T00:00:00Z"),
+		UpdatedBy:       types.StringNull(),
+		UpdatedAt:       types.StringNull(),
+	}
+
+	dynamicState := runStateMovers(t, &connectedAppResource{}, resource.MoveStateRequest{
+		SourceTypeName: "groundcover_connected_app_json",
+		SourceState:    testSourceState(t, &connectedAppJsonResource{}, &jsonModel),
+	})
+	require.NotNil(t, dynamicState)
+	var dynamicModel connectedAppResourceModel
+	require.False(t, dynamicState.Get(ctx, &dynamicModel).HasError())
+	assert.Equal(t, "app-1", dynamicModel.Id.ValueString())
+	assert.Equal(t, "hash", dynamicModel.DataHash.ValueString())
+	data, diags := dynamicValueToMap(ctx, dynamicModel.Data)
+	require.False(t, diags.HasError())
+	assert.Equal(t, map[string]any{"url": "https://hooks.slack.com/services/T/W/U"}, data)
+
+	jsonState := runStateMovers(t, &connectedAppJsonResource{}, resource.MoveStateRequest{
+		SourceTypeName: "groundcover_connected_app",
+		SourceState:    testSourceState(t, &connectedAppResource{}, &dynamicModel),
+	})
+	require.NotNil(t, jsonState)
+	var roundTripped connectedAppJsonResourceModel
+	require.False(t, jsonState.Get(ctx, &roundTripped).HasError())
+	assert.Equal(t, jsonModel, roundTripped)
+}
+
+func TestMoveStateDataIntegrationRename(t *testing.T) {
+	ctx := context.Background()
+
+	pauseScheduleType := resourceSchema(ctx, &dataIntegrationResource{}).Attributes["pause_schedule"].GetType().(types.ObjectType)
+	model := dataIntegrationResourceModel{
+		ID:              types.StringValue("integration-1"),
+		Type:            types.StringValue("cloudwatch"),
+		Cluster:         types.StringValue("prod"),
+		ClusterSelector: types.MapNull(types.StringType),
+		Config:          jsontypes.NewNormalizedValue(`{"roleArn":"arn:aws:iam::123456789012:role/gc","regions":["us-east-1"]}`),
+		IsPaused:        types.BoolValue(false),
+		PauseSchedule:   types.ObjectNull(pauseScheduleType.AttrTypes),
+		Tags:            types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")}),
+		TagsAll:         types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")}),
+		UpdatedAt:       types.StringValue("2026-01-01T00:00:00Z"),
+		UpdatedBy:       types.StringValue("user@example.com"),
+	}
+
+	renamedState := runStateMovers(t, &dataIntegrationResource{renamed: true}, resource.MoveStateRequest{
+		SourceTypeName: "groundcover_dataintegration",
+		SourceState:    testSourceState(t, &dataIntegrationResource{}, &model),
+	})
+	require.NotNil(t, renamedState)
+	var renamed dataIntegrationResourceModel
+	require.False(t, renamedState.Get(ctx, &renamed).HasError())
+	assert.Equal(t, model, renamed)
+
+	legacyState := runStateMovers(t, &dataIntegrationResource{}, resource.MoveStateRequest{
+		SourceTypeName: "groundcover_data_integration",
+		SourceState:    testSourceState(t, &dataIntegrationResource{renamed: true}, &renamed),
+	})
+	require.NotNil(t, legacyState)
+
+	assert.Nil(t, runStateMovers(t, &dataIntegrationResource{renamed: true}, resource.MoveStateRequest{
+		SourceTypeName: "groundcover_data_integration",
+		SourceState:    testSourceState(t, &dataIntegrationResource{}, &model),
+	}))
+}
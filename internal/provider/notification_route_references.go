@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// routeConnectedAppReference is a connected app a planned route notifies.
+type routeConnectedAppReference struct {
+	path    path.Path
+	appType string
+	id      string
+}
+
+// ModifyPlan checks, when validate_references is enabled, that the connected
+// apps the planned routes notify exist. Only creates and changed routes are
+// checked, so unchanged routes cost no extra API calls.
+func (r *notificationRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan notificationRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.ValidateReferences.ValueBool() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state notificationRouteResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || plan.Routes.Equal(state.Routes) {
+			return
+		}
+	}
+
+	references, diags := routeConnectedAppReferences(ctx, plan.Routes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(references) == 0 {
+		return
+	}
+	r.checkConnectedAppReferences(ctx, references, &resp.Diagnostics)
+}
+
+// routeConnectedAppReferences returns the connected apps of routes whose ID
+// is known. IDs of apps created in the same apply are unknown and skipped.
+func routeConnectedAppReferences(ctx context.Context, routes types.List) ([]routeConnectedAppReference, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if routes.IsNull() || routes.IsUnknown() {
+		return nil, diags
+	}
+
+	var routeModels []routeRuleModel
+	diags.Append(routes.ElementsAs(ctx, &routeModels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var references []routeConnectedAppReference
+	for i, routeModel := range routeModels {
+		if routeModel.ConnectedApps.IsNull() || routeModel.ConnectedApps.IsUnknown() {
+			continue
+		}
+		var appModels []routeConnectedAppModel
+		diags.Append(routeModel.ConnectedApps.ElementsAs(ctx, &appModels, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		for j, appModel := range appModels {
+			if appModel.Id.IsNull() || appModel.Id.IsUnknown() {
+				continue
+			}
+			references = append(references, routeConnectedAppReference{
+				path:    path.Root("routes").AtListIndex(i).AtName("connected_apps").AtListIndex(j).AtName("id"),
+				appType: appModel.Type.ValueString(),
+				id:      appModel.Id.ValueString(),
+			})
+		}
+	}
+	return references, diags
+}
+
+// checkConnectedAppReferences adds an error for each reference to a connected
+// app that does not exist or has another type. A failed lookup is reported as
+// a warning so it never blocks the plan.
+func (r *notificationRouteResource) checkConnectedAppReferences(ctx context.Context, references []routeConnectedAppReference, diags *diag.Diagnostics) {
+	apps, err := r.client.ListConnectedApps(ctx, &models.ListConnectedAppsRequest{})
+	if err != nil {
+		tflog.Warn(ctx, "Failed to list connected apps for reference validation", map[string]any{"error": err.Error()})
+		diags.AddAttributeWarning(path.Root("validate_references"), "Connected App References Not Checked",
+			fmt.Sprintf("Could not list connected apps to check the routes' connected_apps: %s", err.Error()))
+		return
+	}
+
+	appTypes := make(map[string]string, len(apps))
+	for _, app := range apps {
+		if app != nil {
+			appTypes[app.ID] = app.Type
+		}
+	}
+	for _, reference := range references {
+		appType, ok := appTypes[reference.id]
+		switch {
+		case !ok:
+			diags.AddAttributeError(reference.path, "Connected App Not Found",
+				fmt.Sprintf("No connected app has the ID %q, so the API would reject the route. Check the ID, or reference a groundcover_connected_app resource or data source instead of a literal.", reference.id))
+		case reference.appType != "" && appType != reference.appType:
+			diags.AddAttributeError(reference.path, "Connected App Type Mismatch",
+				fmt.Sprintf("Connected app %q is of type %q, but the route sets type %q.", reference.id, appType, reference.appType))
+		}
+	}
+}
@@ -4,18 +4,28 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
 )
 
 var _ provider.Provider = &GroundcoverProvider{}
@@ -24,14 +34,57 @@ var _ provider.Provider = &GroundcoverProvider{}
 type GroundcoverProvider struct {
 	// version is set dynamically by the build process.
 	version string
+	// transport, when set, replaces the default HTTP transport of the API client.
+	transport http.RoundTripper
 }
 
 // GroundcoverProviderModel describes the provider data model.
 type GroundcoverProviderModel struct {
-	ApiKey    types.String `tfsdk:"api_key"`
-	OrgName   types.String `tfsdk:"org_name"` // Kept for backwards compatibility
-	BackendId types.String `tfsdk:"backend_id"`
-	ApiUrl    types.String `tfsdk:"api_url"`
+	ApiKey                         types.String `tfsdk:"api_key"`
+	OrgName                        types.String `tfsdk:"org_name"` // Kept for backwards compatibility
+	BackendId                      types.String `tfsdk:"backend_id"`
+	ApiUrl                         types.String `tfsdk:"api_url"`
+	DefaultCluster                 types.String `tfsdk:"default_cluster"`
+	PrefetchMonitors               types.Bool   `tfsdk:"prefetch_monitors"`
+	CompressRequests               types.Bool   `tfsdk:"compress_requests"`
+	AutoRetryOnConflict            types.Bool   `tfsdk:"auto_retry_on_conflict"`
+	FailOnReadOnlyChanges          types.Bool   `tfsdk:"fail_on_read_only_changes"`
+	ExpiringCredentialsWarningDays types.Int64  `tfsdk:"expiring_credentials_warning_days"`
+	ApiTelemetryFile               types.String `tfsdk:"api_telemetry_file"`
+	RequestTimeout                 types.String `tfsdk:"request_timeout"`
+	RequiredMonitorLabels          types.List   `tfsdk:"required_monitor_labels"`
+	DefaultTags                    types.Map    `tfsdk:"default_tags"`
+	ReadOnly                       types.Bool   `tfsdk:"read_only"`
+	ExtraHeaders                   types.Map    `tfsdk:"extra_headers"`
+
+	Features        *providerFeaturesModel        `tfsdk:"features"`
+	MonitorDefaults *providerMonitorDefaultsModel `tfsdk:"monitor_defaults"`
+}
+
+// providerClient is handed to resources as ProviderData. It embeds the API client,
+// so the ApiClient assertion in each resource's Configure keeps working, and carries
+// provider-level defaults for the resources that consult them.
+type providerClient struct {
+	ApiClient
+
+	// defaultCluster fills in `cluster` on resources that leave it unset.
+	defaultCluster string
+	// autoRetryOnConflict retries revision-conflicted updates against the latest revision.
+	autoRetryOnConflict bool
+	// failOnReadOnlyChanges turns planned changes to read-only objects into errors instead of skipped changes.
+	failOnReadOnlyChanges bool
+	// expiringCredentialsWarningDays is the look-ahead for API key expiry warnings; 0 disables them.
+	expiringCredentialsWarningDays int64
+	// requiredMonitorLabels are label keys every created or updated monitor must set.
+	requiredMonitorLabels []string
+	// defaultTags are added to the tags or labels of every dashboard, monitor and data integration.
+	defaultTags map[string]string
+	// monitorDefaults are added to the YAML of monitors that do not set them, keyed by YAML path.
+	monitorDefaults map[string]string
+	// notificationRoutes caches the notification route list for resources that match against it.
+	notificationRoutes *notificationRouteCache
+	// features are the behavior toggles from the features block.
+	features providerFeatures
 }
 
 func (p *GroundcoverProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -60,6 +113,72 @@ func (p *GroundcoverProvider) Schema(ctx context.Context, req provider.SchemaReq
 				MarkdownDescription: "groundcover API URL. Defaults to the groundcover production URL. Can also be set via the GROUNDCOVER_API_URL environment variable.",
 				Optional:            true,
 			},
+			"default_cluster": schema.StringAttribute{
+				MarkdownDescription: "Default cluster for resources that accept an optional `cluster` (currently `groundcover_data_integration`). Used when such a resource is created without `cluster` or `cluster_selector`; existing resources created in the default keep it, so setting or changing the default never moves or replaces them, while removing `cluster` from a resource still replaces it. Can also be set via the GROUNDCOVER_DEFAULT_CLUSTER environment variable.",
+				Optional:            true,
+			},
+			"prefetch_monitors": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the first monitor refresh of a run lists every monitor in the workspace, including those Terraform does not manage, fetches their YAML in parallel, and serves the other monitor refreshes from that cache. This speeds up refresh when the configuration manages most of the workspace's monitors, but slows down a small configuration or a `-target` run in a large workspace. Reads during create and update are not affected. Defaults to `false`.",
+				Optional:            true,
+			},
+			"compress_requests": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, request bodies of 1 KiB or more (e.g. large dashboard presets and monitor definitions) are sent gzip-compressed. Responses are always requested and decoded with gzip. Defaults to `false`.",
+				Optional:            true,
+			},
+			"auto_retry_on_conflict": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, a `groundcover_policy` update rejected because the policy was changed elsewhere (a stale revision) is retried: the provider re-reads the policy, skips the update if it already matches the configuration, and otherwise re-sends it against the latest revision. A warning replaces the error. `groundcover_dashboard` updates always override the stored revision and are never rejected this way. Defaults to `false`.",
+				Optional:            true,
+			},
+			"fail_on_read_only_changes": schema.BoolAttribute{
+				MarkdownDescription: "Controls planned changes to objects the API does not let Terraform modify, such as a `groundcover_policy` that is `read_only` or `is_system_defined`. By default such an update or destroy plans with a warning, and at apply the API call is skipped: updates are recorded in state only and destroys only remove the object from state. When `true`, the plan fails with an error instead. Defaults to `false`.",
+				Optional:            true,
+			},
+			"expiring_credentials_warning_days": schema.Int64Attribute{
+				MarkdownDescription: "When set, refreshing a `groundcover_apikey` that expires within this many days emits a warning with the key name and expiry date, so upcoming rotations show up in every plan. Defaults to `0` (no warnings).",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"api_telemetry_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a JSON file in which the provider keeps API request statistics for the current Terraform run: total requests, retries, and rate-limited (`429`) responses, plus per-endpoint request counts and p95/max latency. The file is rewritten after every request, so after `terraform apply` it describes the apply. Use it to measure the API load a configuration generates and to tune parallelism. Can also be set via the GROUNDCOVER_API_TELEMETRY_FILE environment variable. Unset by default.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long a single API call may take, including its retries, as a duration such as `\"60s\"` or `\"5m\"`. A call that runs longer is cancelled and fails with a timeout error. Can also be set via the GROUNDCOVER_REQUEST_TIMEOUT environment variable. Defaults to `\"120s\"`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.DurationString(),
+				},
+			},
+			"required_monitor_labels": schema.ListAttribute{
+				MarkdownDescription: "Label keys that every `groundcover_monitor`, `groundcover_monitor_v2`, and `groundcover_monitor_v2_json` must set to a non-empty value. A monitor that is created or updated without one of them fails at plan time; monitors the plan leaves unchanged are not checked. For `groundcover_monitor` the keys are looked up in the YAML's top-level `labels`. Example: `[\"team\", \"service\"]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"default_tags": schema.MapAttribute{
+				MarkdownDescription: "Tags added to every `groundcover_dashboard`, `groundcover_monitor`, and `groundcover_data_integration`, for inventory and cost attribution across resource types. Monitors receive them as `labels`, data integrations as `tags`, and dashboards as `key:value` tags. A key the resource sets itself takes precedence. The tags a resource ends up with are exposed as its computed `tags_all` (`labels_all` for monitors). Example: `{ team = \"platform\", cost_center = \"1234\" }`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, every API call that would create, update, or delete an object fails with an error without being sent, while refreshes, plans, imports, and data sources keep working. Use it to run plans with credentials that must not mutate the workspace, such as in untrusted CI or during game days. Can also be set via the GROUNDCOVER_READ_ONLY environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "HTTP headers added to every API request, for gateways or proxies in front of the API that require their own headers. Example: `{ \"X-Internal-Auth\" = var.gateway_token }`. " +
+					"A header that the provider itself sends, such as `User-Agent`, is replaced. `Authorization`, `X-Backend-Id`, `Content-Type`, `Content-Encoding`, `Content-Length`, and `Host` cannot be set. Use `api_key` and `backend_id` for the credentials.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"features":         providerFeaturesBlock(),
+			"monitor_defaults": providerMonitorDefaultsBlock(),
 		},
 	}
 }
@@ -107,6 +226,14 @@ func (p *GroundcoverProvider) Configure(ctx context.Context, req provider.Config
 		apiUrl = normalizeAPIURL(apiUrl)
 	}
 
+	defaultCluster := os.Getenv("GROUNDCOVER_DEFAULT_CLUSTER")
+	if !config.DefaultCluster.IsNull() {
+		defaultCluster = config.DefaultCluster.ValueString()
+	}
+
+	features, diags := providerFeaturesFromModel(config.Features)
+	resp.Diagnostics.Append(diags...)
+
 	if apiKey == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
@@ -131,7 +258,76 @@ func (p *GroundcoverProvider) Configure(ctx context.Context, req provider.Config
 	}
 
 	tflog.Info(ctx, "Initializing Groundcover SDK client", map[string]any{"backend_id": orgName, "api_url": apiUrl})
-	clientWrapper, err := NewSdkClientWrapper(ctx, apiUrl, apiKey, orgName)
+	var clientOpts []sdkClientOption
+	if config.PrefetchMonitors.ValueBool() {
+		clientOpts = append(clientOpts, withMonitorPrefetch())
+	}
+	if config.CompressRequests.ValueBool() {
+		clientOpts = append(clientOpts, withRequestCompression())
+	}
+	apiTelemetryFile := os.Getenv("GROUNDCOVER_API_TELEMETRY_FILE")
+	if !config.ApiTelemetryFile.IsNull() {
+		apiTelemetryFile = config.ApiTelemetryFile.ValueString()
+	}
+	if apiTelemetryFile != "" {
+		clientOpts = append(clientOpts, withAPITelemetry(apiTelemetryFile))
+	}
+
+	requestTimeout := os.Getenv("GROUNDCOVER_REQUEST_TIMEOUT")
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = config.RequestTimeout.ValueString()
+	}
+	if requestTimeout != "" {
+		timeout, err := strfmt.ParseDuration(requestTimeout)
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("The request timeout %q must be a positive duration such as \"60s\" or \"5m\".", requestTimeout),
+			)
+			return
+		}
+		clientOpts = append(clientOpts, withRequestTimeout(timeout))
+	}
+
+	readOnly := false
+	if envReadOnly := os.Getenv("GROUNDCOVER_READ_ONLY"); envReadOnly != "" {
+		parsed, err := strconv.ParseBool(envReadOnly)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_only"),
+				"Invalid GROUNDCOVER_READ_ONLY Value",
+				fmt.Sprintf("GROUNDCOVER_READ_ONLY must be \"true\" or \"false\", got %q.", envReadOnly),
+			)
+			return
+		}
+		readOnly = parsed
+	}
+	if !config.ReadOnly.IsNull() {
+		readOnly = config.ReadOnly.ValueBool()
+	}
+	if readOnly {
+		tflog.Info(ctx, "Provider is read-only: create, update, and delete calls will fail")
+		clientOpts = append(clientOpts, withReadOnly())
+	}
+
+	if !config.ExtraHeaders.IsNull() && !config.ExtraHeaders.IsUnknown() {
+		extraHeaders := map[string]string{}
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+		if err := validateExtraHeaders(extraHeaders); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("extra_headers"), "Invalid Extra Header", err.Error())
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		clientOpts = append(clientOpts, withExtraHeaders(extraHeaders))
+	}
+
+	if p.transport != nil {
+		clientOpts = append(clientOpts, withTransport(p.transport))
+	}
+
+	clientWrapper, err := NewSdkClientWrapper(ctx, apiUrl, apiKey, orgName, clientOpts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to Create API Client Wrapper",
@@ -140,8 +336,37 @@ func (p *GroundcoverProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	resp.DataSourceData = clientWrapper
-	resp.ResourceData = clientWrapper
+	var requiredMonitorLabels []string
+	if !config.RequiredMonitorLabels.IsNull() && !config.RequiredMonitorLabels.IsUnknown() {
+		resp.Diagnostics.Append(config.RequiredMonitorLabels.ElementsAs(ctx, &requiredMonitorLabels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var defaultTags map[string]string
+	if !config.DefaultTags.IsNull() && !config.DefaultTags.IsUnknown() {
+		resp.Diagnostics.Append(config.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	client := &providerClient{
+		ApiClient:                      clientWrapper,
+		defaultCluster:                 defaultCluster,
+		autoRetryOnConflict:            config.AutoRetryOnConflict.ValueBool(),
+		failOnReadOnlyChanges:          config.FailOnReadOnlyChanges.ValueBool(),
+		expiringCredentialsWarningDays: config.ExpiringCredentialsWarningDays.ValueInt64(),
+		requiredMonitorLabels:          requiredMonitorLabels,
+		defaultTags:                    defaultTags,
+		monitorDefaults:                monitorDefaultsFromModel(config.MonitorDefaults),
+		notificationRoutes:             &notificationRouteCache{},
+		features:                       features,
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
 
 	tflog.Info(ctx, "Groundcover provider configured successfully")
 }
@@ -149,6 +374,7 @@ func (p *GroundcoverProvider) Configure(ctx context.Context, req provider.Config
 func (p *GroundcoverProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewPolicyResource,
+		NewPolicyBundleResource,
 		NewServiceAccountResource,
 		NewMonitorResource,
 		NewMonitorV2Resource,
@@ -160,20 +386,37 @@ func (p *GroundcoverProvider) Resources(ctx context.Context) []func() resource.R
 		NewIngestionKeyResource,
 		NewDashboardResource,
 		NewDataIntegrationResource,
+		NewDeprecatedDataIntegrationResource,
 		NewSecretResource,
 		NewSilenceResource,
+		NewAlertingSuspensionResource,
 		NewRecurringSilenceResource,
 		NewConnectedAppResource,
 		NewConnectedAppJsonResource,
+		NewIncidentWebhookResource,
 		NewNotificationRouteResource,
 		NewSyntheticTestResource,
 		NewTracesPipelineResource,
 		NewSkillResource,
+		NewRetentionPolicyResource,
+		NewWorkspaceSettingsResource,
 	}
 }
 
 func (p *GroundcoverProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewAlertRoutingDataSource,
+		NewAlertmanagerConfigDataSource,
+		NewApiKeyDataSource,
+		NewConnectedAppDataSource,
+		NewConnectedAppUsageDataSource,
+		NewDashboardsDataSource,
+		NewDashboardsExportDataSource,
+		NewMonitorsExportDataSource,
+		NewIngestionKeysDataSource,
+		NewPrometheusRuleDataSource,
+		NewQueryValidationDataSource,
+	}
 }
 
 // CloseEphemeralResource closes an opened ephemeral resource.
@@ -190,6 +433,19 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// NewWithTransport is New with the API client's requests sent through
+// transport, for tests that intercept requests without a network. The
+// provider still adds authentication and retries; the configured api_url only
+// determines the request URLs.
+func NewWithTransport(version string, transport http.RoundTripper) func() provider.Provider {
+	return func() provider.Provider {
+		return &GroundcoverProvider{
+			version:   version,
+			transport: transport,
+		}
+	}
+}
+
 // normalizeAPIURL ensures the API URL has a proper scheme and format
 func normalizeAPIURL(apiUrl string) string {
 	apiUrl = strings.TrimSpace(apiUrl)
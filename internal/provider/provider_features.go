@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// providerFeaturesModel is the provider's features block.
+type providerFeaturesModel struct {
+	SuppressMonitorFormattingDrift types.Bool `tfsdk:"suppress_monitor_formatting_drift"`
+	DeleteProtectionDefault        types.Bool `tfsdk:"delete_protection_default"`
+	AbandonOnDestroyDefault        types.Bool `tfsdk:"abandon_on_destroy_default"`
+}
+
+// providerFeatures holds the provider-wide behavior toggles resources consult.
+// The zero value is the behavior without a features block.
+type providerFeatures struct {
+	// planMonitorReformatting plans a configured monitor_yaml that was only
+	// reformatted as an update; the inverse of suppress_monitor_formatting_drift.
+	planMonitorReformatting bool
+	// deleteProtectionDefault refuses to destroy resources that leave
+	// destroy_behavior unset.
+	deleteProtectionDefault bool
+	// abandonOnDestroyDefault abandons resources that leave destroy_behavior
+	// unset instead of deleting them.
+	abandonOnDestroyDefault bool
+}
+
+func providerFeaturesBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Provider-wide defaults for resource behavior, so an organization can set a policy once instead of on every resource.",
+		Attributes: map[string]schema.Attribute{
+			"suppress_monitor_formatting_drift": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, a change to `groundcover_monitor` `monitor_yaml` that only reformats it (whitespace, key order, quoting) is not planned as an update. Set to `false` for state to follow the configured text exactly; each reformat is then applied as an update. Defaults to `true`.",
+				Optional:            true,
+			},
+			"delete_protection_default": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, planning to destroy a `groundcover_dashboard` or `groundcover_monitor` that leaves `destroy_behavior` unset fails. Set `destroy_behavior` on the resource, and apply it, to destroy it. Cannot be combined with `abandon_on_destroy_default`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"abandon_on_destroy_default": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, `destroy_behavior` defaults to `\"abandon\"` instead of `\"delete\"`, so destroying a `groundcover_dashboard` or `groundcover_monitor` that leaves it unset only removes it from state. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// providerFeaturesFromModel returns the features configured in model, with
+// defaults for the unset ones. A nil model is an omitted features block.
+func providerFeaturesFromModel(model *providerFeaturesModel) (providerFeatures, diag.Diagnostics) {
+	var features providerFeatures
+	var diags diag.Diagnostics
+	if model == nil {
+		return features, diags
+	}
+
+	features.planMonitorReformatting = !model.SuppressMonitorFormattingDrift.IsNull() && !model.SuppressMonitorFormattingDrift.ValueBool()
+	features.deleteProtectionDefault = model.DeleteProtectionDefault.ValueBool()
+	features.abandonOnDestroyDefault = model.AbandonOnDestroyDefault.ValueBool()
+	if features.deleteProtectionDefault && features.abandonOnDestroyDefault {
+		diags.AddAttributeError(
+			path.Root("features").AtName("delete_protection_default"),
+			"Conflicting Provider Features",
+			"delete_protection_default and abandon_on_destroy_default cannot both be true: with abandon_on_destroy_default, destroying a resource no longer deletes it.",
+		)
+	}
+	return features, diags
+}
+
+// destroyBehavior returns configured, or the default destroy_behavior the
+// features select when configured is unset.
+func (f providerFeatures) destroyBehavior(configured types.String) types.String {
+	if configured.ValueString() == "" && f.abandonOnDestroyDefault {
+		return types.StringValue(destroyBehaviorAbandon)
+	}
+	return configured
+}
+
+// checkDeleteProtection fails a planned destroy of a resource that leaves
+// destroy_behavior unset when delete_protection_default is enabled.
+func (f providerFeatures) checkDeleteProtection(ctx context.Context, req resource.ModifyPlanRequest, kind string, diags *diag.Diagnostics) {
+	if !f.deleteProtectionDefault || !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var destroyBehavior types.String
+	diags.Append(req.State.GetAttribute(ctx, path.Root("destroy_behavior"), &destroyBehavior)...)
+	if diags.HasError() || destroyBehavior.ValueString() != "" {
+		return
+	}
+	var id types.String
+	diags.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	diags.AddError(
+		fmt.Sprintf("%s Protected From Deletion", kind),
+		fmt.Sprintf("The provider's delete_protection_default is enabled and %s %s does not set destroy_behavior. Set destroy_behavior to \"delete\" or \"abandon\" and apply it before destroying.", kind, id.ValueString()),
+	)
+}
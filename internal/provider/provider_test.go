@@ -3,24 +3,51 @@
 package provider
 
 import (
+	"context"
+	"net/http"
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
 // The factory function is called for each Terraform CLI command to create a provider
-// server that the CLI can connect to and interact with.
+// server that the CLI can connect to and interact with. It goes through the same mux
+// server as the released binary.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"groundcover": providerserver.NewProtocol6WithError(New("test")()),
+	"groundcover": func() (tfprotov6.ProviderServer, error) {
+		newServer, err := NewProviderServer(context.Background(), "test")
+		if err != nil {
+			return nil, err
+		}
+		return newServer(), nil
+	},
+}
+
+// TestMain enables the acceptance tests in mock API mode, which needs no
+// credentials, so they run without TF_ACC.
+func TestMain(m *testing.M) {
+	if mockAPIEnabled() && os.Getenv("TF_ACC") == "" {
+		_ = os.Setenv("TF_ACC", "1")
+	}
+	os.Exit(m.Run())
 }
 
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
+	if mockAPIEnabled() {
+		useMockAPI(t)
+		return
+	}
 	if v := os.Getenv("GROUNDCOVER_API_KEY"); v == "" {
 		t.Fatal("GROUNDCOVER_API_KEY must be set for acceptance tests")
 	}
@@ -96,3 +123,31 @@ func TestNormalizeAPIURL(t *testing.T) {
 		})
 	}
 }
+
+func TestNewWithTransportConfiguresClientWithTransport(t *testing.T) {
+	ctx := context.Background()
+	m := newMockAPI(t)
+	p := NewWithTransport("test", m.transport())()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	// The config is built through a plan, which fills in the unset attributes.
+	config := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	for name, value := range map[string]string{
+		"api_key":    mockAPIKey,
+		"backend_id": mockAPIBackendID,
+		"api_url":    "https://api.groundcover.invalid",
+	} {
+		require.False(t, config.SetAttribute(ctx, path.Root(name), value).HasError())
+	}
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	client, ok := resp.ResourceData.(*providerClient)
+	require.True(t, ok)
+
+	_, err := client.ListPolicies(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, m.requestCount(http.MethodGet, "/api/rbac/policies/list"))
+}
@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// alertingSuspensionID is the id of the groundcover_alerting_suspension
+	// singleton.
+	alertingSuspensionID = "alerting_suspension"
+
+	// alertingSuspensionMatcherName and alertingSuspensionMatcherValue form the
+	// one matcher of the suspension's silence: a negative match on a value no
+	// alert has, so the silence matches every alert.
+	alertingSuspensionMatcherName  = "alertname"
+	alertingSuspensionMatcherValue = "groundcover-alerting-suspension"
+
+	// alertingSuspensionIndefinite is how long a suspension without
+	// auto_expire lasts; the API requires every silence to end.
+	alertingSuspensionIndefinite = 10 * 365 * 24 * time.Hour
+)
+
+var (
+	_ resource.Resource                   = &alertingSuspensionResource{}
+	_ resource.ResourceWithConfigure      = &alertingSuspensionResource{}
+	_ resource.ResourceWithImportState    = &alertingSuspensionResource{}
+	_ resource.ResourceWithValidateConfig = &alertingSuspensionResource{}
+)
+
+func NewAlertingSuspensionResource() resource.Resource {
+	return &alertingSuspensionResource{}
+}
+
+// alertingSuspensionResource suspends all alert notifications of the tenant
+// with a silence that matches every alert.
+type alertingSuspensionResource struct {
+	client ApiClient
+}
+
+type alertingSuspensionResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Reason     types.String `tfsdk:"reason"`
+	AutoExpire types.String `tfsdk:"auto_expire"`
+	SilenceID  types.String `tfsdk:"silence_id"`
+	StartsAt   types.String `tfsdk:"starts_at"`
+	EndsAt     types.String `tfsdk:"ends_at"`
+	Active     types.Bool   `tfsdk:"active"`
+}
+
+func (r *alertingSuspensionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alerting_suspension"
+}
+
+func (r *alertingSuspensionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Break-glass suspension of all alert notifications across the tenant, for major incidents. Declare at most one per tenant and keep it in configuration with ` + "`enabled = false`" + `, so that suspending alerting, and restoring it, is a one-line change that a single commit, or its revert, applies.
+
+While enabled, the provider keeps a silence in groundcover that matches every alert: a matcher ` + "`alertname != \"" + alertingSuspensionMatcherValue + "\"`" + `. Monitors keep evaluating and alerts remain visible in groundcover; only their notifications are suppressed. Disabling the suspension, or destroying the resource, deletes the silence.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `" + alertingSuspensionID + "`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether alert notifications are suspended. Setting it to `true` starts a suspension, and setting it to `false` ends it. If the suspension's silence is expired or deleted outside Terraform before it is due to end, refresh sets `enabled` to `false`, so the next apply suspends alerting again.",
+				Required:            true,
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Why alerting is suspended, such as an incident link. Shown as the comment of the silence.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"auto_expire": schema.StringAttribute{
+				MarkdownDescription: "How long a suspension lasts once enabled, as a Go duration such as `4h` or `90m`. When it passes, notifications resume without an apply and `active` becomes `false`; changing `reason` or `auto_expire` afterwards starts a new suspension. Changing it during a suspension moves the end of that suspension. When unset, the suspension lasts until `enabled` is set to `false`.",
+				Optional:            true,
+			},
+			"silence_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the silence that implements the current suspension, or null when none exists.",
+				Computed:            true,
+			},
+			"starts_at": schema.StringAttribute{
+				MarkdownDescription: "When the current suspension started, in RFC3339 format.",
+				Computed:            true,
+			},
+			"ends_at": schema.StringAttribute{
+				MarkdownDescription: "When the current suspension ends, in RFC3339 format.",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether notifications were suspended when the resource was last applied or refreshed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *alertingSuspensionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var autoExpire types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auto_expire"), &autoExpire)...)
+	if resp.Diagnostics.HasError() || autoExpire.IsNull() || autoExpire.IsUnknown() {
+		return
+	}
+	if _, err := alertingSuspensionDuration(autoExpire); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("auto_expire"), "Invalid auto_expire", err.Error())
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *alertingSuspensionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *alertingSuspensionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertingSuspensionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(alertingSuspensionID)
+	clearAlertingSuspensionSilence(&plan)
+	if plan.Enabled.ValueBool() {
+		if err := r.suspend(ctx, &plan, time.Now()); err != nil {
+			resp.Diagnostics.AddError("Error suspending alerting", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *alertingSuspensionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertingSuspensionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.SilenceID.IsNull() || state.SilenceID.ValueString() == "" {
+		state.Active = types.BoolValue(false)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	silence, err := r.client.GetSilence(ctx, state.SilenceID.ValueString())
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		resp.Diagnostics.AddError("Error reading alerting suspension", fmt.Sprintf("Failed to read silence %s: %s", state.SilenceID.ValueString(), err))
+		return
+	}
+	if errors.Is(err, ErrNotFound) {
+		silence = nil
+	}
+	if silence != nil && !isAlertingSuspensionSilence(silence) {
+		resp.Diagnostics.AddError(
+			"Not an alerting suspension",
+			fmt.Sprintf("Silence %s does not have the single matcher %s != %q of an alerting suspension, so it does not suspend every alert. Import only a silence that a groundcover_alerting_suspension created.",
+				state.SilenceID.ValueString(), alertingSuspensionMatcherName, alertingSuspensionMatcherValue),
+		)
+		return
+	}
+	if readAlertingSuspensionSilence(&state, silence, time.Now()) {
+		tflog.Warn(ctx, "Alerting suspension ended outside Terraform", map[string]any{"silence_id": state.SilenceID.ValueString()})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *alertingSuspensionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state alertingSuspensionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+	plan.ID = types.StringValue(alertingSuspensionID)
+	plan.SilenceID, plan.StartsAt, plan.EndsAt, plan.Active = state.SilenceID, state.StartsAt, state.EndsAt, state.Active
+	switch {
+	case !plan.Enabled.ValueBool():
+		if err := r.resume(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("Error resuming alerting", err.Error())
+			return
+		}
+	case !alertingSuspensionLive(state, now):
+		// The previous suspension, if any, has ended: start a new one.
+		clearAlertingSuspensionSilence(&plan)
+		if err := r.suspend(ctx, &plan, now); err != nil {
+			resp.Diagnostics.AddError("Error suspending alerting", err.Error())
+			return
+		}
+	default:
+		if err := r.updateSuspension(ctx, &plan, now); err != nil {
+			resp.Diagnostics.AddError("Error updating alerting suspension", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *alertingSuspensionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertingSuspensionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.resume(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("Error resuming alerting", err.Error())
+	}
+}
+
+// ImportState imports an alerting suspension by the ID of its silence. Read
+// rejects a silence that is not a suspension's.
+func (r *alertingSuspensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), alertingSuspensionID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("silence_id"), req.ID)...)
+}
+
+// suspend creates the silence of a new suspension starting at now.
+func (r *alertingSuspensionResource) suspend(ctx context.Context, model *alertingSuspensionResourceModel, now time.Time) error {
+	duration, err := alertingSuspensionDuration(model.AutoExpire)
+	if err != nil {
+		return err
+	}
+	startsAt := strfmt.DateTime(now.UTC().Truncate(time.Second))
+	endsAt := strfmt.DateTime(time.Time(startsAt).Add(duration))
+
+	tflog.Warn(ctx, "Suspending all alert notifications", map[string]any{"reason": model.Reason.ValueString(), "ends_at": endsAt.String()})
+	silence, err := r.client.CreateSilence(ctx, &models.CreateSilenceRequest{
+		StartsAt: &startsAt,
+		EndsAt:   &endsAt,
+		Comment:  model.Reason.ValueString(),
+		Matchers: alertingSuspensionMatchers(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create the suspension silence: %w", err)
+	}
+	if silence == nil || silence.UUID.String() == "" {
+		return fmt.Errorf("create response missing silence ID")
+	}
+	setAlertingSuspensionSilence(model, silence, now)
+	return nil
+}
+
+// updateSuspension updates the reason and end of the live suspension.
+func (r *alertingSuspensionResource) updateSuspension(ctx context.Context, model *alertingSuspensionResourceModel, now time.Time) error {
+	duration, err := alertingSuspensionDuration(model.AutoExpire)
+	if err != nil {
+		return err
+	}
+	startsAt, err := parseRFC3339(model.StartsAt.ValueString())
+	if err != nil {
+		return fmt.Errorf("invalid starts_at in state: %w", err)
+	}
+	endsAt := strfmt.DateTime(time.Time(startsAt).Add(duration))
+	if !time.Time(endsAt).After(now) {
+		return fmt.Errorf("with auto_expire %q the suspension that started at %s would already have ended; set enabled = false to end it", model.AutoExpire.ValueString(), model.StartsAt.ValueString())
+	}
+
+	silence, err := r.client.UpdateSilence(ctx, model.SilenceID.ValueString(), &models.UpdateSilenceRequest{
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+		Comment:  model.Reason.ValueString(),
+		Matchers: alertingSuspensionMatchers(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update silence %s: %w", model.SilenceID.ValueString(), err)
+	}
+	if silence != nil && silence.UUID.String() != "" {
+		setAlertingSuspensionSilence(model, silence, now)
+	}
+	return nil
+}
+
+// resume deletes the silence of the suspension, if any.
+func (r *alertingSuspensionResource) resume(ctx context.Context, model *alertingSuspensionResourceModel) error {
+	if silenceID := model.SilenceID.ValueString(); silenceID != "" {
+		tflog.Info(ctx, "Resuming alert notifications", map[string]any{"silence_id": silenceID})
+		if err := r.client.DeleteSilence(ctx, silenceID); err != nil && !errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("failed to delete silence %s: %w", silenceID, err)
+		}
+	}
+	clearAlertingSuspensionSilence(model)
+	return nil
+}
+
+// readAlertingSuspensionSilence refreshes model from the suspension's silence,
+// nil when it no longer exists. It reports whether the suspension ended before
+// ends_at in state, which it records by disabling the suspension.
+func readAlertingSuspensionSilence(model *alertingSuspensionResourceModel, silence *models.Silence, now time.Time) bool {
+	scheduledEnd, err := parseRFC3339(model.EndsAt.ValueString())
+	endedEarly := func(end time.Time) bool {
+		return err == nil && end.Before(time.Time(scheduledEnd).Add(-time.Second)) && !end.After(now)
+	}
+
+	if silence == nil {
+		ended := endedEarly(now)
+		if model.Enabled.IsNull() || ended {
+			model.Enabled = types.BoolValue(false)
+		}
+		clearAlertingSuspensionSilence(model)
+		return ended
+	}
+
+	ended := endedEarly(time.Time(silence.EndsAt))
+	setAlertingSuspensionSilence(model, silence, now)
+	model.Reason = types.StringValue(silence.Comment)
+	if model.Enabled.IsNull() {
+		// Imported: the suspension is enabled while its silence lasts.
+		model.Enabled = model.Active
+	} else if ended {
+		model.Enabled = types.BoolValue(false)
+	}
+	return ended
+}
+
+func setAlertingSuspensionSilence(model *alertingSuspensionResourceModel, silence *models.Silence, now time.Time) {
+	model.SilenceID = types.StringValue(silence.UUID.String())
+	model.StartsAt = types.StringValue(time.Time(silence.StartsAt).UTC().Format(time.RFC3339))
+	model.EndsAt = types.StringValue(time.Time(silence.EndsAt).UTC().Format(time.RFC3339))
+	model.Active = types.BoolValue(!silenceExpired(silence.EndsAt, now))
+}
+
+func clearAlertingSuspensionSilence(model *alertingSuspensionResourceModel) {
+	model.SilenceID = types.StringNull()
+	model.StartsAt = types.StringNull()
+	model.EndsAt = types.StringNull()
+	model.Active = types.BoolValue(false)
+}
+
+// alertingSuspensionLive reports whether state holds a suspension that has not
+// ended at now.
+func alertingSuspensionLive(state alertingSuspensionResourceModel, now time.Time) bool {
+	if state.SilenceID.ValueString() == "" {
+		return false
+	}
+	endsAt, err := parseRFC3339(state.EndsAt.ValueString())
+	return err == nil && !silenceExpired(endsAt, now)
+}
+
+// alertingSuspensionDuration returns how long a suspension lasts.
+func alertingSuspensionDuration(autoExpire types.String) (time.Duration, error) {
+	if autoExpire.IsNull() || autoExpire.IsUnknown() {
+		return alertingSuspensionIndefinite, nil
+	}
+	duration, err := time.ParseDuration(autoExpire.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("auto_expire must be a duration such as \"4h\" or \"90m\": %w", err)
+	}
+	if duration < time.Minute {
+		return 0, fmt.Errorf("auto_expire must be at least 1m, got %q", autoExpire.ValueString())
+	}
+	return duration, nil
+}
+
+// alertingSuspensionMatchers returns the matchers of the suspension's silence,
+// which match every alert.
+func alertingSuspensionMatchers() models.Matchers {
+	isEqual, isRegex := false, false
+	return models.Matchers{{
+		Name:    alertingSuspensionMatcherName,
+		Value:   alertingSuspensionMatcherValue,
+		IsEqual: &isEqual,
+		IsRegex: &isRegex,
+	}}
+}
+
+// isAlertingSuspensionSilence reports whether silence has exactly the matchers
+// of alertingSuspensionMatchers.
+func isAlertingSuspensionSilence(silence *models.Silence) bool {
+	if len(silence.Matchers) != 1 || silence.Matchers[0] == nil {
+		return false
+	}
+	matcher := silence.Matchers[0]
+	return matcher.Name == alertingSuspensionMatcherName &&
+		matcher.Value == alertingSuspensionMatcherValue &&
+		matcher.IsEqual != nil && !*matcher.IsEqual &&
+		(matcher.IsRegex == nil || !*matcher.IsRegex)
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertingSuspensionLifecycle(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	r := &alertingSuspensionResource{client: client}
+	now := time.Date(2030, 1, 15, 10, 0, 0, 0, time.UTC)
+	model := alertingSuspensionResourceModel{
+		Enabled:    types.BoolValue(true),
+		Reason:     types.StringValue("INC-1234"),
+		AutoExpire: types.StringValue("4h"),
+	}
+
+	require.NoError(t, r.suspend(ctx, &model, now))
+	require.Len(t, m.silences, 1)
+	silence := m.silences[model.SilenceID.ValueString()]
+	assert.Equal(t, "INC-1234", silence.Comment)
+	assert.Equal(t, alertingSuspensionMatchers(), silence.Matchers)
+	assert.False(t, *silence.Matchers[0].IsEqual, "the matcher must be negative to match every alert")
+	assert.Equal(t, "2030-01-15T10:00:00Z", model.StartsAt.ValueString())
+	assert.Equal(t, "2030-01-15T14:00:00Z", model.EndsAt.ValueString())
+	assert.True(t, model.Active.ValueBool())
+
+	// Extending auto_expire moves the end of the live suspension.
+	model.AutoExpire = types.StringValue("8h")
+	require.NoError(t, r.updateSuspension(ctx, &model, now.Add(time.Hour)))
+	assert.Equal(t, "2030-01-15T18:00:00Z", model.EndsAt.ValueString())
+
+	model.AutoExpire = types.StringValue("30m")
+	assert.ErrorContains(t, r.updateSuspension(ctx, &model, now.Add(time.Hour)), "would already have ended")
+
+	require.NoError(t, r.resume(ctx, &model))
+	assert.Empty(t, m.silences)
+	assert.True(t, model.SilenceID.IsNull())
+	assert.False(t, model.Active.ValueBool())
+
+	// Resuming again is a no-op.
+	require.NoError(t, r.resume(ctx, &model))
+}
+
+func TestAlertingSuspensionImportRejectsOtherSilences(t *testing.T) {
+	ctx := context.Background()
+	startsAt := strfmt.DateTime(time.Now().UTC().Truncate(time.Second))
+	endsAt := strfmt.DateTime(time.Time(startsAt).Add(time.Hour))
+	isEqual, isRegex := true, false
+
+	tests := []struct {
+		name     string
+		matchers models.Matchers
+		wantErr  bool
+	}{
+		{name: "suspension", matchers: alertingSuspensionMatchers()},
+		{name: "ordinary silence", matchers: models.Matchers{{Name: "service", Value: "checkout", IsEqual: &isEqual, IsRegex: &isRegex}}, wantErr: true},
+		{name: "narrowed suspension", matchers: append(alertingSuspensionMatchers(), &models.SilenceMatcher{Name: "service", Value: "checkout", IsEqual: &isEqual, IsRegex: &isRegex}), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, client := newMockAPIClient(t)
+			silence, err := client.CreateSilence(ctx, &models.CreateSilenceRequest{StartsAt: &startsAt, EndsAt: &endsAt, Comment: "INC-1234", Matchers: tt.matchers})
+			require.NoError(t, err)
+
+			r := &alertingSuspensionResource{client: client}
+			s := resourceSchema(ctx, r)
+			imported := resource.ImportStateResponse{State: tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: silence.UUID.String()}, &imported)
+			require.False(t, imported.Diagnostics.HasError(), "%v", imported.Diagnostics)
+
+			resp := resource.ReadResponse{State: imported.State}
+			r.Read(ctx, resource.ReadRequest{State: imported.State}, &resp)
+			require.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			if tt.wantErr {
+				assert.Equal(t, "Not an alerting suspension", resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			var state alertingSuspensionResourceModel
+			require.False(t, resp.State.Get(ctx, &state).HasError())
+			assert.True(t, state.Enabled.ValueBool())
+		})
+	}
+}
+
+func TestReadAlertingSuspensionSilence(t *testing.T) {
+	startsAt := time.Date(2030, 1, 15, 10, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(4 * time.Hour)
+	suspended := func() alertingSuspensionResourceModel {
+		return alertingSuspensionResourceModel{
+			Enabled:   types.BoolValue(true),
+			Reason:    types.StringValue("INC-1234"),
+			SilenceID: types.StringValue("s1"),
+			StartsAt:  types.StringValue(startsAt.Format(time.RFC3339)),
+			EndsAt:    types.StringValue(endsAt.Format(time.RFC3339)),
+			Active:    types.BoolValue(true),
+		}
+	}
+	silence := func(end time.Time) *models.Silence {
+		return &models.Silence{UUID: "s1", StartsAt: strfmt.DateTime(startsAt), EndsAt: strfmt.DateTime(end), Comment: "INC-1234"}
+	}
+
+	t.Run("auto-expired", func(t *testing.T) {
+		model := suspended()
+		assert.False(t, readAlertingSuspensionSilence(&model, silence(endsAt), endsAt.Add(time.Minute)))
+		assert.True(t, model.Enabled.ValueBool(), "an auto-expired suspension is not restarted")
+		assert.False(t, model.Active.ValueBool())
+	})
+
+	t.Run("expired early outside Terraform", func(t *testing.T) {
+		model := suspended()
+		assert.True(t, readAlertingSuspensionSilence(&model, silence(startsAt.Add(time.Hour)), startsAt.Add(2*time.Hour)))
+		assert.False(t, model.Enabled.ValueBool())
+		assert.False(t, model.Active.ValueBool())
+	})
+
+	t.Run("deleted outside Terraform", func(t *testing.T) {
+		model := suspended()
+		assert.True(t, readAlertingSuspensionSilence(&model, nil, startsAt.Add(time.Hour)))
+		assert.False(t, model.Enabled.ValueBool())
+		assert.True(t, model.SilenceID.IsNull())
+	})
+
+	t.Run("imported", func(t *testing.T) {
+		model := alertingSuspensionResourceModel{SilenceID: types.StringValue("s1")}
+		assert.False(t, readAlertingSuspensionSilence(&model, silence(endsAt), startsAt.Add(time.Hour)))
+		assert.True(t, model.Enabled.ValueBool())
+		assert.Equal(t, "INC-1234", model.Reason.ValueString())
+		assert.Equal(t, endsAt.Format(time.RFC3339), model.EndsAt.ValueString())
+	})
+}
+
+func TestAlertingSuspensionDuration(t *testing.T) {
+	duration, err := alertingSuspensionDuration(types.StringValue("90m"))
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Minute, duration)
+
+	duration, err = alertingSuspensionDuration(types.StringNull())
+	require.NoError(t, err)
+	assert.Equal(t, alertingSuspensionIndefinite, duration)
+
+	_, err = alertingSuspensionDuration(types.StringValue("1d"))
+	assert.ErrorContains(t, err, "must be a duration")
+	_, err = alertingSuspensionDuration(types.StringValue("30s"))
+	assert.ErrorContains(t, err, "at least 1m")
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -31,6 +32,8 @@ func NewApiKeyResource() resource.Resource {
 
 type apiKeyResource struct {
 	client ApiClient
+	// expiringWarningDays mirrors the provider's expiring_credentials_warning_days setting.
+	expiringWarningDays int64
 }
 
 type apiKeyResourceModel struct {
@@ -161,6 +164,9 @@ func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 	r.client = client
+	if pc, ok := req.ProviderData.(*providerClient); ok {
+		r.expiringWarningDays = pc.expiringCredentialsWarningDays
+	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -260,6 +266,10 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	if msg, expiring := apiKeyExpiryWarning(state, time.Now(), r.expiringWarningDays); expiring {
+		resp.Diagnostics.AddWarning("API Key Expiring Soon", msg)
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -305,59 +315,54 @@ func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportSta
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// Helper function to read API Key details using ListApiKeys
-func (r *apiKeyResource) readApiKey(ctx context.Context, state *apiKeyResourceModel) diag.Diagnostics {
-	var diags diag.Diagnostics
-	apiKeyId := state.Id.ValueString()
-
-	tflog.Debug(ctx, fmt.Sprintf("Reading API Key details for ID: %s using ListApiKeys", apiKeyId))
+// apiKeyExpiryWarning returns a warning message when the key expires within
+// warningDays of now. The expiry comes from expired_at, falling back to the
+// configured expiration_date. A warningDays of 0 disables the check.
+func apiKeyExpiryWarning(state apiKeyResourceModel, now time.Time, warningDays int64) (string, bool) {
+	if warningDays <= 0 {
+		return "", false
+	}
 
-	apiKeys, err := r.client.ListApiKeys(ctx, nil, nil)
+	expiryStr := state.ExpiredAt.ValueString()
+	if expiryStr == "" {
+		expiryStr = state.ExpirationDate.ValueString()
+	}
+	if expiryStr == "" {
+		return "", false
+	}
+	expiry, err := time.Parse(time.RFC3339, expiryStr)
 	if err != nil {
-		diags.AddError("Error Listing API Keys", fmt.Sprintf("Could not list API keys: %s", err.Error()))
-		return diags
+		return "", false
 	}
 
-	var foundKey *models.ListAPIKeysResponseItem
-	keyFoundInList := false
-	for _, key := range apiKeys {
-		if key.ID == apiKeyId {
-			// Need to copy the key because 'key' is a loop variable
-			tempKey := key
-			foundKey = tempKey
-			keyFoundInList = true
-			break
-		}
+	if expiry.Before(now) || expiry.After(now.Add(time.Duration(warningDays)*24*time.Hour)) {
+		return "", false
 	}
+	return fmt.Sprintf("API key %q (%s) expires on %s, within the configured %d-day warning window. Rotate it before it expires.",
+		state.Name.ValueString(), state.Id.ValueString(), expiry.UTC().Format(time.RFC3339), warningDays), true
+}
 
-	// If not found in the initial list (active keys), try again including revoked/expired
-	if !keyFoundInList {
-		tflog.Debug(ctx, fmt.Sprintf("API Key %s not found in active list, retrying with revoked/expired", apiKeyId))
-		withRevoked := true
-		withExpired := true
-		apiKeysFiltered, errFiltered := r.client.ListApiKeys(ctx, &withRevoked, &withExpired)
-		if errFiltered != nil {
-			// If even the filtered list fails, report that error (could be permission issue)
-			diags.AddError("Error Listing Filtered API Keys", fmt.Sprintf("Could not list API keys with filters: %s", errFiltered.Error()))
-			return diags
-		}
-		for _, key := range apiKeysFiltered {
-			if key.ID == apiKeyId {
-				tempKey := key
-				foundKey = tempKey
-				break
-			}
-		}
-	}
+// readApiKey reads the API key's details through GetApiKey, which serves every
+// key of a refresh from a single list of the keys.
+func (r *apiKeyResource) readApiKey(ctx context.Context, state *apiKeyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	apiKeyId := state.Id.ValueString()
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading API Key details for ID: %s", apiKeyId))
 
-	// Check if the key was not found first
-	if foundKey == nil {
-		diags.AddWarning("API Key Not Found", fmt.Sprintf("API Key with ID %s not found during list operation (checked active and filtered).", apiKeyId))
+	foundKey, err := r.client.GetApiKey(ctx, apiKeyId)
+	if errors.Is(err, ErrNotFound) {
+		diags.AddWarning("API Key Not Found", fmt.Sprintf("API Key with ID %s not found during list operation (checked active, revoked, and expired keys).", apiKeyId))
+		return diags
+	}
+	if err != nil {
+		diags.AddError("Error Listing API Keys", fmt.Sprintf("Could not list API keys: %s", err.Error()))
 		return diags
 	}
 
-	// Check if the key is revoked or expired
-	if !foundKey.RevokedAt.IsZero() || !foundKey.ExpiredAt.IsZero() {
+	// Check if the key is revoked or expired. expiredAt carries the expiry
+	// timestamp even for keys that have not expired yet.
+	if !foundKey.RevokedAt.IsZero() || (!foundKey.ExpiredAt.IsZero() && time.Time(foundKey.ExpiredAt).Before(time.Now())) {
 		diags.AddWarning("API Key Not Found", fmt.Sprintf("API Key with ID %s is revoked or expired and should be removed from state.", apiKeyId))
 		return diags
 	}
@@ -389,8 +394,25 @@ func (r *apiKeyResource) readApiKey(ctx context.Context, state *apiKeyResourceMo
 		state.ExpiredAt = types.StringNull()
 	}
 
-	policies := make([]attr.Value, 0, len(foundKey.Policies))
-	for _, p := range foundKey.Policies {
+	policiesList, listDiags := apiKeyPoliciesList(foundKey.Policies)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	state.Policies = policiesList
+
+	tflog.Debug(ctx, fmt.Sprintf("API Key %s read complete. State populated.", apiKeyId))
+
+	return diags
+}
+
+// apiKeyPoliciesList converts the policies attached to an API key's service
+// account into a list of policyMetadataObjectType objects.
+func apiKeyPoliciesList(refs []*models.PolicyRef) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policies := make([]attr.Value, 0, len(refs))
+	for _, p := range refs {
 		if p == nil {
 			continue
 		}
@@ -408,19 +430,12 @@ func (r *apiKeyResource) readApiKey(ctx context.Context, state *apiKeyResourceMo
 		policyObj, policyDiags := types.ObjectValue(policyMetadataObjectType.AttrTypes, policyAttrs)
 		diags.Append(policyDiags...)
 		if diags.HasError() {
-			return diags // Stop processing if object creation failed
+			return types.ListNull(policyMetadataObjectType), diags // Stop processing if object creation failed
 		}
 		policies = append(policies, policyObj)
 	}
 
 	policiesList, listDiags := types.ListValue(policyMetadataObjectType, policies)
 	diags.Append(listDiags...)
-	if diags.HasError() {
-		return diags
-	}
-	state.Policies = policiesList
-
-	tflog.Debug(ctx, fmt.Sprintf("API Key %s read complete. State populated.", apiKeyId))
-
-	return diags
+	return policiesList, diags
 }
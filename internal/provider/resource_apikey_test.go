@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -169,3 +171,45 @@ func testAccCheckApiKeyResourceDisappears(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestApiKeyExpiryWarning(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	key := func(expiredAt, expirationDate string) apiKeyResourceModel {
+		m := apiKeyResourceModel{
+			Id:             types.StringValue("key-id"),
+			Name:           types.StringValue("ci-deployer"),
+			ExpiredAt:      types.StringNull(),
+			ExpirationDate: types.StringNull(),
+		}
+		if expiredAt != "" {
+			m.ExpiredAt = types.StringValue(expiredAt)
+		}
+		if expirationDate != "" {
+			m.ExpirationDate = types.StringValue(expirationDate)
+		}
+		return m
+	}
+
+	tests := []struct {
+		name        string
+		state       apiKeyResourceModel
+		warningDays int64
+		want        bool
+	}{
+		{name: "within window", state: key("2026-01-15T00:00:00.000Z", ""), warningDays: 7, want: true},
+		{name: "outside window", state: key("2026-02-15T00:00:00.000Z", ""), warningDays: 7},
+		{name: "falls back to expiration_date", state: key("", "2026-01-12T00:00:00Z"), warningDays: 7, want: true},
+		{name: "no expiry", state: key("", ""), warningDays: 7},
+		{name: "disabled", state: key("2026-01-15T00:00:00.000Z", ""), warningDays: 0},
+		{name: "already expired", state: key("2026-01-01T00:00:00.000Z", ""), warningDays: 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, got := apiKeyExpiryWarning(tt.state, now, tt.warningDays)
+			if got != tt.want {
+				t.Fatalf("apiKeyExpiryWarning() = %t (%q), want %t", got, msg, tt.want)
+			}
+		})
+	}
+}
@@ -37,15 +37,18 @@ type connectedAppResource struct {
 }
 
 type connectedAppResourceModel struct {
-	Id        types.String  `tfsdk:"id"`
-	Name      types.String  `tfsdk:"name"`
-	Type      types.String  `tfsdk:"type"`
-	Data      types.Dynamic `tfsdk:"data"`
-	DataHash  types.String  `tfsdk:"data_hash"`
-	CreatedBy types.String  `tfsdk:"created_by"`
-	CreatedAt types.String  `tfsdk:"created_at"`
-	UpdatedBy types.String  `tfsdk:"updated_by"`
-	UpdatedAt types.String  `tfsdk:"updated_at"`
+	Id       types.String  `tfsdk:"id"`
+	Name     types.String  `tfsdk:"name"`
+	Type     types.String  `tfsdk:"type"`
+	Data     types.Dynamic `tfsdk:"data"`
+	DataHash types.String  `tfsdk:"data_hash"`
+	// RotationTrigger is never sent to the API; changing it only forces an update.
+	RotationTrigger types.Map    `tfsdk:"rotation_trigger"`
+	ForceDelete     types.Bool   `tfsdk:"force_delete"`
+	CreatedBy       types.String `tfsdk:"created_by"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedBy       types.String `tfsdk:"updated_by"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
 }
 
 func (r *connectedAppResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -80,6 +83,8 @@ func (r *connectedAppResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "SHA-256 hash of the stored connected app data (including secret fields), computed by groundcover. Because `data` is sensitive and redacted on read, this hash is how Terraform detects that the stored data changed outside of Terraform. Drift detection is forward-looking: it covers changes made after this hash is first recorded in state (i.e. after upgrading to a provider version that supports `data_hash`, on the next refresh/apply). For resources created by an older provider version, the first refresh adopts the current server hash as the baseline, so any out-of-band change made before the upgrade is absorbed rather than flagged.",
 				Computed:    true,
 			},
+			"rotation_trigger": connectedAppRotationTriggerAttribute(),
+			"force_delete":     forceDeleteAttribute(connectedAppDependentsDescription),
 			"created_by": schema.StringAttribute{
 				Description: "The user who created the connected app.",
 				Computed:    true,
@@ -100,6 +105,16 @@ func (r *connectedAppResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+// connectedAppRotationTriggerAttribute returns the rotation_trigger attribute
+// shared by both connected app resources.
+func connectedAppRotationTriggerAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: "Arbitrary values that, when changed, update the connected app in place and re-send `data`, e.g. `{ rotated_at = \"2024-06-01\" }`. Use it to push a rotated secret to groundcover while keeping the app ID that notification routes and monitors reference. The API replaces `data` as a whole on every update, so `data` must hold the complete configuration. Not sent to the API.",
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *connectedAppResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -300,6 +315,10 @@ func (r *connectedAppResource) Delete(ctx context.Context, req resource.DeleteRe
 	connectedAppId := state.Id.ValueString()
 	tflog.Debug(ctx, fmt.Sprintf("Deleting connected app resource: %s", connectedAppId))
 
+	if !checkDeleteDependents(ctx, state.ForceDelete, "connected app", connectedAppId, connectedAppDependents(r.client, connectedAppId), &resp.Diagnostics) {
+		return
+	}
+
 	err := r.client.DeleteConnectedApp(ctx, connectedAppId)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
@@ -43,15 +43,18 @@ type connectedAppJsonResource struct {
 }
 
 type connectedAppJsonResourceModel struct {
-	Id        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Type      types.String `tfsdk:"type"`
-	Data      types.String `tfsdk:"data"`
-	DataHash  types.String `tfsdk:"data_hash"`
-	CreatedBy types.String `tfsdk:"created_by"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedBy types.String `tfsdk:"updated_by"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Data     types.String `tfsdk:"data"`
+	DataHash types.String `tfsdk:"data_hash"`
+	// RotationTrigger is never sent to the API; changing it only forces an update.
+	RotationTrigger types.Map    `tfsdk:"rotation_trigger"`
+	ForceDelete     types.Bool   `tfsdk:"force_delete"`
+	CreatedBy       types.String `tfsdk:"created_by"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedBy       types.String `tfsdk:"updated_by"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
 }
 
 func (r *connectedAppJsonResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -86,10 +89,12 @@ func (r *connectedAppJsonResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "SHA-256 hash of the stored connected app data, computed by groundcover. Because `data` is sensitive and redacted on read, this hash is how drift in the stored data is detected. Drift detection is forward-looking (see groundcover_connected_app.data_hash).",
 				Computed:    true,
 			},
-			"created_by": schema.StringAttribute{Description: "The user who created the connected app.", Computed: true},
-			"created_at": schema.StringAttribute{Description: "The date the connected app was created (RFC3339 format).", Computed: true},
-			"updated_by": schema.StringAttribute{Description: "The user who last updated the connected app.", Computed: true},
-			"updated_at": schema.StringAttribute{Description: "The date the connected app was last updated (RFC3339 format).", Computed: true},
+			"rotation_trigger": connectedAppRotationTriggerAttribute(),
+			"force_delete":     forceDeleteAttribute(connectedAppDependentsDescription),
+			"created_by":       schema.StringAttribute{Description: "The user who created the connected app.", Computed: true},
+			"created_at":       schema.StringAttribute{Description: "The date the connected app was created (RFC3339 format).", Computed: true},
+			"updated_by":       schema.StringAttribute{Description: "The user who last updated the connected app.", Computed: true},
+			"updated_at":       schema.StringAttribute{Description: "The date the connected app was last updated (RFC3339 format).", Computed: true},
 		},
 	}
 }
@@ -206,6 +211,9 @@ func (r *connectedAppJsonResource) Delete(ctx context.Context, req resource.Dele
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !checkDeleteDependents(ctx, state.ForceDelete, "connected app", state.Id.ValueString(), connectedAppDependents(r.client, state.Id.ValueString()), &resp.Diagnostics) {
+		return
+	}
 	if err := r.client.DeleteConnectedApp(ctx, state.Id.ValueString()); err != nil {
 		if errors.Is(err, ErrNotFound) {
 			tflog.Warn(ctx, "Connected app already deleted externally, treating as success", map[string]any{"id": state.Id.ValueString()})
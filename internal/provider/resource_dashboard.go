@@ -2,18 +2,24 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 	"slices"
 	"strings"
 
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,25 +31,36 @@ var (
 	_ resource.ResourceWithModifyPlan  = &dashboardResource{}
 )
 
+var dashboardIgnoreJsonPathPattern = regexp.MustCompile(`^[^.\s]+(\.[^.\s]+)*$`)
+
 func NewDashboardResource() resource.Resource {
 	return &dashboardResource{}
 }
 
 type dashboardResource struct {
 	client ApiClient
+	// features are the provider's features block settings.
+	features providerFeatures
+	// defaultTags are the provider's default_tags, added to the dashboard's tags.
+	defaultTags map[string]string
 }
 
 type dashboardResourceModel struct {
-	UUID           types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	Team           types.String `tfsdk:"team"`
-	Preset         types.String `tfsdk:"preset"`
-	Tags           types.List   `tfsdk:"tags"`
-	RevisionNumber types.Int32  `tfsdk:"revision_number"`
-	Override       types.Bool   `tfsdk:"override"`
-	Owner          types.String `tfsdk:"owner"`
-	Status         types.String `tfsdk:"status"`
+	UUID            types.String         `tfsdk:"id"`
+	Name            types.String         `tfsdk:"name"`
+	Description     types.String         `tfsdk:"description"`
+	Team            types.String         `tfsdk:"team"`
+	Preset          jsontypes.Normalized `tfsdk:"preset"`
+	Tags            types.List           `tfsdk:"tags"`
+	TagsAll         types.List           `tfsdk:"tags_all"`
+	Variables       types.Map            `tfsdk:"variables"`
+	IgnoreJsonPaths types.List           `tfsdk:"ignore_json_paths"`
+	RevisionNumber  types.Int32          `tfsdk:"revision_number"`
+	Override        types.Bool           `tfsdk:"override"`
+	Owner           types.String         `tfsdk:"owner"`
+	Status          types.String         `tfsdk:"status"`
+
+	DestroyBehavior types.String `tfsdk:"destroy_behavior"`
 }
 
 func (r *dashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -74,14 +91,39 @@ func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 			},
 			"preset": schema.StringAttribute{
-				Description: "The preset configuration for the dashboard.",
+				Description: "The preset configuration for the dashboard, as a JSON string. Formatting and key order are not significant: a preset the API returns in a different layout is not reported as a change. When an update changes the preset, the plan includes a warning listing the panels added, removed, changed, or moved (by title and widget id) and the other top-level keys that changed.",
 				Required:    true,
+				CustomType:  jsontypes.NormalizedType{},
 			},
 			"tags": schema.ListAttribute{
-				Description: "Free-text tags for organizing the dashboard. Your configured list is preserved as-is in Terraform state; the backend additionally trims surrounding whitespace and drops exact duplicates server-side. Omit or leave unset for an untagged dashboard.",
+				Description: "Free-text tags for organizing the dashboard. Your configured list is preserved as-is in Terraform state; the backend additionally trims surrounding whitespace and drops exact duplicates server-side. Omit or leave unset for an untagged dashboard. " +
+					"Unlike the `tags` map of `groundcover_data_integration`, this is a list: the API stores dashboard tags as free-text strings rather than key/value pairs. Write key/value tags as `key:value` strings, the form the provider's `default_tags` are sent in.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tags_all": schema.ListAttribute{
+				Description: "The tags the dashboard is sent with: `tags` followed by the provider's `default_tags` as `key:value` tags. A default tag is left out when `tags` already has a tag starting with its `key:`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"variables": schema.MapAttribute{
+				Description: "Values substituted into `${name}` placeholders in `preset` before it is sent to the API, so one preset can serve several environments. " +
+					"Values are JSON-escaped, so placeholders belong inside JSON strings. Placeholders with no matching variable are left untouched. " +
+					"State keeps the unrendered preset, and refresh compares the API preset against the rendered one. " +
+					"Inline presets must escape placeholders as `$${name}` so Terraform does not interpolate them; presets loaded with `file()` need no escaping.",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"ignore_json_paths": schema.ListAttribute{
+				Description: "Paths in `preset` left out when it is compared with the preset the API returns, for volatile keys such as auto-generated panel IDs or timestamps. " +
+					"A path is a dot-separated list of object keys; a `*` segment matches every array element or object key, so `layout.*.id` covers the ID of each layout item. " +
+					"Differences at these paths, remote or in configuration, are not reported as changes. The configured preset is still sent as-is on create and update.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(dashboardIgnoreJsonPathPattern, "must be dot-separated JSON object keys or `*`, such as `layout.*.id`")),
+				},
+			},
 			"revision_number": schema.Int32Attribute{
 				Description: "The revision number of the dashboard.",
 				Computed:    true,
@@ -91,6 +133,7 @@ func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:           true,
 				DeprecationMessage: "This attribute is ignored and will be removed in a future version. Override is always enabled for terraform-managed updates.",
 			},
+			"destroy_behavior": destroyBehaviorAttribute("dashboard"),
 			"owner": schema.StringAttribute{
 				Description: "The owner of the dashboard.",
 				Computed:    true,
@@ -123,6 +166,10 @@ func (r *dashboardResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 	r.client = client
+	if pc, ok := req.ProviderData.(*providerClient); ok {
+		r.features = pc.features
+		r.defaultTags = pc.defaultTags
+	}
 }
 
 func (r *dashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -142,17 +189,23 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 		"plan_preset_preview": getPreview(planPresetStr, 200),
 	})
 
-	tags, tagDiags := tagsToStringSlice(ctx, plan.Tags)
+	tags, tagDiags := r.tagsForAPI(ctx, plan.Tags)
 	resp.Diagnostics.Append(tagDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	renderedPreset, varDiags := renderDashboardPresetFromModel(ctx, planPresetStr, plan.Variables)
+	resp.Diagnostics.Append(varDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createReq := &models.CreateDashboardRequest{
 		Name:          plan.Name.ValueString(),
 		Description:   plan.Description.ValueString(),
 		Team:          plan.Team.ValueString(),
-		Preset:        planPresetStr,
+		Preset:        renderedPreset,
 		Tags:          tags,
 		IsProvisioned: true,
 	}
@@ -184,9 +237,14 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 	} else {
 		plan.Team = types.StringValue(dashboard.Team)
 	}
-	// Keep the user's original preset format if semantically the same
+	// Keep the user's original preset format (and placeholders) if semantically the same
 	apiPresetStr := dashboard.Preset
-	areSemanticallySame, err := CompareJSONSemantically(planPresetStr, apiPresetStr)
+	ignorePaths, pathDiags := dashboardIgnoreJsonPaths(ctx, plan.IgnoreJsonPaths)
+	resp.Diagnostics.Append(pathDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	areSemanticallySame, err := CompareJSONSemanticallyIgnoringPaths(renderedPreset, apiPresetStr, ignorePaths)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Dashboard Preset",
@@ -213,7 +271,7 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 			"plan_preset_len": len(planPresetStr),
 			"api_preset_len":  len(apiPresetStr),
 		})
-		plan.Preset = types.StringValue(apiPresetStr)
+		plan.Preset = jsontypes.NewNormalizedValue(apiPresetStr)
 	} else {
 		tflog.Debug(ctx, "Create: Preset JSON is semantically same, keeping plan format", map[string]interface{}{
 			"uuid":            dashboard.UUID,
@@ -225,7 +283,7 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 	plan.Status = types.StringValue(dashboard.Status)
 	plan.RevisionNumber = types.Int32Value(dashboard.RevisionNumber)
 
-	plan.Tags, tagDiags = tagsToState(ctx, dashboard.Tags, plan.Tags)
+	plan.Tags, plan.TagsAll, tagDiags = r.tagsToState(ctx, dashboard.Tags, plan.Tags, plan.TagsAll)
 	resp.Diagnostics.Append(tagDiags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -320,8 +378,18 @@ func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 		})
 	}
 
-	// Keep the user's original preset format if semantically the same
-	areSemanticallySame, err := CompareJSONSemantically(originalStatePreset, apiPreset)
+	// Keep the user's original preset format (and placeholders) if semantically the same
+	renderedStatePreset, varDiags := renderDashboardPresetFromModel(ctx, originalStatePreset, state.Variables)
+	resp.Diagnostics.Append(varDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ignorePaths, pathDiags := dashboardIgnoreJsonPaths(ctx, state.IgnoreJsonPaths)
+	resp.Diagnostics.Append(pathDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	areSemanticallySame, err := CompareJSONSemanticallyIgnoringPaths(renderedStatePreset, apiPreset, ignorePaths)
 	if err != nil {
 		// If we can't parse the JSON, use the API response
 		// This can happen if the state has invalid JSON from an older version
@@ -329,7 +397,7 @@ func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 			"uuid":  state.UUID.ValueString(),
 			"error": err.Error(),
 		})
-		state.Preset = types.StringValue(apiPreset)
+		state.Preset = jsontypes.NewNormalizedValue(apiPreset)
 	} else if !areSemanticallySame {
 		tflog.Info(ctx, "Read: Preset JSON is semantically different, using API response", map[string]interface{}{
 			"uuid":                 state.UUID.ValueString(),
@@ -338,7 +406,7 @@ func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 			"state_preset_preview": getPreview(originalStatePreset, 200),
 			"api_preset_preview":   getPreview(apiPreset, 200),
 		})
-		state.Preset = types.StringValue(apiPreset)
+		state.Preset = jsontypes.NewNormalizedValue(apiPreset)
 	} else {
 		tflog.Debug(ctx, "Read: Preset JSON is semantically same, keeping state format", map[string]interface{}{
 			"uuid":             state.UUID.ValueString(),
@@ -353,12 +421,12 @@ func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.Owner = types.StringValue(dashboard.Owner)
 	state.Status = types.StringValue(dashboard.Status)
 
-	tagsValue, tagDiags := tagsToState(ctx, dashboard.Tags, state.Tags)
+	var tagDiags diag.Diagnostics
+	state.Tags, state.TagsAll, tagDiags = r.tagsToState(ctx, dashboard.Tags, state.Tags, state.TagsAll)
 	resp.Diagnostics.Append(tagDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.Tags = tagsValue
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -390,6 +458,19 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		"state_preset_len":  len(state.Preset.ValueString()),
 	})
 
+	// A change to attributes that only affect the provider, such as
+	// destroy_behavior, does not need an API call and must not bump the revision.
+	if !dashboardFieldsChanged(plan, state) && plan.Preset.Equal(state.Preset) {
+		tflog.Debug(ctx, "Update: No dashboard fields changed, updating state only", map[string]interface{}{
+			"uuid": state.UUID.ValueString(),
+		})
+		state.DestroyBehavior = plan.DestroyBehavior
+		state.Override = plan.Override
+		state.IgnoreJsonPaths = plan.IgnoreJsonPaths
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
 	// Verify the dashboard still exists before updating
 	tflog.Debug(ctx, "Update: Verifying dashboard exists before update", map[string]interface{}{
 		"uuid": state.UUID.ValueString(),
@@ -413,17 +494,23 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 	// Build update request - always use plan values for all fields
 	// Use Override: true since Terraform is the source of truth.
 	// Don't send CurrentRevision — the API rejects it with excluded_if when Override is true.
-	tags, tagDiags := tagsToStringSlice(ctx, plan.Tags)
+	tags, tagDiags := r.tagsForAPI(ctx, plan.Tags)
 	resp.Diagnostics.Append(tagDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	renderedPreset, varDiags := renderDashboardPresetFromModel(ctx, plan.Preset.ValueString(), plan.Variables)
+	resp.Diagnostics.Append(varDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := &models.UpdateDashboardRequest{
 		Name:          plan.Name.ValueString(),
 		Description:   plan.Description.ValueString(),
 		Team:          plan.Team.ValueString(),
-		Preset:        plan.Preset.ValueString(),
+		Preset:        renderedPreset,
 		Tags:          tags,
 		IsProvisioned: true,
 		Override:      true,
@@ -528,7 +615,12 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		})
 	}
 
-	areSemanticallySame, err := CompareJSONSemantically(planPresetStr, apiPresetStr)
+	ignorePaths, pathDiags := dashboardIgnoreJsonPaths(ctx, plan.IgnoreJsonPaths)
+	resp.Diagnostics.Append(pathDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	areSemanticallySame, err := CompareJSONSemanticallyIgnoringPaths(renderedPreset, apiPresetStr, ignorePaths)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Dashboard Preset",
@@ -544,7 +636,7 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 			"plan_preset_preview": getPreview(planPresetStr, 200),
 			"api_preset_preview":  getPreview(apiPresetStr, 200),
 		})
-		plan.Preset = types.StringValue(apiPresetStr)
+		plan.Preset = jsontypes.NewNormalizedValue(apiPresetStr)
 	} else {
 		tflog.Debug(ctx, "Update: Preset JSON is semantically same, keeping plan format to prevent format drift", map[string]interface{}{
 			"uuid":            state.UUID.ValueString(),
@@ -559,7 +651,7 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 	plan.Status = types.StringValue(dashboard.Status)
 	plan.RevisionNumber = types.Int32Value(dashboard.RevisionNumber)
 
-	plan.Tags, tagDiags = tagsToState(ctx, dashboard.Tags, plan.Tags)
+	plan.Tags, plan.TagsAll, tagDiags = r.tagsToState(ctx, dashboard.Tags, plan.Tags, plan.TagsAll)
 	resp.Diagnostics.Append(tagDiags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -610,6 +702,10 @@ func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if abandonOnDestroy(r.features.destroyBehavior(state.DestroyBehavior), "Dashboard", state.UUID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting Dashboard", map[string]interface{}{
 		"uuid": state.UUID.ValueString(),
 	})
@@ -640,6 +736,11 @@ func (r *dashboardResource) ImportState(ctx context.Context, req resource.Import
 
 func (r *dashboardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	tflog.Info(ctx, "ModifyPlan called for dashboard resource")
+	r.features.checkDeleteProtection(ctx, req, "Dashboard", &resp.Diagnostics)
+	r.planTagsAll(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
 		tflog.Debug(ctx, "ModifyPlan: Skipping for new or destroyed dashboard resource")
 		return
@@ -648,7 +749,7 @@ func (r *dashboardResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 	var plan dashboardResourceModel
 	var state dashboardResourceModel
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -658,10 +759,7 @@ func (r *dashboardResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 	// tagsToState preserves the user's configured list verbatim in state (see
 	// its doc), so state.Tags mirrors the config and Equal() is the correct
 	// comparison — a genuine edit flips it, an unchanged list does not.
-	hasChanges := !plan.Name.Equal(state.Name) ||
-		!plan.Description.Equal(state.Description) ||
-		!plan.Team.Equal(state.Team) ||
-		!plan.Tags.Equal(state.Tags)
+	hasChanges := dashboardFieldsChanged(plan, state)
 
 	plannedPreset := plan.Preset.ValueString()
 	statePreset := state.Preset.ValueString()
@@ -677,105 +775,45 @@ func (r *dashboardResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 		"has_other_changes":    hasChanges,
 	})
 
-	// Handle preset comparison
-	if !plan.Preset.IsNull() && !plan.Preset.IsUnknown() && !state.Preset.IsNull() && !state.Preset.IsUnknown() {
-		// Skip if presets are already identical
-		if plannedPreset == statePreset {
-			tflog.Debug(ctx, "ModifyPlan: Presets are already identical, no normalization needed", map[string]interface{}{
-				"uuid":       plan.UUID.ValueString(),
-				"preset_len": len(plannedPreset),
+	// Terraform does not apply semantic equality while planning, so a preset that
+	// was only reformatted in configuration is reconciled here with the type's own
+	// comparison.
+	if !plan.Preset.IsNull() && !plan.Preset.IsUnknown() && !state.Preset.IsNull() && !state.Preset.IsUnknown() && plannedPreset != statePreset {
+		areSemanticallySame, diags := plan.Preset.StringSemanticEquals(ctx, state.Preset)
+		if !areSemanticallySame && !diags.HasError() && !plan.IgnoreJsonPaths.IsNull() {
+			ignorePaths, pathDiags := dashboardIgnoreJsonPaths(ctx, plan.IgnoreJsonPaths)
+			diags.Append(pathDiags...)
+			if !diags.HasError() {
+				var err error
+				areSemanticallySame, err = CompareJSONSemanticallyIgnoringPaths(plannedPreset, statePreset, ignorePaths)
+				if err != nil {
+					diags.AddError("Invalid Dashboard Preset", err.Error())
+				}
+			}
+		}
+		switch {
+		case diags.HasError():
+			// Invalid JSON is reported by the attribute's validation; let the update proceed.
+			tflog.Warn(ctx, "ModifyPlan: Failed to compare preset JSON semantically, allowing update", map[string]interface{}{
+				"uuid": plan.UUID.ValueString(),
 			})
-		} else {
-			// Log raw presets before normalization for debugging
-			tflog.Debug(ctx, "ModifyPlan: Raw preset comparison (before normalization)", map[string]interface{}{
+			hasChanges = true
+		case areSemanticallySame:
+			tflog.Info(ctx, "ModifyPlan: Preset JSONs are semantically identical. Suppressing diff.", map[string]interface{}{
+				"uuid":             plan.UUID.ValueString(),
+				"plan_preset_len":  len(plannedPreset),
+				"state_preset_len": len(statePreset),
+			})
+			plan.Preset = state.Preset
+		default:
+			tflog.Info(ctx, "ModifyPlan: Preset JSONs have semantic differences.", map[string]interface{}{
 				"uuid":                 plan.UUID.ValueString(),
-				"plan_preset_len":      len(plannedPreset),
-				"state_preset_len":     len(statePreset),
 				"plan_preset_preview":  getPreview(plannedPreset, 300),
 				"state_preset_preview": getPreview(statePreset, 300),
 			})
-
-			// Try to normalize and compare the presets
-			normalizedPlanned, err := NormalizeJSON(ctx, plannedPreset)
-			if err != nil {
-				// If we can't normalize the planned preset, it's likely invalid JSON
-				// Allow Terraform to proceed with the update so the user can fix it
-				tflog.Warn(ctx, "ModifyPlan: Failed to normalize planned preset JSON", map[string]interface{}{
-					"uuid":                plan.UUID.ValueString(),
-					"plan_preset_len":     len(plannedPreset),
-					"plan_preset_preview": getPreview(plannedPreset, 200),
-					"error":               err.Error(),
-				})
-				hasChanges = true
-			} else {
-				normalizedState, err := NormalizeJSON(ctx, statePreset)
-				if err != nil {
-					// If we can't normalize the state preset, allow the update to proceed
-					// This could happen if the state has corrupted data from a previous version
-					tflog.Warn(ctx, "ModifyPlan: Failed to normalize state preset JSON, allowing update", map[string]interface{}{
-						"uuid":                 plan.UUID.ValueString(),
-						"state_preset_len":     len(statePreset),
-						"state_preset_preview": getPreview(statePreset, 200),
-						"error":                err.Error(),
-					})
-					hasChanges = true
-				} else {
-					// Log normalized presets for detailed debugging
-					tflog.Debug(ctx, "ModifyPlan: Normalized preset comparison (after normalization)", map[string]interface{}{
-						"uuid":                     plan.UUID.ValueString(),
-						"normalized_plan_len":      len(normalizedPlanned),
-						"normalized_state_len":     len(normalizedState),
-						"normalized_equal":         normalizedPlanned == normalizedState,
-						"normalized_plan_preview":  getPreview(normalizedPlanned, 300),
-						"normalized_state_preview": getPreview(normalizedState, 300),
-					})
-
-					areSemanticallySame, err := CompareJSONSemantically(normalizedPlanned, normalizedState)
-					if err != nil {
-						// If we can't compare after successful normalization, something is wrong
-						// Allow the update to proceed rather than blocking it
-						tflog.Warn(ctx, "ModifyPlan: Failed to perform semantic JSON comparison, allowing update", map[string]interface{}{
-							"uuid":                 plan.UUID.ValueString(),
-							"normalized_plan_len":  len(normalizedPlanned),
-							"normalized_state_len": len(normalizedState),
-							"error":                err.Error(),
-						})
-						hasChanges = true
-					} else if !areSemanticallySame {
-						hasChanges = true
-						tflog.Info(ctx, "ModifyPlan: Preset JSONs have semantic differences.", map[string]interface{}{
-							"uuid":                     plan.UUID.ValueString(),
-							"plan_preset_len":          len(plannedPreset),
-							"state_preset_len":         len(statePreset),
-							"normalized_plan_len":      len(normalizedPlanned),
-							"normalized_state_len":     len(normalizedState),
-							"normalized_plan_preview":  getPreview(normalizedPlanned, 300),
-							"normalized_state_preview": getPreview(normalizedState, 300),
-						})
-					} else {
-						// Presets are semantically the same, suppress the diff
-						tflog.Info(ctx, "ModifyPlan: Preset JSONs are semantically identical. Suppressing diff.", map[string]interface{}{
-							"uuid":                 plan.UUID.ValueString(),
-							"plan_preset_len":      len(plannedPreset),
-							"state_preset_len":     len(statePreset),
-							"normalized_plan_len":  len(normalizedPlanned),
-							"normalized_state_len": len(normalizedState),
-							"normalized_match":     normalizedPlanned == normalizedState,
-							"raw_match":            plannedPreset == statePreset,
-						})
-						plan.Preset = state.Preset
-					}
-				}
-			}
+			planPresetChanges(ctx, plan, statePreset, resp)
+			hasChanges = true
 		}
-	} else {
-		tflog.Debug(ctx, "ModifyPlan: Skipping preset comparison - preset is null or unknown", map[string]interface{}{
-			"uuid":             plan.UUID.ValueString(),
-			"plan_is_null":     plan.Preset.IsNull(),
-			"plan_is_unknown":  plan.Preset.IsUnknown(),
-			"state_is_null":    state.Preset.IsNull(),
-			"state_is_unknown": state.Preset.IsUnknown(),
-		})
 	}
 
 	// Handle revision_number: if there are no changes, use state value to prevent false positives
@@ -791,6 +829,105 @@ func (r *dashboardResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
+// planPresetChanges summarizes by panel how the planned preset differs from
+// statePreset and reports it as a warning on preset, since the JSON diff of a
+// large preset cannot be reviewed in the plan output.
+func planPresetChanges(ctx context.Context, plan dashboardResourceModel, statePreset string, resp *resource.ModifyPlanResponse) {
+	ignorePaths, diags := dashboardIgnoreJsonPaths(ctx, plan.IgnoreJsonPaths)
+	if diags.HasError() {
+		return
+	}
+	changes, err := diffDashboardPresets(statePreset, plan.Preset.ValueString(), ignorePaths)
+	if err != nil || changes.empty() {
+		return
+	}
+	tflog.Info(ctx, "ModifyPlan: Dashboard preset changes", map[string]interface{}{
+		"uuid":             plan.UUID.ValueString(),
+		"panels_added":     changes.Added,
+		"panels_removed":   changes.Removed,
+		"panels_changed":   changes.Changed,
+		"panels_moved":     changes.Moved,
+		"settings_changed": changes.Settings,
+	})
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("preset"),
+		"Dashboard Preset Changes",
+		fmt.Sprintf("The preset of dashboard %q changes:\n%s", plan.Name.ValueString(), changes),
+	)
+}
+
+// dashboardFieldsChanged reports whether plan changes a dashboard field other
+// than preset that is sent to the API.
+func dashboardFieldsChanged(plan, state dashboardResourceModel) bool {
+	return !plan.Name.Equal(state.Name) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.Team.Equal(state.Team) ||
+		!plan.Tags.Equal(state.Tags) ||
+		!plan.TagsAll.Equal(state.TagsAll) ||
+		!plan.Variables.Equal(state.Variables)
+}
+
+// planTagsAll sets tags_all from the planned tags and the provider's
+// default_tags. It stays unknown while tags is unknown.
+func (r *dashboardResource) planTagsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.List
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() || tags.IsUnknown() {
+		return
+	}
+	configured, diags := tagsToStringSlice(ctx, tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll := types.ListNull(types.StringType)
+	if all := dashboardTagsAll(configured, r.defaultTags); len(all) > 0 {
+		tagsAll, diags = types.ListValueFrom(ctx, types.StringType, all)
+		resp.Diagnostics.Append(diags...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// tagsForAPI returns the tags sent for the tags attribute, with the
+// provider's default_tags added.
+func (r *dashboardResource) tagsForAPI(ctx context.Context, tags types.List) ([]string, diag.Diagnostics) {
+	configured, diags := tagsToStringSlice(ctx, tags)
+	if diags.HasError() || len(r.defaultTags) == 0 {
+		return configured, diags
+	}
+	return dashboardTagsAll(configured, r.defaultTags), diags
+}
+
+// tagsToState returns tags and tags_all for the tags the API returned. The
+// default tags the provider added are not reported in tags.
+func (r *dashboardResource) tagsToState(ctx context.Context, apiTags []string, priorTags, priorTagsAll types.List) (types.List, types.List, diag.Diagnostics) {
+	configured, diags := tagsToStringSlice(ctx, priorTags)
+	if diags.HasError() {
+		return priorTags, priorTagsAll, diags
+	}
+	tags, tagDiags := tagsToState(ctx, dashboardTagsWithoutDefaults(apiTags, configured, r.defaultTags), priorTags)
+	diags.Append(tagDiags...)
+	tagsAll, tagDiags := tagsToState(ctx, apiTags, priorTagsAll)
+	diags.Append(tagDiags...)
+	return tags, tagsAll, diags
+}
+
+// dashboardIgnoreJsonPaths returns the entries of the ignore_json_paths
+// attribute. A null or unknown list yields no paths.
+func dashboardIgnoreJsonPaths(ctx context.Context, paths types.List) ([]string, diag.Diagnostics) {
+	if paths.IsNull() || paths.IsUnknown() {
+		return nil, nil
+	}
+	var values []string
+	diags := paths.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
 // tagsToStringSlice converts the Terraform tags list into a []string for the
 // API request. A null or unknown list yields nil so the request omits tags
 // entirely (matching an untagged dashboard). Non-empty lists are canonicalized
@@ -857,6 +994,35 @@ func tagsToState(ctx context.Context, apiTags []string, prior types.List) (types
 	return types.ListValueFrom(ctx, types.StringType, apiTags)
 }
 
+// renderDashboardPresetFromModel renders the preset with the values from the
+// Terraform variables map. A null or unknown map leaves the preset unchanged.
+func renderDashboardPresetFromModel(ctx context.Context, preset string, variables types.Map) (string, diag.Diagnostics) {
+	if variables.IsNull() || variables.IsUnknown() {
+		return preset, nil
+	}
+	values := make(map[string]string, len(variables.Elements()))
+	diags := variables.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return preset, diags
+	}
+	return renderDashboardPreset(preset, values), diags
+}
+
+// renderDashboardPreset replaces each `${name}` placeholder in preset with the
+// JSON-escaped value of variables[name]. Placeholders without a matching
+// variable are left as-is, since presets may carry their own `${...}` syntax.
+func renderDashboardPreset(preset string, variables map[string]string) string {
+	if len(variables) == 0 {
+		return preset
+	}
+	replacements := make([]string, 0, len(variables)*2)
+	for name, value := range variables {
+		escaped, _ := json.Marshal(value)
+		replacements = append(replacements, "${"+name+"}", string(escaped[1:len(escaped)-1]))
+	}
+	return strings.NewReplacer(replacements...).Replace(preset)
+}
+
 // getPreview returns a preview of a string, useful for logging long JSON strings
 func getPreview(s string, maxLen int) string {
 	if len(s) <= maxLen {
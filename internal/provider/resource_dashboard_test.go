@@ -545,3 +545,21 @@ resource "groundcover_dashboard" "test" {
 }
 `, name, tagsHCL)
 }
+
+func TestRenderDashboardPreset(t *testing.T) {
+	preset := `{"title":"${env} overview","query":"cluster=\"${cluster}\"","own":"${unset}"}`
+
+	got := renderDashboardPreset(preset, map[string]string{
+		"env":     "prod",
+		"cluster": `eu "west"`,
+	})
+
+	want := `{"title":"prod overview","query":"cluster=\"eu \"west\"\"","own":"${unset}"}`
+	if got != want {
+		t.Fatalf("renderDashboardPreset() = %s, want %s", got, want)
+	}
+
+	if got := renderDashboardPreset(preset, nil); got != preset {
+		t.Fatalf("renderDashboardPreset() with no variables changed the preset: %s", got)
+	}
+}
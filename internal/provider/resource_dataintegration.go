@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,36 +22,69 @@ import (
 
 // Ensure resource implements required interfaces
 var (
-	_ resource.Resource                = &dataIntegrationResource{}
-	_ resource.ResourceWithConfigure   = &dataIntegrationResource{}
-	_ resource.ResourceWithImportState = &dataIntegrationResource{}
+	_ resource.Resource                   = &dataIntegrationResource{}
+	_ resource.ResourceWithConfigure      = &dataIntegrationResource{}
+	_ resource.ResourceWithImportState    = &dataIntegrationResource{}
+	_ resource.ResourceWithModifyPlan     = &dataIntegrationResource{}
+	_ resource.ResourceWithValidateConfig = &dataIntegrationResource{}
 )
 
+// NewDataIntegrationResource returns groundcover_data_integration.
 func NewDataIntegrationResource() resource.Resource {
+	return &dataIntegrationResource{renamed: true}
+}
+
+// NewDeprecatedDataIntegrationResource returns groundcover_dataintegration,
+// the original name of groundcover_data_integration, kept so existing
+// configurations keep working until they move to the new name.
+func NewDeprecatedDataIntegrationResource() resource.Resource {
 	return &dataIntegrationResource{}
 }
 
 type dataIntegrationResource struct {
-	client ApiClient
+	client         ApiClient
+	defaultCluster string
+	// defaultTags are the provider's default_tags, added to the integration's tags.
+	defaultTags map[string]string
+	// renamed registers the resource as groundcover_data_integration instead
+	// of the deprecated groundcover_dataintegration.
+	renamed bool
 }
 
 type dataIntegrationResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Type      types.String `tfsdk:"type"`
-	Cluster   types.String `tfsdk:"cluster"`
-	Config    types.String `tfsdk:"config"`
-	IsPaused  types.Bool   `tfsdk:"is_paused"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
-	UpdatedBy types.String `tfsdk:"updated_by"`
+	ID              types.String         `tfsdk:"id"`
+	Type            types.String         `tfsdk:"type"`
+	Cluster         types.String         `tfsdk:"cluster"`
+	ClusterSelector types.Map            `tfsdk:"cluster_selector"`
+	Config          jsontypes.Normalized `tfsdk:"config"`
+	IsPaused        types.Bool           `tfsdk:"is_paused"`
+	PauseSchedule   types.Object         `tfsdk:"pause_schedule"`
+	Tags            types.Map            `tfsdk:"tags"`
+	TagsAll         types.Map            `tfsdk:"tags_all"`
+	UpdatedAt       types.String         `tfsdk:"updated_at"`
+	UpdatedBy       types.String         `tfsdk:"updated_by"`
 }
 
 func (r *dataIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dataintegration"
+	resp.TypeName = req.ProviderTypeName + r.typeNameSuffix()
+}
+
+func (r *dataIntegrationResource) typeNameSuffix() string {
+	if r.renamed {
+		return "_data_integration"
+	}
+	return "_dataintegration"
 }
 
 func (r *dataIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	var deprecationMessage string
+	if !r.renamed {
+		deprecationMessage = "groundcover_dataintegration is deprecated and will be removed in a future major version. Use groundcover_data_integration instead, with a `moved` block from the old address to move existing integrations without recreating them."
+	}
+
 	resp.Schema = schema.Schema{
-		Description: "DataIntegration resource for managing groundcover's integrations with external services such as cloud providers, databases and more. This resource is composed of general metadata on the integration and a specific configuration per data source. Navigate to the relevant nested schema according to your specific needs.",
+		DeprecationMessage: deprecationMessage,
+		Description:        "DataIntegration resource for managing groundcover's integrations with external services such as cloud providers, databases and more. This resource is composed of general metadata on the integration and a specific configuration per data source. Navigate to the relevant nested schema according to your specific needs.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the data integration configuration.",
@@ -64,22 +101,37 @@ func (r *dataIntegrationResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"cluster": schema.StringAttribute{
-				Description: "The cluster where the data integration runs. If unspecified, it will run in the backend.",
+				Description: "The cluster where the data integration runs. If unspecified, the cluster `cluster_selector` selects is used, or else, when the integration is created, the provider's `default_cluster`; if that is also unset, it will run in the backend. An integration created in `default_cluster`, or in the backend, is not moved when `default_cluster` is set or changed. Removing `cluster` or `cluster_selector` from the configuration replaces the integration, as it is then planned as a new one.",
 				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"cluster_selector": dataIntegrationClusterSelectorAttribute(),
 			"config": schema.StringAttribute{
-				Description: "The JSON configuration for the data integration.",
+				Description: "The JSON configuration for the data integration. Formatting and key order are not significant: a configuration the API returns in a different layout is not reported as a change. At plan time, the required fields of `cloudwatch` (`roleArn`, `regions`), `gcpmetrics` (`projectIDs`), `azuremetrics` (`subscriptions`), and `prometheusscrape` (`staticTargets` or `httpDiscovery`) configs are checked, along with list fields and the `scrapeInterval`/`scrapeTimeout` durations of every type.",
 				Required:    true,
+				CustomType:  jsontypes.NormalizedType{},
 			},
 			"is_paused": schema.BoolAttribute{
-				Description: "Whether the data integration is paused. Default: `false`.",
+				Description: "Whether the data integration is paused. Default: `false`. Set by the provider when `pause_schedule` is used, in which case a change is planned as known after apply.",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"pause_schedule": dataIntegrationPauseScheduleAttribute(),
+			"tags": schema.MapAttribute{
+				Description: "Tags attached to the data integration, for inventory and cost attribution. Merged over the provider's `default_tags`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tags_all": schema.MapAttribute{
+				Description: "The tags the data integration is sent with: `tags` merged over the provider's `default_tags`. A key set in `tags` takes precedence.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"updated_at": schema.StringAttribute{
 				Description: "The last update timestamp of the data integration configuration.",
 				Computed:    true,
@@ -107,6 +159,11 @@ func (r *dataIntegrationResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 	r.client = client
+
+	if pc, ok := req.ProviderData.(*providerClient); ok {
+		r.defaultCluster = pc.defaultCluster
+		r.defaultTags = pc.defaultTags
+	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -121,10 +178,33 @@ func (r *dataIntegrationResource) Create(ctx context.Context, req resource.Creat
 
 	tflog.Debug(ctx, "Creating DataIntegration", map[string]any{"type": plan.Type.ValueString()})
 
+	tagsAll, diags := r.tagsAll(ctx, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isPaused, diags := dataIntegrationPausedNow(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create request model
 	createReq := &models.CreateDataIntegrationConfigRequest{
 		Config:   plan.Config.ValueString(),
-		IsPaused: plan.IsPaused.ValueBool(),
+		IsPaused: isPaused,
+		Tags:     dataIntegrationTagsForAPI(tagsAll),
+	}
+
+	// The selector is resolved now when it was unknown at plan time.
+	if plan.Cluster.IsUnknown() && !plan.ClusterSelector.IsNull() {
+		cluster, diags := r.resolveClusterSelector(ctx, plan.ClusterSelector, "")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.Cluster = types.StringValue(cluster)
 	}
 
 	// Set pointer fields only if they are not null
@@ -143,14 +223,29 @@ func (r *dataIntegrationResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Remember that the cluster came from default_cluster, so that a later
+	// default_cluster does not move the integration.
+	if r.defaultCluster != "" && plan.Cluster.ValueString() == r.defaultCluster && plan.ClusterSelector.IsNull() {
+		var configCluster types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster"), &configCluster)...)
+		if configCluster.IsNull() {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, dataIntegrationClusterSourceKey, dataIntegrationDefaultClusterSource)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Map response back to plan
 	plan.ID = types.StringValue(createdConfig.ID)
 	// Handle nullable fields using StringPointerValue
 	plan.Cluster = types.StringPointerValue(createdConfig.Cluster)
-	plan.Config = types.StringValue(createdConfig.Config)
+	plan.Config = jsontypes.NewNormalizedValue(createdConfig.Config)
 	plan.UpdatedAt = types.StringValue(createdConfig.UpdateTimestamp.String())
 	plan.UpdatedBy = types.StringValue(createdConfig.UpdatedBy)
 	plan.IsPaused = types.BoolValue(createdConfig.IsPaused)
+	plan.TagsAll, diags = stringMapValue(ctx, tagsAll)
+	resp.Diagnostics.Append(diags...)
 
 	tflog.Debug(ctx, fmt.Sprintf("DataIntegration created with ID: %s", createdConfig.ID))
 
@@ -201,10 +296,15 @@ func (r *dataIntegrationResource) Read(ctx context.Context, req resource.ReadReq
 	state.Type = types.StringValue(configEntry.Type)
 	// Handle nullable fields using StringPointerValue
 	state.Cluster = types.StringPointerValue(configEntry.Cluster)
-	state.Config = types.StringValue(configEntry.Config)
+	state.Config = jsontypes.NewNormalizedValue(configEntry.Config)
 	state.IsPaused = types.BoolValue(configEntry.IsPaused)
 	state.UpdatedAt = types.StringValue(configEntry.UpdateTimestamp.String())
 	state.UpdatedBy = types.StringValue(configEntry.UpdatedBy)
+	state.Tags, state.TagsAll, diags = r.tagsToState(ctx, configEntry.Tags, state.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -227,10 +327,23 @@ func (r *dataIntegrationResource) Update(ctx context.Context, req resource.Updat
 
 	tflog.Debug(ctx, "Updating DataIntegration", map[string]any{"id": plan.ID.ValueString(), "type": plan.Type.ValueString()})
 
+	tagsAll, diags := r.tagsAll(ctx, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isPaused, diags := dataIntegrationPausedNow(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create update request model
 	updateReq := &models.CreateDataIntegrationConfigRequest{
 		Config:   plan.Config.ValueString(),
-		IsPaused: plan.IsPaused.ValueBool(),
+		IsPaused: isPaused,
+		Tags:     dataIntegrationTagsForAPI(tagsAll),
 	}
 
 	// Set pointer fields only if they are not null
@@ -252,10 +365,12 @@ func (r *dataIntegrationResource) Update(ctx context.Context, req resource.Updat
 	// Update state
 	// Handle nullable fields using StringPointerValue
 	plan.Cluster = types.StringPointerValue(updatedConfig.Cluster)
-	plan.Config = types.StringValue(updatedConfig.Config)
+	plan.Config = jsontypes.NewNormalizedValue(updatedConfig.Config)
 	plan.IsPaused = types.BoolValue(updatedConfig.IsPaused)
 	plan.UpdatedAt = types.StringValue(updatedConfig.UpdateTimestamp.String())
 	plan.UpdatedBy = types.StringValue(updatedConfig.UpdatedBy)
+	plan.TagsAll, diags = stringMapValue(ctx, tagsAll)
+	resp.Diagnostics.Append(diags...)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &plan)
@@ -301,6 +416,193 @@ func (r *dataIntegrationResource) Delete(ctx context.Context, req resource.Delet
 	tflog.Debug(ctx, fmt.Sprintf("Successfully deleted DataIntegration resource with ID %s", state.ID.ValueString()))
 }
 
+// ValidateConfig checks config against the rules known for its integration
+// type, and checks pause_schedule and rejects combining it with is_paused.
+func (r *dataIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dataIntegrationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Type.IsUnknown() && !config.Config.IsNull() && !config.Config.IsUnknown() {
+		validateDataIntegrationConfig(config.Type.ValueString(), config.Config.ValueString(), &resp.Diagnostics)
+	}
+
+	if config.PauseSchedule.IsNull() {
+		return
+	}
+
+	if !config.IsPaused.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("is_paused"),
+			"Conflicting Pause Settings",
+			"is_paused cannot be set together with pause_schedule, which decides whether the integration is paused.",
+		)
+	}
+	validateDataIntegrationPauseSchedule(ctx, config.PauseSchedule, &resp.Diagnostics)
+}
+
+// ModifyPlan plans tags_all from tags and is_paused from pause_schedule, and
+// fills in the cluster cluster_selector selects, or for a new integration the
+// provider's default_cluster, when the configuration leaves cluster unset. An
+// integration created in default_cluster, or in the backend, stays where it
+// is when default_cluster is set or changed, rather than every such
+// integration being replaced; a cluster removed from the configuration is
+// planned as for a new integration. Because cluster is Computed, the
+// attribute-level RequiresReplace never sees this value, so a change against
+// the prior state is flagged here.
+func (r *dataIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !tags.IsUnknown() {
+		tagsAll, diags := r.tagsAll(ctx, tags)
+		resp.Diagnostics.Append(diags...)
+		plannedTagsAll, diags := stringMapValue(ctx, tagsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), plannedTagsAll)...)
+	}
+
+	r.planCluster(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planDataIntegrationPaused(ctx, req, resp, time.Now())
+}
+
+// planCluster plans cluster when the configuration leaves it unset.
+func (r *dataIntegrationResource) planCluster(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var configCluster types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster"), &configCluster)...)
+	if resp.Diagnostics.HasError() || !configCluster.IsNull() {
+		return
+	}
+
+	var stateCluster types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cluster"), &stateCluster)...)
+	}
+	var selector types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster_selector"), &selector)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plannedCluster := defaultClusterValue(r.defaultCluster)
+	switch {
+	case selector.IsNull():
+		if req.State.Raw.IsNull() {
+			break
+		}
+		source, diags := req.Private.GetKey(ctx, dataIntegrationClusterSourceKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Only a cluster the configuration or cluster_selector chose is
+		// planned again; one that came from default_cluster is kept.
+		if stateCluster.IsNull() || bytes.Equal(source, dataIntegrationDefaultClusterSource) {
+			plannedCluster = stateCluster
+		}
+	case selector.IsUnknown() || r.client == nil:
+		plannedCluster = types.StringUnknown()
+	default:
+		cluster, diags := r.resolveClusterSelector(ctx, selector, stateCluster.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plannedCluster = types.StringValue(cluster)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cluster"), plannedCluster)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if !stateCluster.Equal(plannedCluster) {
+		tflog.Debug(ctx, "DataIntegration cluster changed via cluster_selector or provider default_cluster, requiring replacement", map[string]any{
+			"state_cluster":   stateCluster.ValueString(),
+			"planned_cluster": plannedCluster.ValueString(),
+		})
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("cluster"))
+	}
+}
+
+// tagsAll returns the tags attribute merged over the provider's default_tags.
+func (r *dataIntegrationResource) tagsAll(ctx context.Context, tags types.Map) (map[string]string, diag.Diagnostics) {
+	var configured map[string]string
+	var diags diag.Diagnostics
+	if !tags.IsNull() && !tags.IsUnknown() {
+		diags = tags.ElementsAs(ctx, &configured, false)
+	}
+	return mergeDefaultTags(r.defaultTags, configured), diags
+}
+
+// tagsToState returns tags and tags_all for the tags the API returned. The
+// default tags the provider added are not reported in tags.
+func (r *dataIntegrationResource) tagsToState(ctx context.Context, apiTags map[string]any, priorTags types.Map) (types.Map, types.Map, diag.Diagnostics) {
+	var remote map[string]string
+	if len(apiTags) > 0 {
+		remote = make(map[string]string, len(apiTags))
+		for key, value := range apiTags {
+			remote[key] = fmt.Sprint(value)
+		}
+	}
+	tagsAll, diags := stringMapValue(ctx, remote)
+
+	var configured map[string]string
+	if !priorTags.IsNull() && !priorTags.IsUnknown() {
+		diags.Append(priorTags.ElementsAs(ctx, &configured, false)...)
+	}
+	tags := withoutDefaultTags(remote, configured, r.defaultTags)
+	if len(tags) == 0 && priorTags.IsNull() {
+		return priorTags, tagsAll, diags
+	}
+	tagsValue, tagDiags := stringMapValue(ctx, tags)
+	diags.Append(tagDiags...)
+	return tagsValue, tagsAll, diags
+}
+
+// dataIntegrationTagsForAPI returns tags in the form of the API's tags field.
+func dataIntegrationTagsForAPI(tags map[string]string) map[string]any {
+	if tags == nil {
+		return nil
+	}
+	result := make(map[string]any, len(tags))
+	for key, value := range tags {
+		result[key] = value
+	}
+	return result
+}
+
+// dataIntegrationClusterSourceKey is the private state key recording that the
+// cluster of an integration came from the provider's default_cluster, with the
+// value dataIntegrationDefaultClusterSource.
+const dataIntegrationClusterSourceKey = "cluster_source"
+
+var dataIntegrationDefaultClusterSource = []byte(`"default_cluster"`)
+
+// defaultClusterValue returns the planned cluster for a configuration that leaves
+// cluster unset: the provider default when one is configured, otherwise null so
+// the integration runs in the backend.
+func defaultClusterValue(defaultCluster string) types.String {
+	if defaultCluster == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(defaultCluster)
+}
+
 // ImportState imports an existing resource into Terraform state.
 func (r *dataIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import format: "type:id" (e.g., "cloudwatch:abc123")
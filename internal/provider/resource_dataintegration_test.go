@@ -7,11 +7,25 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccDataIntegrationResource(t *testing.T) {
@@ -81,6 +95,70 @@ func TestAccDataIntegrationResource_disappears(t *testing.T) {
 	})
 }
 
+func TestAccDataIntegrationResource_cluster(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-cloudwatch-cluster")
+	step := func(defaultCluster, cluster string, action plancheck.ResourceActionType, check resource.TestCheckFunc) resource.TestStep {
+		return resource.TestStep{
+			Config: testAccDataIntegrationResourceClusterConfig(name, defaultCluster, cluster),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{plancheck.ExpectResourceAction("groundcover_dataintegration.test", action)},
+			},
+			Check: check,
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			step("prod-0", "", plancheck.ResourceActionCreate,
+				resource.TestCheckResourceAttr("groundcover_dataintegration.test", "cluster", "prod-0")),
+			// An integration created in the default stays there.
+			step("prod-1", "", plancheck.ResourceActionNoop,
+				resource.TestCheckResourceAttr("groundcover_dataintegration.test", "cluster", "prod-0")),
+			step("prod-1", "pinned", plancheck.ResourceActionReplace,
+				resource.TestCheckResourceAttr("groundcover_dataintegration.test", "cluster", "pinned")),
+			// Removing cluster puts the integration back on the backend.
+			step("", "", plancheck.ResourceActionReplace,
+				resource.TestCheckNoResourceAttr("groundcover_dataintegration.test", "cluster")),
+			// An integration running in the backend stays there.
+			step("prod-1", "", plancheck.ResourceActionNoop,
+				resource.TestCheckNoResourceAttr("groundcover_dataintegration.test", "cluster")),
+		},
+	})
+}
+
+// testAccDataIntegrationResourceClusterConfig returns an integration in
+// cluster, under a provider with defaultCluster. Empty values are left unset.
+func testAccDataIntegrationResourceClusterConfig(name, defaultCluster, cluster string) string {
+	var provider, clusterAttr string
+	if defaultCluster != "" {
+		provider = fmt.Sprintf(`
+provider "groundcover" {
+  default_cluster = %q
+}
+`, defaultCluster)
+	}
+	if cluster != "" {
+		clusterAttr = fmt.Sprintf("cluster = %q", cluster)
+	}
+	return provider + fmt.Sprintf(`
+resource "groundcover_dataintegration" "test" {
+  type = "cloudwatch"
+  %[2]s
+  config = jsonencode({
+    version = 1
+    name = %[1]q
+    exporters = ["prometheus"]
+    scrapeInterval = "5m"
+    stsRegion = "us-east-1"
+    regions = ["us-east-1"]
+    roleArn = "arn:aws:iam::123456789012:role/test-role"
+  })
+}
+`, name, clusterAttr)
+}
+
 func testAccDataIntegrationResourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "groundcover_dataintegration" "test" {
@@ -236,3 +314,340 @@ func testAccDataIntegrationImportStateIdFunc(s *terraform.State) (string, error)
 	// Import format: "type:id"
 	return fmt.Sprintf("%s:%s", rs.Primary.Attributes["type"], rs.Primary.ID), nil
 }
+
+func TestDefaultClusterValue(t *testing.T) {
+	if got := defaultClusterValue(""); !got.IsNull() {
+		t.Errorf("defaultClusterValue(\"\") = %s, want null", got)
+	}
+	if got := defaultClusterValue("prod-cluster"); got.ValueString() != "prod-cluster" {
+		t.Errorf("defaultClusterValue(\"prod-cluster\") = %s, want \"prod-cluster\"", got)
+	}
+}
+
+// testDataIntegrationModel returns a cloudwatch integration that sets neither
+// cluster nor cluster_selector, as configured, and as planned for a new
+// integration.
+func testDataIntegrationModel(ctx context.Context, s *schema.Schema) dataIntegrationResourceModel {
+	pauseScheduleType := s.Attributes["pause_schedule"].GetType().(types.ObjectType)
+	return dataIntegrationResourceModel{
+		ID:              types.StringUnknown(),
+		Type:            types.StringValue("cloudwatch"),
+		Cluster:         types.StringNull(),
+		ClusterSelector: types.MapNull(types.StringType),
+		Config:          jsontypes.NewNormalizedValue(`{"roleArn":"arn:aws:iam::123456789012:role/gc","regions":["us-east-1"]}`),
+		IsPaused:        types.BoolValue(false),
+		PauseSchedule:   types.ObjectNull(pauseScheduleType.AttrTypes),
+		Tags:            types.MapNull(types.StringType),
+		TagsAll:         types.MapUnknown(types.StringType),
+		UpdatedAt:       types.StringUnknown(),
+		UpdatedBy:       types.StringUnknown(),
+	}
+}
+
+// testDataIntegrationPlanRequest returns a ModifyPlan request for planned,
+// which is also used as the configuration, against the prior state, or for a
+// new integration when state is nil, and the response to fill in. Leave
+// cluster null in planned to have ModifyPlan plan it.
+func testDataIntegrationPlanRequest(t *testing.T, r *dataIntegrationResource, planned dataIntegrationResourceModel, state *dataIntegrationResourceModel) (fwresource.ModifyPlanRequest, *fwresource.ModifyPlanResponse) {
+	t.Helper()
+	ctx := context.Background()
+	s := resourceSchema(ctx, r)
+	plan := tfsdk.Plan{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	require.False(t, plan.Set(ctx, planned).HasError())
+	prior := tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if state != nil {
+		require.False(t, prior.Set(ctx, state).HasError())
+	}
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: *s, Raw: plan.Raw},
+		Plan:   plan,
+		State:  prior,
+	}
+	return req, &fwresource.ModifyPlanResponse{Plan: plan}
+}
+
+// testDataIntegrationModifyPlan runs ModifyPlan for the request
+// testDataIntegrationPlanRequest returns.
+func testDataIntegrationModifyPlan(t *testing.T, r *dataIntegrationResource, planned dataIntegrationResourceModel, state *dataIntegrationResourceModel) *fwresource.ModifyPlanResponse {
+	t.Helper()
+	req, resp := testDataIntegrationPlanRequest(t, r, planned, state)
+	r.ModifyPlan(context.Background(), req, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	return resp
+}
+
+// testExistingDataIntegration returns model as stored in state after it was
+// created.
+func testExistingDataIntegration(model dataIntegrationResourceModel) dataIntegrationResourceModel {
+	model.ID = types.StringValue("integration-1")
+	model.TagsAll = types.MapNull(types.StringType)
+	model.UpdatedAt = types.StringValue("2026-01-01T00:00:00Z")
+	model.UpdatedBy = types.StringValue("terraform")
+	return model
+}
+
+func TestDataIntegrationModifyPlanDefaultCluster(t *testing.T) {
+	ctx := context.Background()
+	r := &dataIntegrationResource{defaultCluster: "prod-1"}
+	model := testDataIntegrationModel(ctx, resourceSchema(ctx, r))
+	plannedCluster := func(resp *fwresource.ModifyPlanResponse) types.String {
+		var cluster types.String
+		require.False(t, resp.Plan.GetAttribute(ctx, path.Root("cluster"), &cluster).HasError())
+		return cluster
+	}
+
+	resp := testDataIntegrationModifyPlan(t, r, model, nil)
+	assert.Equal(t, types.StringValue("prod-1"), plannedCluster(resp), "a new integration runs in the default cluster")
+
+	// Setting default_cluster leaves an integration that runs in the backend
+	// where it is, rather than replacing it.
+	state := testExistingDataIntegration(model)
+	resp = testDataIntegrationModifyPlan(t, r, state, &state)
+	assert.True(t, plannedCluster(resp).IsNull())
+	assert.Empty(t, resp.RequiresReplace)
+
+	// Removing cluster from the configuration replaces the integration,
+	// running it in the default cluster like a new one. Integrations created
+	// in the default are covered by TestAccDataIntegrationResource_cluster,
+	// since that is recorded in private state.
+	planned := state
+	state.Cluster = types.StringValue("pinned")
+	resp = testDataIntegrationModifyPlan(t, r, planned, &state)
+	assert.Equal(t, types.StringValue("prod-1"), plannedCluster(resp))
+	assert.Equal(t, path.Paths{path.Root("cluster")}, resp.RequiresReplace)
+
+	r.defaultCluster = ""
+	resp = testDataIntegrationModifyPlan(t, r, planned, &state)
+	assert.True(t, plannedCluster(resp).IsNull())
+	assert.Equal(t, path.Paths{path.Root("cluster")}, resp.RequiresReplace)
+}
+
+func testPauseSchedule(t *testing.T, timezone string, timeframes ...timeframeModel) types.Object {
+	t.Helper()
+	values := make([]attr.Value, 0, len(timeframes))
+	for _, tf := range timeframes {
+		values = append(values, types.ObjectValueMust(timeframeObjectAttrTypes, map[string]attr.Value{
+			"day":        tf.Day,
+			"start_time": tf.StartTime,
+			"end_time":   tf.EndTime,
+		}))
+	}
+	return types.ObjectValueMust(
+		map[string]attr.Type{
+			"timezone":   types.StringType,
+			"timeframes": types.SetType{ElemType: timeframeObjectType},
+		},
+		map[string]attr.Value{
+			"timezone":   types.StringValue(timezone),
+			"timeframes": types.SetValueMust(timeframeObjectType, values),
+		},
+	)
+}
+
+func testTimeframe(day, start, end string) timeframeModel {
+	return timeframeModel{Day: types.StringValue(day), StartTime: types.StringValue(start), EndTime: types.StringValue(end)}
+}
+
+func TestDataIntegrationPausedAt(t *testing.T) {
+	ctx := context.Background()
+	// Outside business hours on weekdays, and all of Saturday and Sunday.
+	schedule := testPauseSchedule(t, "America/New_York",
+		testTimeframe("monday", "18:00", "08:00"),
+		testTimeframe("friday", "18:00", "00:00"),
+		testTimeframe("saturday", "00:00", "00:00"),
+		testTimeframe("sunday", "00:00", "00:00"),
+	)
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"monday working hours", time.Date(2026, 3, 2, 12, 0, 0, 0, newYork), false},
+		{"monday evening", time.Date(2026, 3, 2, 18, 0, 0, 0, newYork), true},
+		{"tuesday before the window ends", time.Date(2026, 3, 3, 7, 59, 0, 0, newYork), true},
+		{"tuesday when the window ends", time.Date(2026, 3, 3, 8, 0, 0, 0, newYork), false},
+		{"tuesday evening has no window", time.Date(2026, 3, 3, 20, 0, 0, 0, newYork), false},
+		{"friday night", time.Date(2026, 3, 6, 23, 30, 0, 0, newYork), true},
+		{"saturday", time.Date(2026, 3, 7, 12, 0, 0, 0, newYork), true},
+		{"evaluated in the schedule's timezone", time.Date(2026, 3, 3, 12, 30, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := dataIntegrationPausedAt(ctx, schedule, tt.at)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("dataIntegrationPausedAt(%s) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+
+	everyDay := testPauseSchedule(t, "UTC", testTimeframe(dailyTimeframeKey, "01:00", "02:00"))
+	if got, _ := dataIntegrationPausedAt(ctx, everyDay, time.Date(2026, 3, 4, 1, 30, 0, 0, time.UTC)); !got {
+		t.Error("every_day timeframe should match any day")
+	}
+}
+
+func TestPlanDataIntegrationPaused(t *testing.T) {
+	ctx := context.Background()
+	r := &dataIntegrationResource{}
+	// The window starts between the plan and the apply.
+	atPlan := time.Date(2026, 3, 2, 17, 59, 0, 0, time.UTC)
+	atApply := time.Date(2026, 3, 2, 18, 1, 0, 0, time.UTC)
+	model := testDataIntegrationModel(ctx, resourceSchema(ctx, r))
+	model.PauseSchedule = testPauseSchedule(t, "UTC", testTimeframe(dailyTimeframeKey, "18:00", "08:00"))
+	resumed := testExistingDataIntegration(model)
+
+	plan := func(planned dataIntegrationResourceModel, state *dataIntegrationResourceModel, now time.Time) (dataIntegrationResourceModel, bool) {
+		req, resp := testDataIntegrationPlanRequest(t, r, planned, state)
+		planDataIntegrationPaused(ctx, req, resp, now)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		var result dataIntegrationResourceModel
+		require.False(t, resp.Plan.Get(ctx, &result).HasError())
+		return result, resp.Plan.Raw.Equal(req.State.Raw)
+	}
+
+	t.Run("new integration", func(t *testing.T) {
+		planned, _ := plan(model, nil, atPlan)
+		assert.True(t, planned.IsPaused.IsUnknown())
+	})
+
+	t.Run("no change while the schedule is followed", func(t *testing.T) {
+		planned, unchanged := plan(resumed, &resumed, atPlan)
+		assert.True(t, unchanged)
+		assert.Equal(t, types.BoolValue(false), planned.IsPaused)
+	})
+
+	t.Run("window started", func(t *testing.T) {
+		planned, _ := plan(resumed, &resumed, atApply)
+		assert.True(t, planned.IsPaused.IsUnknown())
+	})
+
+	t.Run("other change across the window boundary", func(t *testing.T) {
+		changed := resumed
+		changed.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")})
+		changed.UpdatedAt = types.StringUnknown()
+		changed.UpdatedBy = types.StringUnknown()
+
+		// The plan and the plan Terraform makes again at apply time must
+		// agree, whichever side of the boundary each falls on.
+		initial, _ := plan(changed, &resumed, atPlan)
+		final, _ := plan(changed, &resumed, atApply)
+		assert.True(t, initial.IsPaused.IsUnknown())
+		assert.True(t, final.IsPaused.IsUnknown())
+	})
+
+	t.Run("no change inside the window", func(t *testing.T) {
+		paused := resumed
+		paused.IsPaused = types.BoolValue(true)
+		// The default of is_paused is false, so the framework plans an
+		// update of the computed attributes.
+		planned := resumed
+		planned.UpdatedAt = types.StringUnknown()
+		planned.UpdatedBy = types.StringUnknown()
+
+		result, unchanged := plan(planned, &paused, atApply)
+		assert.True(t, unchanged)
+		assert.Equal(t, types.BoolValue(true), result.IsPaused)
+	})
+}
+
+func TestValidateDataIntegrationPauseSchedule(t *testing.T) {
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	validateDataIntegrationPauseSchedule(ctx, testPauseSchedule(t, "UTC", testTimeframe("monday", "09:00", "17:00")), &diags)
+	if diags.HasError() {
+		t.Fatalf("valid schedule rejected: %v", diags)
+	}
+
+	diags = nil
+	validateDataIntegrationPauseSchedule(ctx, testPauseSchedule(t, "Mars/Olympus",
+		testTimeframe("Monday", "9:00", "17:00"),
+	), &diags)
+	if diags.ErrorsCount() != 3 {
+		t.Fatalf("expected errors for the timezone, day, and start_time, got %v", diags)
+	}
+}
+
+func TestValidateDataIntegrationConfig(t *testing.T) {
+	tests := []struct {
+		name            string
+		integrationType string
+		config          string
+		wantErrors      []string
+		wantWarnings    int
+	}{
+		{
+			name:            "valid cloudwatch",
+			integrationType: "cloudwatch",
+			config:          `{"roleArn":"arn:aws:iam::123456789012:role/r","regions":["us-east-1"],"scrapeInterval":"5m","exporters":["prometheus"]}`,
+		},
+		{
+			name:            "cloudwatch missing fields",
+			integrationType: "cloudwatch",
+			config:          `{"regions":[],"stsRegion":"us-east-1"}`,
+			wantErrors:      []string{"missing required fields: regions, roleArn"},
+		},
+		{
+			name:            "regions must be a list of strings",
+			integrationType: "cloudwatch",
+			config:          `{"roleArn":"arn","regions":"us-east-1"}`,
+			wantErrors:      []string{`"regions" must be a list of strings`},
+		},
+		{
+			name:            "prometheus needs targets or discovery",
+			integrationType: "prometheusscrape",
+			config:          `{"scrapeTimeout":10000000000}`,
+			wantErrors:      []string{"missing required fields: staticTargets or httpDiscovery"},
+		},
+		{
+			name:            "malformed duration string",
+			integrationType: "prometheusscrape",
+			config:          `{"staticTargets":["t:9090"],"scrapeInterval":"5 minutes"}`,
+			wantErrors:      []string{`"scrapeInterval" must be a positive duration`},
+		},
+		{
+			name:            "fractional nanoseconds",
+			integrationType: "gcpmetrics",
+			config:          `{"projectIDs":["p"],"scrapeInterval":1.5}`,
+			wantErrors:      []string{`"scrapeInterval" must be a positive whole number of nanoseconds`},
+		},
+		{
+			name:            "duration written in seconds",
+			integrationType: "azuremetrics",
+			config:          `{"subscriptions":["s"],"scrapeInterval":300}`,
+			wantWarnings:    1,
+		},
+		{
+			name:            "unknown type only gets common checks",
+			integrationType: "rds_enhanced",
+			config:          `{"exporters":"prometheus"}`,
+			wantErrors:      []string{`"exporters" must be a list of strings`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateDataIntegrationConfig(tt.integrationType, tt.config, &diags)
+
+			if diags.ErrorsCount() != len(tt.wantErrors) {
+				t.Fatalf("got %d errors, want %d: %v", diags.ErrorsCount(), len(tt.wantErrors), diags)
+			}
+			for i, want := range tt.wantErrors {
+				if got := diags.Errors()[i].Detail(); !strings.Contains(got, want) {
+					t.Errorf("error %d = %q, want it to contain %q", i, got, want)
+				}
+			}
+			if diags.WarningsCount() != tt.wantWarnings {
+				t.Errorf("got %d warnings, want %d: %v", diags.WarningsCount(), tt.wantWarnings, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
+)
+
+// incidentWebhookAppType is the connected app type behind groundcover_incident_webhook.
+const incidentWebhookAppType = "webhook"
+
+// incidentWebhookMaxPayloadBytes is the largest payload template the API accepts.
+const incidentWebhookMaxPayloadBytes = 64 * 1024
+
+var (
+	_ resource.Resource                   = &incidentWebhookResource{}
+	_ resource.ResourceWithConfigure      = &incidentWebhookResource{}
+	_ resource.ResourceWithImportState    = &incidentWebhookResource{}
+	_ resource.ResourceWithValidateConfig = &incidentWebhookResource{}
+)
+
+func NewIncidentWebhookResource() resource.Resource {
+	return &incidentWebhookResource{}
+}
+
+// incidentWebhookResource manages a connected app of type webhook through
+// typed attributes instead of the free-form data of groundcover_connected_app.
+type incidentWebhookResource struct {
+	client ApiClient
+}
+
+type incidentWebhookResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	URL             types.String `tfsdk:"url"`
+	Method          types.String `tfsdk:"method"`
+	Headers         types.Map    `tfsdk:"headers"`
+	PayloadTemplate types.String `tfsdk:"payload_template"`
+	BearerToken     types.String `tfsdk:"bearer_token"`
+	BasicAuth       types.Object `tfsdk:"basic_auth"`
+	DataHash        types.String `tfsdk:"data_hash"`
+	ForceDelete     types.Bool   `tfsdk:"force_delete"`
+}
+
+type incidentWebhookBasicAuthModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+var incidentWebhookBasicAuthAttrTypes = map[string]attr.Type{
+	"username": types.StringType,
+	"password": types.StringType,
+}
+
+func (r *incidentWebhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_webhook"
+}
+
+func (r *incidentWebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generic outbound webhook that delivers alerts to in-house incident tooling. It is a connected app of type `webhook`, so its `id` is used in `groundcover_notification_route` `connected_apps` with `type = \"webhook\"`, and it appears in the `groundcover_connected_app` data source. " +
+			"groundcover does not sign webhook payloads; authenticate deliveries with `bearer_token`, `basic_auth`, or a secret header.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the connected app behind the webhook.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the webhook.",
+				Required:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The http or https URL alerts are delivered to.",
+				Required:    true,
+				Validators:  []validator.String{validators.HTTPURL()},
+			},
+			"method": schema.StringAttribute{
+				Description: "The HTTP method of each delivery: `GET`, `POST`, `PUT`, or `DELETE`. Defaults to `POST`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("POST"),
+				Validators:  []validator.String{stringvalidator.OneOf("GET", "POST", "PUT", "DELETE")},
+			},
+			"headers": schema.MapAttribute{
+				Description: "Headers sent with each delivery, such as a shared secret the receiver checks. Sensitive, since headers often carry credentials.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"payload_template": schema.StringAttribute{
+				Description: "The body of each delivery, as a Jinja2 template that renders to JSON, such as `jsonencode({ title = \"{{ alert.title }}\" })` with placeholders for the alert's fields. At most 64 KiB. When unset, groundcover sends its default alert payload.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(1, incidentWebhookMaxPayloadBytes)},
+			},
+			"bearer_token": schema.StringAttribute{
+				Description: "A token sent as `Authorization: Bearer <token>`. Conflicts with `basic_auth`.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("basic_auth")),
+				},
+			},
+			"basic_auth": schema.SingleNestedAttribute{
+				Description: "Credentials sent with HTTP basic authentication. Conflicts with `bearer_token`.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description: "The basic authentication username.",
+						Required:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "The basic authentication password.",
+						Required:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"data_hash": schema.StringAttribute{
+				Description: "SHA-256 hash of the stored webhook configuration, secrets included, computed by groundcover. When it changes outside Terraform, refresh reads the webhook's settings back and clears its secrets in state, so the next apply restores the configuration.",
+				Computed:    true,
+			},
+			"force_delete": forceDeleteAttribute(connectedAppDependentsDescription),
+		},
+	}
+}
+
+func (r *incidentWebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config incidentWebhookResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Headers.IsNull() || config.Headers.IsUnknown() {
+		return
+	}
+	if config.BearerToken.IsNull() && config.BasicAuth.IsNull() {
+		return
+	}
+	for name := range config.Headers.Elements() {
+		if http.CanonicalHeaderKey(name) == "Authorization" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("headers").AtMapKey(name),
+				"Conflicting Authorization Header",
+				"The Authorization header is set from bearer_token or basic_auth, so it cannot also be set in headers.",
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *incidentWebhookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *incidentWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan incidentWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := incidentWebhookData(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating incident webhook", map[string]any{"name": plan.Name.ValueString()})
+
+	name, appType := plan.Name.ValueString(), incidentWebhookAppType
+	created, err := r.client.CreateConnectedApp(ctx, &models.CreateConnectedAppRequest{Name: &name, Type: &appType, Data: data})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating incident webhook", err.Error())
+		return
+	}
+
+	app, err := r.client.GetConnectedApp(ctx, created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading created incident webhook", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(app.ID)
+	plan.DataHash = incidentWebhookDataHash(app)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *incidentWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state incidentWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.client.GetConnectedApp(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			tflog.Warn(ctx, "Incident webhook not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading incident webhook", err.Error())
+		return
+	}
+	if app.Type != incidentWebhookAppType {
+		resp.Diagnostics.AddError(
+			"Connected App Is Not a Webhook",
+			fmt.Sprintf("Connected app %s has type %q; groundcover_incident_webhook only manages connected apps of type %q. Use groundcover_connected_app for it instead.", app.ID, app.Type, incidentWebhookAppType),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(app.ID)
+	state.Name = types.StringValue(app.Name)
+	// The API redacts secrets on read, so the settings in state are kept
+	// unless data_hash shows the stored configuration changed, or the
+	// webhook was just imported and state holds none.
+	if state.URL.IsNull() || connectedAppDataDrifted(state.DataHash, app.DataHash) {
+		tflog.Info(ctx, "Reading incident webhook settings from groundcover", map[string]any{"id": app.ID})
+		resp.Diagnostics.Append(incidentWebhookFromData(ctx, app.Data, &state)...)
+	}
+	state.DataHash = incidentWebhookDataHash(app)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *incidentWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan incidentWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := incidentWebhookData(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating incident webhook", map[string]any{"id": plan.ID.ValueString()})
+
+	// The API replaces data as a whole, so the complete configuration is sent.
+	name, appType := plan.Name.ValueString(), incidentWebhookAppType
+	err := r.client.UpdateConnectedApp(ctx, plan.ID.ValueString(), &models.UpdateConnectedAppRequest{Name: &name, Type: &appType, Data: data})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating incident webhook", err.Error())
+		return
+	}
+
+	app, err := r.client.GetConnectedApp(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading updated incident webhook", err.Error())
+		return
+	}
+	plan.DataHash = incidentWebhookDataHash(app)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *incidentWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state incidentWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkDeleteDependents(ctx, state.ForceDelete, "incident webhook", state.ID.ValueString(), connectedAppDependents(r.client, state.ID.ValueString()), &resp.Diagnostics) {
+		return
+	}
+
+	err := r.client.DeleteConnectedApp(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			tflog.Warn(ctx, "Incident webhook already deleted externally, treating as success", map[string]any{"id": state.ID.ValueString()})
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting incident webhook", err.Error())
+	}
+}
+
+func (r *incidentWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// incidentWebhookData returns the connected app data of the webhook, in the
+// keys of the webhook connected app type.
+func incidentWebhookData(ctx context.Context, model incidentWebhookResourceModel) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	data := map[string]any{
+		"url":    model.URL.ValueString(),
+		"method": model.Method.ValueString(),
+	}
+	if !model.Headers.IsNull() {
+		var headers map[string]string
+		diags.Append(model.Headers.ElementsAs(ctx, &headers, false)...)
+		data["headers"] = headers
+	}
+	if !model.PayloadTemplate.IsNull() {
+		data["custom_payload"] = model.PayloadTemplate.ValueString()
+	}
+	switch {
+	case !model.BearerToken.IsNull():
+		data["auth_type"] = "bearer"
+		data["api_key"] = model.BearerToken.ValueString()
+	case !model.BasicAuth.IsNull():
+		var basicAuth incidentWebhookBasicAuthModel
+		diags.Append(model.BasicAuth.As(ctx, &basicAuth, basetypes.ObjectAsOptions{})...)
+		data["auth_type"] = "basic"
+		data["username"] = basicAuth.Username.ValueString()
+		data["password"] = basicAuth.Password.ValueString()
+	}
+	return data, diags
+}
+
+// incidentWebhookFromData sets the webhook's settings in model from the
+// connected app data the API returned. Secrets are redacted on read, so they
+// are cleared, and a configured secret is planned to be sent again.
+func incidentWebhookFromData(ctx context.Context, appData any, model *incidentWebhookResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data, _ := appData.(map[string]any)
+	stringAt := func(key string) types.String {
+		if value, ok := data[key].(string); ok && value != "" {
+			return types.StringValue(value)
+		}
+		return types.StringNull()
+	}
+
+	model.URL = stringAt("url")
+	model.Method = stringAt("method")
+	if model.Method.IsNull() {
+		model.Method = types.StringValue("POST")
+	}
+	model.PayloadTemplate = stringAt("custom_payload")
+	model.Headers = types.MapNull(types.StringType)
+	model.BearerToken = types.StringNull()
+	model.BasicAuth = types.ObjectNull(incidentWebhookBasicAuthAttrTypes)
+	if stringAt("auth_type").ValueString() == "basic" {
+		// The username is kept so the plan only shows the password being set.
+		basicAuth, objectDiags := types.ObjectValue(incidentWebhookBasicAuthAttrTypes, map[string]attr.Value{
+			"username": stringAt("username"),
+			"password": types.StringNull(),
+		})
+		diags.Append(objectDiags...)
+		model.BasicAuth = basicAuth
+	}
+	tflog.Debug(ctx, "Read incident webhook settings", map[string]any{"url": model.URL.ValueString(), "method": model.Method.ValueString()})
+	return diags
+}
+
+func incidentWebhookDataHash(app *models.ConnectedAppResponse) types.String {
+	if app.DataHash == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(app.DataHash)
+}
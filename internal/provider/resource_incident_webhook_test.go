@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccIncidentWebhookResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-incident-webhook")
+	resourceName := "groundcover_incident_webhook.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentWebhookConfig(name, "https://incidents.example.com/hooks/groundcover"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "headers.X-Source", "groundcover"),
+					resource.TestCheckResourceAttrSet(resourceName, "payload_template"),
+					resource.TestCheckResourceAttrSet(resourceName, "data_hash"),
+				),
+			},
+			{
+				Config: testAccIncidentWebhookConfig(name, "https://incidents.example.com/hooks/groundcover-v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "url", "https://incidents.example.com/hooks/groundcover-v2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"headers", "bearer_token"},
+			},
+		},
+	})
+}
+
+func testAccIncidentWebhookConfig(name, url string) string {
+	return fmt.Sprintf(`
+resource "groundcover_incident_webhook" "test" {
+  name         = %[1]q
+  url          = %[2]q
+  bearer_token = "test-token"
+  headers = {
+    X-Source = "groundcover"
+  }
+  payload_template = jsonencode({
+    summary  = "{{ alert.title }}"
+    severity = "{{ alert.severity }}"
+  })
+}
+`, name, url)
+}
+
+func TestIncidentWebhookData(t *testing.T) {
+	ctx := context.Background()
+	model := incidentWebhookResourceModel{
+		Name:            types.StringValue("incidents"),
+		URL:             types.StringValue("https://incidents.example.com/hook"),
+		Method:          types.StringValue("PUT"),
+		Headers:         types.MapValueMust(types.StringType, map[string]attr.Value{"X-Secret": types.StringValue("s3cret")}),
+		PayloadTemplate: types.StringValue(`{"summary":"{{ alert.title }}"}`),
+		BearerToken:     types.StringNull(),
+		BasicAuth: types.ObjectValueMust(incidentWebhookBasicAuthAttrTypes, map[string]attr.Value{
+			"username": types.StringValue("gc"),
+			"password": types.StringValue("pass"),
+		}),
+	}
+
+	data, diags := incidentWebhookData(ctx, model)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, map[string]any{
+		"url":            "https://incidents.example.com/hook",
+		"method":         "PUT",
+		"headers":        map[string]string{"X-Secret": "s3cret"},
+		"custom_payload": `{"summary":"{{ alert.title }}"}`,
+		"auth_type":      "basic",
+		"username":       "gc",
+		"password":       "pass",
+	}, data)
+
+	// Reading the webhook back keeps its settings and clears its secrets.
+	var read incidentWebhookResourceModel
+	require.False(t, incidentWebhookFromData(ctx, data, &read).HasError())
+	assert.Equal(t, model.URL, read.URL)
+	assert.Equal(t, model.Method, read.Method)
+	assert.Equal(t, model.PayloadTemplate, read.PayloadTemplate)
+	assert.True(t, read.Headers.IsNull())
+	assert.True(t, read.BearerToken.IsNull())
+	assert.Equal(t, types.ObjectValueMust(incidentWebhookBasicAuthAttrTypes, map[string]attr.Value{
+		"username": types.StringValue("gc"),
+		"password": types.StringNull(),
+	}), read.BasicAuth)
+}
@@ -21,6 +21,7 @@ var (
 	_ resource.Resource                = &ingestionKeyResource{}
 	_ resource.ResourceWithConfigure   = &ingestionKeyResource{}
 	_ resource.ResourceWithImportState = &ingestionKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &ingestionKeyResource{}
 )
 
 func NewIngestionKeyResource() resource.Resource {
@@ -58,7 +59,7 @@ func (r *ingestionKeyResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the ingestion key.",
+				Description: "The name of the ingestion key. Must be unique; a plan that creates a key with a name already in use fails.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -283,6 +284,50 @@ func (r *ingestionKeyResource) Delete(ctx context.Context, req resource.DeleteRe
 	tflog.Debug(ctx, fmt.Sprintf("Successfully deleted Ingestion Key resource: %s", ingestionKeyName))
 }
 
+// ModifyPlan fails the plan when an ingestion key is created, or replaced for
+// a new name, with a name another ingestion key already uses.
+func (r *ingestionKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || name.IsUnknown() || name.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var stateName types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+		if resp.Diagnostics.HasError() || stateName.Equal(name) {
+			return
+		}
+	}
+
+	r.checkNameAvailable(ctx, name.ValueString(), &resp.Diagnostics)
+}
+
+// checkNameAvailable adds an error to diags when an ingestion key named name
+// exists. A failure to list the keys only logs a warning, leaving the error,
+// if any, to the apply.
+func (r *ingestionKeyResource) checkNameAvailable(ctx context.Context, name string, diags *diag.Diagnostics) {
+	keys, err := r.client.ListIngestionKeys(ctx, &models.ListIngestionKeysRequest{Name: name})
+	if err != nil {
+		tflog.Warn(ctx, "Could not list ingestion keys to check name uniqueness", map[string]any{"name": name, "error": err.Error()})
+		return
+	}
+	for _, key := range keys {
+		if key != nil && key.Name == name {
+			diags.AddAttributeError(
+				path.Root("name"),
+				"Ingestion Key Name Already In Use",
+				fmt.Sprintf("An ingestion key named %q already exists (type %q). Choose another name, or import it with: terraform import groundcover_ingestionkey.<name> %s", name, key.Type, name),
+			)
+			return
+		}
+	}
+}
+
 // ImportState imports the resource from its ID.
 func (r *ingestionKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -80,6 +81,44 @@ func TestAccIngestionKeyResource_disappears(t *testing.T) {
 	})
 }
 
+type listingIngestionKeyClient struct {
+	ApiClient
+	keys []*models.IngestionKeyResult
+	err  error
+	req  *models.ListIngestionKeysRequest
+}
+
+func (c *listingIngestionKeyClient) ListIngestionKeys(_ context.Context, req *models.ListIngestionKeysRequest) ([]*models.IngestionKeyResult, error) {
+	c.req = req
+	return c.keys, c.err
+}
+
+func TestIngestionKeyCheckNameAvailable(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  *listingIngestionKeyClient
+		wantErr bool
+	}{
+		{name: "unused", client: &listingIngestionKeyClient{}},
+		{name: "taken", client: &listingIngestionKeyClient{keys: []*models.IngestionKeyResult{{Name: "prod", Type: "sensor"}}}, wantErr: true},
+		{name: "only similar names", client: &listingIngestionKeyClient{keys: []*models.IngestionKeyResult{{Name: "prod-old"}, nil}}},
+		{name: "list fails", client: &listingIngestionKeyClient{err: fmt.Errorf("unavailable")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ingestionKeyResource{client: tt.client}
+			var diags diag.Diagnostics
+			r.checkNameAvailable(context.Background(), "prod", &diags)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("checkNameAvailable() diagnostics = %v, want error %v", diags, tt.wantErr)
+			}
+			if tt.client.req == nil || tt.client.req.Name != "prod" {
+				t.Fatalf("ListIngestionKeys request = %+v, want name filter prod", tt.client.req)
+			}
+		})
+	}
+}
+
 func testAccIngestionKeyResourceConfig(name string) string {
 	return testAccIngestionKeyResourceConfigWithType(name, "sensor")
 }
@@ -159,6 +198,9 @@ func testAccCheckIngestionKeyResourceDisappears(n string) resource.TestCheckFunc
 
 // testAccPreCheckIngestionKey verifies that required environment variables are set for ingestion key tests
 func testAccPreCheckIngestionKey(t *testing.T) {
+	if mockAPIEnabled() {
+		return // Started by testAccProtoV6ProviderFactoriesWithInCloudBackend.
+	}
 	if v := os.Getenv("GROUNDCOVER_API_KEY"); v == "" {
 		t.Fatal("GROUNDCOVER_API_KEY must be set for acceptance tests")
 	}
@@ -173,6 +215,11 @@ func testAccProtoV6ProviderFactoriesWithInCloudBackend(t *testing.T) map[string]
 	if os.Getenv("TF_ACC") == "" {
 		return testAccProtoV6ProviderFactories
 	}
+	// The mock API has a single backend, which serves ingestion keys too.
+	if mockAPIEnabled() {
+		useMockAPI(t)
+		return testAccProtoV6ProviderFactories
+	}
 
 	// Temporarily override GROUNDCOVER_BACKEND_ID with the in-cloud backend ID
 	inCloudBackendId := os.Getenv("GROUNDCOVER_INCLOUD_BACKEND_ID")
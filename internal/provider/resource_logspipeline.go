@@ -147,9 +147,9 @@ func (r *logsPipelineResource) Read(ctx context.Context, req resource.ReadReques
 		uuid = configEntry.UUID
 	}
 
-	// Update state
+	// Update state, keeping the configured YAML when the server only reformatted it
 	state.UpdatedAt = types.StringValue(createdAt)
-	state.Value = types.StringValue(value)
+	state.Value = types.StringValue(pipelineValueForState(ctx, state.Value.ValueString(), value))
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -257,6 +257,25 @@ func (r *logsPipelineResource) ModifyPlan(ctx context.Context, req resource.Modi
 	)
 }
 
+// pipelineValueForState returns the prior YAML when the API value is semantically
+// the same (key order, quoting, indentation, time formats), so server-side
+// re-serialization does not show up as drift. List order is significant and is
+// never ignored, since rule order changes pipeline behavior.
+func pipelineValueForState(ctx context.Context, priorValue, apiValue string) string {
+	if priorValue == "" || apiValue == "" {
+		return apiValue
+	}
+	same, err := CompareYamlSemantically(priorValue, apiValue)
+	if err != nil {
+		tflog.Debug(ctx, "Could not compare pipeline YAML semantically, using API value", map[string]any{"error": err.Error()})
+		return apiValue
+	}
+	if same {
+		return priorValue
+	}
+	return apiValue
+}
+
 func (r *logsPipelineResource) checkAndImportExisting(ctx context.Context, state *tfsdk.State, diags *diag.Diagnostics) (*models.LogsPipelineConfig, error) {
 	existingConfig, err := r.client.GetLogsPipeline(ctx)
 	if err != nil && err != ErrNotFound {
@@ -3,6 +3,7 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -21,6 +22,13 @@ func TestAccLogsPipelineResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("groundcover_logspipeline.test", "updated_at"),
 				),
 			},
+			// ImportState testing (singleton: any ID adopts the existing configuration)
+			{
+				ResourceName:     "groundcover_logspipeline.test",
+				ImportState:      true,
+				ImportStateId:    "default",
+				ImportStateCheck: testAccCheckSingletonImport("value", "updated_at"),
+			},
 			// Update and Read testing
 			{
 				Config: testAccLogsPipelineResourceConfigUpdated(),
@@ -34,6 +42,65 @@ func TestAccLogsPipelineResource(t *testing.T) {
 	})
 }
 
+// TestAccLogsPipelineResource_applyLoop applies the same configuration repeatedly
+// and checks that server-side re-serialization of the YAML never plans a change.
+func TestAccLogsPipelineResource_applyLoop(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogsPipelineResourceConfig(),
+			},
+			{
+				Config:   testAccLogsPipelineResourceConfig(),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccLogsPipelineResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("groundcover_logspipeline.test", "value"),
+				),
+			},
+			{
+				Config:   testAccLogsPipelineResourceConfig(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestPipelineValueForState(t *testing.T) {
+	ctx := context.Background()
+	configured := `ottlRules:
+- ruleName: test-rule
+  conditions:
+    - container_name == "nginx"
+  statements:
+    - set(attributes["test.key"], "test-value")
+`
+	reserialized := `ottlRules:
+    - conditions: ['container_name == "nginx"']
+      ruleName: "test-rule"
+      statements:
+        - set(attributes["test.key"], "test-value")
+`
+	reordered := `ottlRules:
+- ruleName: second
+- ruleName: test-rule
+`
+
+	if got := pipelineValueForState(ctx, configured, reserialized); got != configured {
+		t.Errorf("expected configured YAML to be kept for a reformatted API value, got %q", got)
+	}
+	if got := pipelineValueForState(ctx, configured, reordered); got != reordered {
+		t.Errorf("expected API value for a semantically different pipeline, got %q", got)
+	}
+	if got := pipelineValueForState(ctx, "", reserialized); got != reserialized {
+		t.Errorf("expected API value on import, got %q", got)
+	}
+}
+
 func testAccLogsPipelineResourceConfig() string {
 	return `
 resource "groundcover_logspipeline" "test" {
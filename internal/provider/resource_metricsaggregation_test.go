@@ -25,6 +25,13 @@ func TestAccMetricsAggregationResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("groundcover_metricsaggregation.test", "updated_at"),
 				),
 			},
+			// ImportState testing (singleton: any ID adopts the existing configuration)
+			{
+				ResourceName:     "groundcover_metricsaggregation.test",
+				ImportState:      true,
+				ImportStateId:    "default",
+				ImportStateCheck: testAccCheckSingletonImport("value", "updated_at"),
+			},
 			// Update and Read testing
 			{
 				Config: testAccMetricsAggregationResourceConfigUpdated(),
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,11 +29,57 @@ func NewMonitorResource() resource.Resource {
 
 type monitorResource struct {
 	client ApiClient
+	// requiredMonitorLabels mirrors the provider's required_monitor_labels setting.
+	requiredMonitorLabels []string
+	// notificationRoutes is the provider's shared notification route list.
+	notificationRoutes *notificationRouteCache
+	// features are the provider's features block settings.
+	features providerFeatures
+	// defaultTags are the provider's default_tags, added to the monitor's labels.
+	defaultTags map[string]string
+	// monitorDefaults are the provider's monitor_defaults, keyed by YAML path.
+	monitorDefaults map[string]string
 }
 
 type monitorResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	MonitorYaml types.String `tfsdk:"monitor_yaml"`
+	Id          types.String     `tfsdk:"id"`
+	MonitorYaml monitorYamlValue `tfsdk:"monitor_yaml"`
+
+	ExpandYamlAnchors  types.Bool   `tfsdk:"expand_yaml_anchors"`
+	Annotations        types.Map    `tfsdk:"annotations"`
+	ThresholdOverrides types.Map    `tfsdk:"threshold_overrides"`
+	OwnerTeam          types.String `tfsdk:"owner_team"`
+	OwnerSlackChannel  types.String `tfsdk:"owner_slack_channel"`
+	OwnerQuery         types.String `tfsdk:"owner_query"`
+	DestroyBehavior    types.String `tfsdk:"destroy_behavior"`
+	IgnoreYamlPaths    types.List   `tfsdk:"ignore_yaml_paths"`
+	StartPaused        types.Bool   `tfsdk:"start_paused"`
+	Reconcile          types.Bool   `tfsdk:"reconcile"`
+
+	DriftDetected     types.Bool   `tfsdk:"drift_detected"`
+	RemoteMonitorYaml types.String `tfsdk:"remote_monitor_yaml"`
+
+	NotificationRoutes types.List `tfsdk:"notification_routes"`
+	LabelsAll          types.Map  `tfsdk:"labels_all"`
+	DefaultsApplied    types.Map  `tfsdk:"defaults_applied"`
+}
+
+// monitorYamlForAPI returns the YAML that is sent to the API and compared with
+// what the API returns: monitorYaml itself, with its YAML anchors expanded
+// when expand_yaml_anchors is set, and threshold_overrides applied.
+func (m monitorResourceModel) monitorYamlForAPI(monitorYaml string) (string, error) {
+	if m.ExpandYamlAnchors.ValueBool() {
+		expanded, err := ExpandYamlAnchors(monitorYaml)
+		if err != nil {
+			return "", fmt.Errorf("unable to expand YAML anchors: %w", err)
+		}
+		monitorYaml = expanded
+	}
+	overridden, err := applyMonitorThresholdOverrides(monitorYaml, monitorThresholdOverrides(m.ThresholdOverrides))
+	if err != nil {
+		return "", fmt.Errorf("unable to apply threshold_overrides: %w", err)
+	}
+	return overridden, nil
 }
 
 func (r *monitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,12 +100,42 @@ func (r *monitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"monitor_yaml": schema.StringAttribute{
-				MarkdownDescription: "The monitor definition in YAML format.",
+				MarkdownDescription: "The monitor definition in YAML format. Formatting, key order, and fields added by the server are not significant: a definition the API returns in a different layout is not reported as a change.",
 				Required:            true,
+				CustomType:          monitorYamlType{},
 				PlanModifiers:       []planmodifier.String{},
 			},
+			"annotations": schema.MapAttribute{
+				MarkdownDescription: "Annotations merged into the monitor's `annotations` on create and update, such as `runbook_url` or a dashboard link. Lets a wrapper module inject shared metadata without templating `monitor_yaml`. A key may be set here or in `monitor_yaml`, not both. Refresh tracks only the keys set here; annotations set in `monitor_yaml` are compared there as usual.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"threshold_overrides": monitorThresholdOverridesAttribute(),
+			"expand_yaml_anchors": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, YAML anchors, aliases, and `<<` merge keys in `monitor_yaml` are expanded before the YAML is sent to the API and before it is compared with the monitor the API returns, which stores the expanded form. Set this for monitors written with anchors, whose comparison is otherwise undefined and can produce unstable diffs. `monitor_yaml` in state keeps the anchors as written. Defaults to `false`.",
+				Optional:            true,
+			},
+			"ignore_yaml_paths": monitorIgnoreYamlPathsAttribute(),
+			"start_paused": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the monitor is created paused, in the same request that creates it, so it cannot alert before its silences and notification routes are in place. Only applies on create: the monitor stays paused until it is resumed, for example in the UI or by setting `isPaused: false` in `monitor_yaml`, and changing this attribute later has no effect. Ignored when `monitor_yaml` sets `isPaused`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"destroy_behavior":    destroyBehaviorAttribute("monitor"),
+			"notification_routes": monitorNotificationRoutesAttribute(),
+			"labels_all": schema.MapAttribute{
+				MarkdownDescription: "The labels the monitor is sent with: the `labels` in `monitor_yaml` and the labels of `owner_team` and `owner_slack_channel`, merged over the provider's `default_tags`. These take precedence over a default tag with the same key. Refresh reads these keys back from the monitor, so a default tag removed outside Terraform is restored by the next apply.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"defaults_applied": monitorDefaultsAppliedAttribute(),
 		},
 	}
+	for name, attribute := range monitorReconcileAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+	for name, attribute := range monitorOwnerAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (r *monitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -75,6 +152,13 @@ func (r *monitorResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 	r.client = client
+	if pc, ok := req.ProviderData.(*providerClient); ok {
+		r.requiredMonitorLabels = pc.requiredMonitorLabels
+		r.notificationRoutes = pc.notificationRoutes
+		r.features = pc.features
+		r.defaultTags = pc.defaultTags
+		r.monitorDefaults = pc.monitorDefaults
+	}
 	tflog.Info(ctx, "monitor resource configured successfully")
 }
 
@@ -130,12 +214,34 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 		"has_trailing_nl": strings.HasSuffix(userInputMonitorYaml, "\n"),
 	})
 
-	createReq, normalizedApiYaml, err := buildCreateMonitorRequest(ctx, userInputMonitorYaml)
+	apiMonitorYaml, err := data.monitorYamlForAPI(userInputMonitorYaml)
+	if err != nil {
+		resp.Diagnostics.AddError("YAML Request Error", fmt.Sprintf("Unable to build monitor create request: %s", err))
+		return
+	}
+	data.DefaultsApplied = r.resolvedDefaultsApplied(ctx, data.DefaultsApplied, userInputMonitorYaml, &resp.Diagnostics)
+	apiMonitorYaml, err = withMonitorDefaults(ctx, apiMonitorYaml, data.DefaultsApplied)
 	if err != nil {
 		resp.Diagnostics.AddError("YAML Request Error", fmt.Sprintf("Unable to build monitor create request: %s", err))
 		return
 	}
 
+	createReq, normalizedApiYaml, err := buildCreateMonitorRequest(ctx, apiMonitorYaml)
+	if err != nil {
+		resp.Diagnostics.AddError("YAML Request Error", fmt.Sprintf("Unable to build monitor create request: %s", err))
+		return
+	}
+	annotations, diags := monitorAnnotations(ctx, data.Annotations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Annotations = mergeMonitorAnnotations(createReq.Annotations, annotations)
+	createReq.Labels = mergeDefaultTags(r.labelDefaults(data), createReq.Labels)
+	if data.StartPaused.ValueBool() && createReq.IsPaused == nil {
+		createReq.IsPaused = data.StartPaused.ValueBoolPointer()
+	}
+
 	// Log normalized YAML to show the transformation
 	// Note: Keys are sorted alphabetically, so order may differ from input
 	tflog.Debug(ctx, "Monitor YAML normalization: Normalized YAML", map[string]interface{}{
@@ -172,7 +278,11 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Store the user's original YAML to avoid Terraform consistency check errors
 	// The normalization will be handled in Read and ModifyPlan
-	data.MonitorYaml = types.StringValue(userInputMonitorYaml)
+	data.MonitorYaml = newMonitorYamlValue(userInputMonitorYaml)
+	data.NotificationRoutes = r.resolvedNotificationRoutes(ctx, data.NotificationRoutes, userInputMonitorYaml, &resp.Diagnostics)
+	data.LabelsAll = r.resolvedLabelsAll(ctx, data.LabelsAll, userInputMonitorYaml, r.labelDefaults(data), &resp.Diagnostics)
+	data.OwnerQuery = monitorOwnerQuery(data.OwnerTeam)
+	data.clearDrift()
 
 	tflog.Trace(ctx, "Created monitor resource from YAML", map[string]interface{}{"id": data.Id.ValueString()})
 
@@ -187,121 +297,46 @@ func derefString(s *string) string {
 	return *s
 }
 
-// detectAndHandleDrift performs drift detection between state and remote YAML
-// Only updates state when there's actual semantic drift, not formatting differences
+// detectAndHandleDrift stores the remote YAML in state. monitorYamlType's
+// semantic equality makes the framework keep the prior state YAML, and with it
+// the user's formatting (e.g. multiline pipe syntax `|`), unless the remote
+// YAML describes a different monitor. With expand_yaml_anchors the type cannot
+// see the anchors expanded, so the comparison is made here against the
+// expanded state YAML instead.
 func (r *monitorResource) detectAndHandleDrift(ctx context.Context, data *monitorResourceModel, remoteYamlBytes []byte) {
-	monitorId := data.Id.ValueString()
-	stateYaml := data.MonitorYaml.ValueString()
-
-	if stateYaml == "" || remoteYamlBytes == nil {
+	if data.MonitorYaml.ValueString() == "" || remoteYamlBytes == nil {
 		return
 	}
 
-	remoteYaml := string(remoteYamlBytes)
-
-	// Debug: Log the raw YAMLs being compared
-	tflog.Debug(ctx, "Drift detection: Raw YAML comparison", map[string]interface{}{
-		"id":              monitorId,
-		"state_yaml":      stateYaml,
-		"remote_yaml":     remoteYaml,
-		"state_yaml_len":  len(stateYaml),
-		"remote_yaml_len": len(remoteYaml),
-	})
-
-	// Filter remote YAML to only include keys that exist in the state
-	// This prevents drift detection from triggering on server-added default fields
-	filteredRemoteYaml, err := FilterYamlKeysBasedOnTemplate(ctx, remoteYaml, stateYaml)
-	if err != nil {
-		// If the error is due to unparseable state YAML, that's a more serious issue
-		if strings.Contains(err.Error(), "failed to parse template YAML") {
-			tflog.Error(ctx, "State YAML is unparseable - this indicates a serious issue", map[string]interface{}{
-				"id":    monitorId,
-				"error": err.Error(),
+	if data.ExpandYamlAnchors.ValueBool() {
+		expandedStateYaml, err := data.monitorYamlForAPI(data.MonitorYaml.ValueString())
+		if err == nil && monitorYamlSemanticallyEqual(ctx, expandedStateYaml, string(remoteYamlBytes)) {
+			tflog.Debug(ctx, "Drift detection: remote YAML matches the expanded state YAML, keeping state", map[string]interface{}{
+				"id": data.Id.ValueString(),
 			})
-			// For now, continue with fallback but this might warrant failing fast in the future
+			return
 		}
-
-		tflog.Warn(ctx, "Failed to filter remote YAML based on state template, using unfiltered remote YAML", map[string]interface{}{
-			"id":    monitorId,
-			"error": err.Error(),
-		})
-		filteredRemoteYaml = remoteYaml
-	}
-
-	// Normalize both YAMLs for comparison
-	normalizedStateYaml, err := NormalizeMonitorYaml(ctx, stateYaml)
-	if err != nil {
-		tflog.Warn(ctx, "Failed to normalize state YAML", map[string]interface{}{
-			"id":    monitorId,
-			"error": err.Error(),
-		})
-		normalizedStateYaml = stateYaml
-	}
-
-	normalizedFilteredRemoteYaml, err := NormalizeMonitorYaml(ctx, filteredRemoteYaml)
-	if err != nil {
-		tflog.Warn(ctx, "Failed to normalize filtered remote YAML", map[string]interface{}{
-			"id":    monitorId,
-			"error": err.Error(),
-		})
-		normalizedFilteredRemoteYaml = filteredRemoteYaml
 	}
 
-	// Debug: Log normalized YAMLs and their lengths
-	tflog.Debug(ctx, "Drift detection: After filtering and normalization", map[string]interface{}{
-		"id":                             monitorId,
-		"normalized_state_len":           len(normalizedStateYaml),
-		"normalized_filtered_remote_len": len(normalizedFilteredRemoteYaml),
-	})
-
-	// Use semantic comparison to detect real drift vs formatting differences
-	areSemanticallySame, err := CompareYamlSemantically(normalizedStateYaml, normalizedFilteredRemoteYaml)
-	if err != nil {
-		tflog.Warn(ctx, "Failed to perform semantic YAML comparison, falling back to string comparison", map[string]interface{}{
-			"id":    monitorId,
-			"error": err.Error(),
-		})
-		// Fallback to string comparison of normalized YAMLs
-		areSemanticallySame = (normalizedStateYaml == normalizedFilteredRemoteYaml)
+	// Overridden thresholds keep their monitor_yaml values; the remote values
+	// were read into threshold_overrides.
+	//
+	// The API returns keys in its own order. Store them in the order the state
+	// YAML uses, so a drift diff shows the changed values rather than every key
+	// moving. Comparisons normalize key order, so this only affects the layout.
+	remoteYaml := restoreOverriddenThresholds(ctx, string(remoteYamlBytes), data.MonitorYaml.ValueString(), data.ThresholdOverrides)
+	if ordered, err := OrderYamlKeysLikeTemplate(remoteYaml, data.MonitorYaml.ValueString()); err != nil {
+		tflog.Debug(ctx, "Drift detection: keeping remote key order", map[string]interface{}{"error": err.Error()})
+	} else {
+		remoteYaml = ordered
 	}
 
-	tflog.Debug(ctx, "Drift detection: Semantic comparison result", map[string]interface{}{
-		"id":                    monitorId,
-		"are_semantically_same": areSemanticallySame,
+	tflog.Debug(ctx, "Drift detection: storing remote YAML", map[string]interface{}{
+		"id":              data.Id.ValueString(),
+		"state_yaml_len":  len(data.MonitorYaml.ValueString()),
+		"remote_yaml_len": len(remoteYamlBytes),
 	})
-
-	// Debug: Save YAMLs to temp files for comparison
-	if !areSemanticallySame {
-		tflog.Debug(ctx, "Drift detection: YAML content for debugging", map[string]interface{}{
-			"id":                         monitorId,
-			"normalized_state_yaml":      normalizedStateYaml,
-			"normalized_filtered_remote": normalizedFilteredRemoteYaml,
-		})
-	}
-
-	if areSemanticallySame {
-		tflog.Debug(ctx, "No semantic drift detected between state and remote YAML", map[string]interface{}{
-			"id": monitorId,
-		})
-		// Keep the current state YAML format since there's no semantic difference
-		// This preserves the user's formatting preferences (e.g., multiline pipe syntax `|`)
-		// Note: State may have different formatting (e.g., `title: |\n  value`) than remote
-		// (e.g., `title: value`), but both normalize to the same semantic content, so no drift.
-		return
-	} else {
-		tflog.Info(ctx, "Semantic configuration drift detected", map[string]interface{}{
-			"id":                 monitorId,
-			"state_yaml_length":  len(normalizedStateYaml),
-			"remote_yaml_length": len(normalizedFilteredRemoteYaml),
-		})
-
-		// There's real drift - update state with the actual remote YAML
-		// Use the raw remote YAML to preserve the server's format
-		data.MonitorYaml = types.StringValue(remoteYaml)
-		tflog.Debug(ctx, "Updated state with remote YAML due to drift", map[string]interface{}{
-			"id": monitorId,
-		})
-	}
+	data.MonitorYaml = newMonitorYamlValue(remoteYaml)
 }
 
 func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -316,7 +351,7 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	monitorId := data.Id.ValueString()
 	tflog.Debug(ctx, "Reading monitor resource YAML", map[string]interface{}{"id": monitorId})
 
-	remoteYamlBytes, err := r.client.GetMonitor(ctx, monitorId)
+	remoteYamlBytes, err := r.client.GetMonitor(withMonitorRefresh(ctx), monitorId)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			tflog.Warn(ctx, fmt.Sprintf("Monitor %s not found (handled via ErrNotFound), removing from state", monitorId))
@@ -329,8 +364,39 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	tflog.Trace(ctx, "Read monitor resource YAML (confirmed existence)", map[string]interface{}{"id": monitorId})
 
-	// Enhanced drift detection: compare remote state with user's original YAML
-	r.detectAndHandleDrift(ctx, &data, remoteYamlBytes)
+	ignorePaths, diags := monitorIgnoreYamlPaths(ctx, data.IgnoreYamlPaths)
+	resp.Diagnostics.Append(diags...)
+	if len(ignorePaths) > 0 {
+		// Values at ignored paths are whatever state holds, so out-of-band
+		// changes there are not drift.
+		remoteYamlBytes = []byte(overlayIgnoredYamlPaths(ctx, string(remoteYamlBytes), data.MonitorYaml.ValueString(), ignorePaths))
+	}
+
+	if data.reconciles() {
+		data.Annotations, diags = refreshMonitorAnnotations(ctx, data.Annotations, string(remoteYamlBytes))
+		resp.Diagnostics.Append(diags...)
+		data.ThresholdOverrides, diags = refreshMonitorThresholdOverrides(data.ThresholdOverrides, string(remoteYamlBytes))
+		resp.Diagnostics.Append(diags...)
+		data.LabelsAll, diags = refreshMonitorLabelsAll(ctx, data.LabelsAll, data.MonitorYaml.ValueString(), string(remoteYamlBytes), r.labelDefaults(data))
+		resp.Diagnostics.Append(diags...)
+		data.DefaultsApplied, diags = refreshMonitorDefaultsApplied(ctx, data.DefaultsApplied, string(remoteYamlBytes))
+		resp.Diagnostics.Append(diags...)
+		refreshMonitorOwner(&data, string(remoteYamlBytes))
+
+		// Enhanced drift detection: compare remote state with user's original YAML
+		r.detectAndHandleDrift(ctx, &data, remoteYamlBytes)
+		data.clearDrift()
+	} else {
+		// Only report drift; state keeps what was applied, so apply does not
+		// revert the change.
+		r.auditDrift(ctx, &data, string(remoteYamlBytes), &resp.Diagnostics)
+	}
+
+	routes, diags := r.monitorNotificationRoutes(ctx, string(remoteYamlBytes))
+	resp.Diagnostics.Append(diags...)
+	if !routes.IsUnknown() {
+		data.NotificationRoutes = routes
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -347,21 +413,79 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	monitorId := state.Id.ValueString()
+	if plan.MonitorYaml.Equal(state.MonitorYaml) && plan.Annotations.Equal(state.Annotations) && plan.ThresholdOverrides.Equal(state.ThresholdOverrides) && plan.ExpandYamlAnchors.Equal(state.ExpandYamlAnchors) && plan.LabelsAll.Equal(state.LabelsAll) && plan.DefaultsApplied.Equal(state.DefaultsApplied) {
+		// Only destroy_behavior, ignore_yaml_paths, start_paused or reconcile
+		// changed; the monitor itself is unchanged.
+		state.DestroyBehavior = plan.DestroyBehavior
+		state.IgnoreYamlPaths = plan.IgnoreYamlPaths
+		state.StartPaused = plan.StartPaused
+		state.Reconcile = plan.Reconcile
+		if state.reconciles() || state.DriftDetected.IsNull() {
+			state.clearDrift()
+		}
+		state.NotificationRoutes = r.resolvedNotificationRoutes(ctx, plan.NotificationRoutes, state.MonitorYaml.ValueString(), &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
 	tflog.Debug(ctx, "Updating monitor resource from YAML", map[string]interface{}{"id": monitorId})
 
 	userInputMonitorYaml := plan.MonitorYaml.ValueString()
-	updateReq, _, err := buildUpdateMonitorRequest(ctx, userInputMonitorYaml)
+	apiMonitorYaml, err := plan.monitorYamlForAPI(userInputMonitorYaml)
 	if err != nil {
 		resp.Diagnostics.AddError("YAML Request Error", fmt.Sprintf("Unable to build monitor update request for monitor %s: %s", monitorId, err))
 		return
 	}
-
-	tflog.Debug(ctx, "Updating monitor via SDK with unmarshalled request", map[string]any{"id": monitorId, "title_from_yaml": derefString(updateReq.Title)})
-	err = r.client.UpdateMonitor(ctx, monitorId, updateReq)
+	plan.DefaultsApplied = r.resolvedDefaultsApplied(ctx, plan.DefaultsApplied, userInputMonitorYaml, &resp.Diagnostics)
+	apiMonitorYaml, err = withMonitorDefaults(ctx, apiMonitorYaml, plan.DefaultsApplied)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update monitor %s, got error: %s", monitorId, err.Error()))
+		resp.Diagnostics.AddError("YAML Request Error", fmt.Sprintf("Unable to build monitor update request for monitor %s: %s", monitorId, err))
+		return
+	}
+	ignorePaths, diags := monitorIgnoreYamlPaths(ctx, plan.IgnoreYamlPaths)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	sendUpdate := true
+	if len(ignorePaths) > 0 || !plan.reconciles() {
+		remoteYamlBytes, err := r.client.GetMonitor(ctx, monitorId)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read monitor %s before updating it, got error: %s", monitorId, err))
+			return
+		}
+		remoteYaml := string(remoteYamlBytes)
+		// Keep the monitor's current values at ignored paths rather than
+		// overwriting them with the configured ones.
+		apiMonitorYaml = overlayIgnoredYamlPaths(ctx, apiMonitorYaml, remoteYaml, ignorePaths)
+		if !plan.reconciles() {
+			comparedRemoteYaml := overlayIgnoredYamlPaths(ctx, remoteYaml, state.MonitorYaml.ValueString(), ignorePaths)
+			sendUpdate = !r.checkUnreconciledUpdate(ctx, plan, state, comparedRemoteYaml, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+	updateReq, _, err := buildUpdateMonitorRequest(ctx, apiMonitorYaml)
+	if err != nil {
+		resp.Diagnostics.AddError("YAML Request Error", fmt.Sprintf("Unable to build monitor update request for monitor %s: %s", monitorId, err))
+		return
+	}
+	annotations, diags := monitorAnnotations(ctx, plan.Annotations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateReq.Annotations = mergeMonitorAnnotations(updateReq.Annotations, annotations)
+	updateReq.Labels = mergeDefaultTags(r.labelDefaults(plan), updateReq.Labels)
+
+	if sendUpdate {
+		tflog.Debug(ctx, "Updating monitor via SDK with unmarshalled request", map[string]any{"id": monitorId, "title_from_yaml": derefString(updateReq.Title)})
+		err = r.client.UpdateMonitor(ctx, monitorId, updateReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update monitor %s, got error: %s", monitorId, err.Error()))
+			return
+		}
+	}
 
 	tflog.Trace(ctx, "Updated monitor resource from YAML", map[string]interface{}{"id": monitorId})
 
@@ -370,7 +494,11 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Store the user's original YAML to avoid Terraform consistency check errors
 	// The normalization will be handled in Read and ModifyPlan
-	updatedState.MonitorYaml = types.StringValue(userInputMonitorYaml)
+	updatedState.MonitorYaml = newMonitorYamlValue(userInputMonitorYaml)
+	updatedState.NotificationRoutes = r.resolvedNotificationRoutes(ctx, plan.NotificationRoutes, userInputMonitorYaml, &resp.Diagnostics)
+	updatedState.LabelsAll = r.resolvedLabelsAll(ctx, plan.LabelsAll, userInputMonitorYaml, r.labelDefaults(plan), &resp.Diagnostics)
+	updatedState.OwnerQuery = monitorOwnerQuery(plan.OwnerTeam)
+	updatedState.clearDrift()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedState)...)
 }
@@ -385,6 +513,9 @@ func (r *monitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	monitorId := data.Id.ValueString()
+	if abandonOnDestroy(r.features.destroyBehavior(data.DestroyBehavior), "Monitor", monitorId, &resp.Diagnostics) {
+		return
+	}
 	tflog.Debug(ctx, "Deleting monitor resource", map[string]interface{}{"id": monitorId})
 
 	err := r.client.DeleteMonitor(ctx, monitorId)
@@ -405,19 +536,86 @@ func (r *monitorResource) ImportState(ctx context.Context, req resource.ImportSt
 }
 
 func (r *monitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.features.checkDeleteProtection(ctx, req, "Monitor", &resp.Diagnostics)
+	r.checkRequiredLabels(ctx, req, &resp.Diagnostics)
+	checkMonitorAnnotationConflicts(ctx, req, &resp.Diagnostics)
+	checkMonitorThresholdOverrides(ctx, req, &resp.Diagnostics)
+	checkMonitorOwnerLabelConflicts(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.features.planMonitorReformatting {
+		r.suppressReformattedYaml(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	r.planLabelsAll(ctx, req, resp)
+	r.planDefaultsApplied(ctx, req, resp)
+	planOwnerQuery(ctx, req, resp)
+	r.planNotificationRoutes(ctx, req, resp)
+}
+
+// planLabelsAll sets labels_all from the planned YAML, owner labels and the
+// provider's default_tags. It stays unknown while the YAML or an owner label
+// is unknown, or the YAML does not parse.
+func (r *monitorResource) planLabelsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plannedYaml monitorYamlValue
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("monitor_yaml"), &plannedYaml)...)
+	if resp.Diagnostics.HasError() || plannedYaml.IsNull() || plannedYaml.IsUnknown() {
+		return
+	}
+	var owner monitorResourceModel
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("owner_team"), &owner.OwnerTeam)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("owner_slack_channel"), &owner.OwnerSlackChannel)...)
+	if resp.Diagnostics.HasError() || owner.OwnerTeam.IsUnknown() || owner.OwnerSlackChannel.IsUnknown() {
+		return
+	}
+	labels, ok := monitorLabelsAll(plannedYaml.ValueString(), r.labelDefaults(owner))
+	if !ok {
+		return
+	}
+	labelsAll, diags := stringMapValue(ctx, labels)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_all"), labelsAll)...)
+}
+
+// resolvedLabelsAll returns planned, or when it is unknown the labels_all of
+// the applied YAML with defaults.
+func (r *monitorResource) resolvedLabelsAll(ctx context.Context, planned types.Map, monitorYaml string, defaults map[string]string, diags *diag.Diagnostics) types.Map {
+	if !planned.IsUnknown() {
+		return planned
+	}
+	labels, ok := monitorLabelsAll(monitorYaml, defaults)
+	if !ok {
+		return types.MapNull(types.StringType)
+	}
+	labelsAll, mapDiags := stringMapValue(ctx, labels)
+	diags.Append(mapDiags...)
+	return labelsAll
+}
+
+// suppressReformattedYaml keeps the state YAML in the plan when the configured
+// YAML describes the same monitor.
+func (r *monitorResource) suppressReformattedYaml(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
 		tflog.Debug(ctx, "ModifyPlan: Skipping custom YAML diff for new or destroyed resource.")
 		return
 	}
 
-	var plannedYaml types.String
+	var plannedYaml monitorYamlValue
 	diags := req.Plan.GetAttribute(ctx, path.Root("monitor_yaml"), &plannedYaml)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var stateYaml types.String
+	var stateYaml monitorYamlValue
 	diags = req.State.GetAttribute(ctx, path.Root("monitor_yaml"), &stateYaml)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -429,56 +627,41 @@ func (r *monitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
-	plannedYamlString := plannedYaml.ValueString()
-	stateYamlString := stateYaml.ValueString()
-
-	if plannedYamlString == stateYamlString {
+	if plannedYaml.ValueString() == stateYaml.ValueString() {
 		tflog.Debug(ctx, "ModifyPlan: Raw YAML strings are identical.")
 		return
 	}
 
-	// Filter state YAML to only include keys that exist in the planned YAML
-	// This prevents ModifyPlan from triggering on server-added default fields
-	filteredStateYaml, err := FilterYamlKeysBasedOnTemplate(ctx, stateYamlString, plannedYamlString)
-	if err != nil {
-		// If the error is due to unparseable planned YAML, that's concerning
-		if strings.Contains(err.Error(), "failed to parse template YAML") {
-			tflog.Error(ctx, "ModifyPlan: Planned YAML is unparseable - this indicates a serious issue", map[string]interface{}{
-				"error": err.Error(),
-			})
-			// For now, continue with fallback but this might warrant failing fast in the future
-		}
-
-		tflog.Warn(ctx, "ModifyPlan: Failed to filter state YAML based on planned template, using unfiltered state YAML", map[string]interface{}{
-			"error": err.Error(),
-		})
-		filteredStateYaml = stateYamlString
+	var expandAnchors types.Bool
+	var ignorePathsList types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expand_yaml_anchors"), &expandAnchors)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ignore_yaml_paths"), &ignorePathsList)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	// Normalize both YAMLs for comparison
-	normalizedPlannedYaml, err := NormalizeMonitorYaml(ctx, plannedYamlString)
-	if err != nil {
-		tflog.Warn(ctx, "ModifyPlan: Failed to normalize planned YAML", map[string]interface{}{
-			"error": err.Error(),
-		})
-		normalizedPlannedYaml = plannedYamlString
+	ignorePaths, diags := monitorIgnoreYamlPaths(ctx, ignorePathsList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	normalizedFilteredStateYaml, err := NormalizeMonitorYaml(ctx, filteredStateYaml)
-	if err != nil {
-		tflog.Warn(ctx, "ModifyPlan: Failed to normalize filtered state YAML", map[string]interface{}{
-			"error": err.Error(),
-		})
-		normalizedFilteredStateYaml = filteredStateYaml
+	comparedYaml := plannedYaml
+	if len(ignorePaths) > 0 {
+		// A configuration change confined to ignored paths is not applied.
+		comparedYaml = newMonitorYamlValue(overlayIgnoredYamlPaths(ctx, plannedYaml.ValueString(), stateYaml.ValueString(), ignorePaths))
 	}
 
-	// Use semantic comparison to detect real changes vs formatting differences
-	areSemanticallySame, err := CompareYamlSemantically(normalizedPlannedYaml, normalizedFilteredStateYaml)
-	if err != nil {
-		tflog.Warn(ctx, "ModifyPlan: Failed to perform semantic YAML comparison, allowing update", map[string]interface{}{
-			"error": err.Error(),
-		})
-		return
+	// Terraform does not apply semantic equality while planning, so a YAML that
+	// was only reformatted in configuration is reconciled here with the type's
+	// own comparison.
+	var areSemanticallySame bool
+	if expandAnchors.ValueBool() {
+		areSemanticallySame = monitorYamlsEqualExpanded(ctx, comparedYaml.ValueString(), stateYaml.ValueString())
+	} else {
+		areSemanticallySame, diags = comparedYaml.StringSemanticEquals(ctx, stateYaml)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	if areSemanticallySame {
@@ -490,3 +673,42 @@ func (r *monitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		tflog.Info(ctx, "ModifyPlan: YAMLs have semantic differences. Plan will proceed with update.")
 	}
 }
+
+// monitorYamlsEqualExpanded reports whether two monitor YAMLs describe the same
+// monitor once their anchors are expanded. YAML that does not expand is only
+// equal to an identical string.
+func monitorYamlsEqualExpanded(ctx context.Context, configured, other string) bool {
+	expandedConfigured, err := ExpandYamlAnchors(configured)
+	if err != nil {
+		return configured == other
+	}
+	expandedOther, err := ExpandYamlAnchors(other)
+	if err != nil {
+		return configured == other
+	}
+	return monitorYamlSemanticallyEqual(ctx, expandedConfigured, expandedOther)
+}
+
+// checkRequiredLabels enforces required_monitor_labels on a planned create or
+// update. YAML that is unknown or does not parse is left to Create and Update,
+// which report it with more context.
+func (r *monitorResource) checkRequiredLabels(ctx context.Context, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	if len(r.requiredMonitorLabels) == 0 || req.Plan.Raw.IsNull() || planMatchesState(req.Plan, req.State) {
+		return
+	}
+
+	var plannedYaml monitorYamlValue
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("monitor_yaml"), &plannedYaml)...)
+	if diags.HasError() || plannedYaml.IsNull() || plannedYaml.IsUnknown() {
+		return
+	}
+
+	labels, err := monitorYamlLabels(plannedYaml.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "ModifyPlan: Skipping required label check for unparseable monitor YAML", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if missing := missingMonitorLabels(r.requiredMonitorLabels, labels); len(missing) > 0 {
+		addMissingMonitorLabelsError(diags, path.Root("monitor_yaml"), r.requiredMonitorLabels, missing)
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/customtypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -479,7 +480,7 @@ func TestMonitorV2BuildCreateRequestConnectedAppParams(t *testing.T) {
 		ConnectedAppParams:     connectedAppParams,
 		StatusFilters:          statusFilters,
 		DisableRenotification:  types.BoolValue(false),
-		RenotificationInterval: types.StringValue("4h"),
+		RenotificationInterval: customtypes.NewDurationValue("4h"),
 	}
 
 	req, buildDiags := buildMonitorV2CreateRequest(ctx, &plan)
@@ -600,8 +601,8 @@ func TestMonitorV2MapSDKToModelPreservesEquivalentConfiguredDurations(t *testing
 
 	state := monitorV2ResourceModel{
 		EvaluationInterval: &monitorV2EvaluationIntervalModel{
-			Interval:   types.StringValue("60 seconds"),
-			PendingFor: types.StringValue("1 minute"),
+			Interval:   customtypes.NewDurationValue("60 seconds"),
+			PendingFor: customtypes.NewDurationValue("1 minute"),
 		},
 		Query: &monitorV2QueryModel{
 			Rollup: &monitorV2RollupModel{
@@ -651,7 +652,7 @@ func TestMonitorV2MapSDKToModelPreservesEquivalentNotificationDuration(t *testin
 	state := monitorV2ResourceModel{
 		NotificationSettings: &monitorV2NotificationSettingsModel{
 			Method:                 types.StringValue("connectedApps"),
-			RenotificationInterval: types.StringValue("60m"),
+			RenotificationInterval: customtypes.NewDurationValue("60m"),
 		},
 	}
 	var diags diag.Diagnostics
@@ -684,8 +685,8 @@ func TestMonitorV2MapSDKToModelPreservesDayWeekDurations(t *testing.T) {
 
 	state := monitorV2ResourceModel{
 		EvaluationInterval: &monitorV2EvaluationIntervalModel{
-			Interval:   types.StringValue("1d"),
-			PendingFor: types.StringValue("1w"),
+			Interval:   customtypes.NewDurationValue("1d"),
+			PendingFor: customtypes.NewDurationValue("1w"),
 		},
 	}
 	var diags diag.Diagnostics
@@ -2175,3 +2176,91 @@ YAML
 }
 `, yaml)
 }
+
+func TestMissingMonitorLabels(t *testing.T) {
+	labels, err := monitorYamlLabels(`
+title: checkout errors
+labels:
+  team: payments
+  service: "   "
+  tier: 1
+`)
+	if err != nil {
+		t.Fatalf("monitorYamlLabels returned error: %v", err)
+	}
+	if labels["tier"] != "1" {
+		t.Fatalf("tier label = %q, want %q", labels["tier"], "1")
+	}
+
+	missing := missingMonitorLabels([]string{"team", "service", "owner"}, labels)
+	if want := []string{"service", "owner"}; fmt.Sprint(missing) != fmt.Sprint(want) {
+		t.Fatalf("missing = %v, want %v", missing, want)
+	}
+
+	noLabels, err := monitorYamlLabels("title: no labels\n")
+	if err != nil {
+		t.Fatalf("monitorYamlLabels returned error: %v", err)
+	}
+	if missing := missingMonitorLabels([]string{"team"}, noLabels); len(missing) != 1 {
+		t.Fatalf("missing = %v, want [team]", missing)
+	}
+}
+
+func TestPlannedMonitorLabels(t *testing.T) {
+	if _, ok := plannedMonitorLabels(types.MapUnknown(types.StringType)); ok {
+		t.Fatal("expected an unknown labels map to be skipped")
+	}
+
+	planned, ok := plannedMonitorLabels(types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team":    types.StringValue("payments"),
+		"service": types.StringUnknown(),
+	}))
+	if !ok {
+		t.Fatal("expected a known labels map to be checked")
+	}
+	if missing := missingMonitorLabels([]string{"team", "service", "owner"}, planned); fmt.Sprint(missing) != "[owner]" {
+		t.Fatalf("missing = %v, want [owner]", missing)
+	}
+
+	planned, ok = plannedMonitorLabels(types.MapNull(types.StringType))
+	if !ok || len(planned) != 0 {
+		t.Fatalf("null labels map = %v, %v; want empty, true", planned, ok)
+	}
+}
+
+func TestMonitorAnnotations(t *testing.T) {
+	ctx := context.Background()
+
+	merged := mergeMonitorAnnotations(map[string]string{"summary": "from yaml"}, map[string]string{"runbook_url": "https://runbooks/checkout"})
+	if len(merged) != 2 || merged["summary"] != "from yaml" || merged["runbook_url"] != "https://runbooks/checkout" {
+		t.Fatalf("mergeMonitorAnnotations() = %v", merged)
+	}
+	if got := mergeMonitorAnnotations(nil, map[string]string{"a": "b"}); got["a"] != "b" {
+		t.Fatalf("mergeMonitorAnnotations(nil) = %v", got)
+	}
+
+	current := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"runbook_url": types.StringValue("https://runbooks/checkout"),
+		"dashboard":   types.StringValue("https://app/dashboards/1"),
+	})
+	remoteYaml := `title: checkout errors
+annotations:
+  summary: from yaml
+  runbook_url: https://runbooks/checkout-v2
+`
+	refreshed, diags := refreshMonitorAnnotations(ctx, current, remoteYaml)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"runbook_url": types.StringValue("https://runbooks/checkout-v2"),
+	})
+	if !refreshed.Equal(want) {
+		t.Fatalf("refreshMonitorAnnotations() = %v, want %v", refreshed, want)
+	}
+
+	unmanaged, diags := refreshMonitorAnnotations(ctx, types.MapNull(types.StringType), remoteYaml)
+	if diags.HasError() || !unmanaged.IsNull() {
+		t.Fatalf("expected a null annotations attribute to stay null, got %v (%v)", unmanaged, diags)
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/customtypes"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -30,6 +32,7 @@ var _ resource.Resource = &monitorV2Resource{}
 var _ resource.ResourceWithConfigure = &monitorV2Resource{}
 var _ resource.ResourceWithImportState = &monitorV2Resource{}
 var _ resource.ResourceWithValidateConfig = &monitorV2Resource{}
+var _ resource.ResourceWithModifyPlan = &monitorV2Resource{}
 
 const (
 	monitorV2QueryTypeGCQL      = "gcql"
@@ -55,6 +58,8 @@ func NewMonitorV2Resource() resource.Resource {
 
 type monitorV2Resource struct {
 	client ApiClient
+	// requiredMonitorLabels mirrors the provider's required_monitor_labels setting.
+	requiredMonitorLabels []string
 }
 
 type monitorV2ResourceModel struct {
@@ -126,8 +131,8 @@ type monitorV2CustomResolveThresholdModel struct {
 }
 
 type monitorV2EvaluationIntervalModel struct {
-	Interval   types.String `tfsdk:"interval"`
-	PendingFor types.String `tfsdk:"pending_for"`
+	Interval   customtypes.Duration `tfsdk:"interval"`
+	PendingFor customtypes.Duration `tfsdk:"pending_for"`
 }
 
 type monitorV2DisplayModel struct {
@@ -139,12 +144,12 @@ type monitorV2DisplayModel struct {
 }
 
 type monitorV2NotificationSettingsModel struct {
-	Method                 types.String `tfsdk:"method"`
-	ConnectedApps          types.List   `tfsdk:"connected_apps"`
-	ConnectedAppParams     types.Map    `tfsdk:"connected_app_params"`
-	StatusFilters          types.List   `tfsdk:"status_filters"`
-	DisableRenotification  types.Bool   `tfsdk:"disable_renotification"`
-	RenotificationInterval types.String `tfsdk:"renotification_interval"`
+	Method                 types.String         `tfsdk:"method"`
+	ConnectedApps          types.List           `tfsdk:"connected_apps"`
+	ConnectedAppParams     types.Map            `tfsdk:"connected_app_params"`
+	StatusFilters          types.List           `tfsdk:"status_filters"`
+	DisableRenotification  types.Bool           `tfsdk:"disable_renotification"`
+	RenotificationInterval customtypes.Duration `tfsdk:"renotification_interval"`
 }
 
 type monitorV2ConnectedAppDeliveryOptionsModel struct {
@@ -396,13 +401,21 @@ func (r *monitorV2Resource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Attributes: map[string]schema.Attribute{
 					"interval": schema.StringAttribute{
 						MarkdownDescription: "How often the monitor evaluates, for example `1m`.",
+						CustomType:          customtypes.DurationType{},
 						Optional:            true,
 						Computed:            true,
+						Validators: []validator.String{
+							validators.DurationString(),
+						},
 					},
 					"pending_for": schema.StringAttribute{
 						MarkdownDescription: "How long the condition must remain true before alerting, for example `5m`.",
+						CustomType:          customtypes.DurationType{},
 						Optional:            true,
 						Computed:            true,
+						Validators: []validator.String{
+							validators.DurationString(),
+						},
 					},
 				},
 			},
@@ -522,7 +535,11 @@ func (r *monitorV2Resource) Schema(_ context.Context, _ resource.SchemaRequest,
 					},
 					"renotification_interval": schema.StringAttribute{
 						MarkdownDescription: "Duration between renotifications, for example `4h`.",
+						CustomType:          customtypes.DurationType{},
 						Optional:            true,
+						Validators: []validator.String{
+							validators.DurationString(),
+						},
 					},
 				},
 			},
@@ -582,6 +599,13 @@ func (r *monitorV2Resource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 	r.client = client
+	if pc, ok := req.ProviderData.(*providerClient); ok {
+		r.requiredMonitorLabels = pc.requiredMonitorLabels
+	}
+}
+
+func (r *monitorV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkMonitorV2RequiredLabels(ctx, r.requiredMonitorLabels, req, &resp.Diagnostics)
 }
 
 func (r *monitorV2Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -851,7 +875,7 @@ func (r *monitorV2Resource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	id := state.ID.ValueString()
-	if err := r.readMonitorV2IntoState(ctx, id, &state, &resp.Diagnostics); err != nil {
+	if err := r.readMonitorV2IntoState(withMonitorRefresh(ctx), id, &state, &resp.Diagnostics); err != nil {
 		if errors.Is(err, ErrNotFound) {
 			tflog.Warn(ctx, fmt.Sprintf("Monitor %s not found, removing from state", id))
 			resp.State.RemoveResource(ctx)
@@ -1105,10 +1129,10 @@ func monitorV2EvaluationIntervalToSDK(interval *monitorV2EvaluationIntervalModel
 	}
 
 	req := &models.EvaluationInterval{}
-	if parsed, ok := monitorV2ParseDuration(interval.Interval, path.Root("evaluation_interval").AtName("interval"), diags); ok {
+	if parsed, ok := monitorV2ParseDuration(interval.Interval.StringValue, path.Root("evaluation_interval").AtName("interval"), diags); ok {
 		req.Interval = strfmt.Duration(parsed)
 	}
-	if parsed, ok := monitorV2ParseDuration(interval.PendingFor, path.Root("evaluation_interval").AtName("pending_for"), diags); ok {
+	if parsed, ok := monitorV2ParseDuration(interval.PendingFor.StringValue, path.Root("evaluation_interval").AtName("pending_for"), diags); ok {
 		pendingFor := models.Duration(parsed)
 		req.PendingFor = &pendingFor
 	}
@@ -1139,7 +1163,7 @@ func monitorV2NotificationSettingsToSDK(ctx context.Context, settings *monitorV2
 		Method:                monitorV2String(settings.Method),
 		StatusFilters:         monitorV2IssueStatuses(ctx, settings.StatusFilters, diags),
 	}
-	if interval := monitorV2String(settings.RenotificationInterval); interval != "" {
+	if interval := monitorV2String(settings.RenotificationInterval.StringValue); interval != "" {
 		req.RenotificationInterval = models.RenotificationDuration(interval)
 	}
 	return req
@@ -1219,8 +1243,8 @@ func monitorV2PreserveEvaluationIntervalDurations(previous, updated *monitorV2Ev
 		return updated
 	}
 
-	updated.Interval = monitorV2PreserveDurationString(previous.Interval, updated.Interval)
-	updated.PendingFor = monitorV2PreserveDurationString(previous.PendingFor, updated.PendingFor)
+	updated.Interval = previous.Interval.KeepEquivalent(updated.Interval)
+	updated.PendingFor = previous.PendingFor.KeepEquivalent(updated.PendingFor)
 	return updated
 }
 
@@ -1229,7 +1253,7 @@ func monitorV2PreserveNotificationSettingsDurations(previous, updated *monitorV2
 		return updated
 	}
 
-	updated.RenotificationInterval = monitorV2PreserveDurationString(previous.RenotificationInterval, updated.RenotificationInterval)
+	updated.RenotificationInterval = previous.RenotificationInterval.KeepEquivalent(updated.RenotificationInterval)
 	return updated
 }
 
@@ -1382,12 +1406,12 @@ func monitorV2EvaluationIntervalFromSDK(interval *models.EvaluationInterval) *mo
 	if interval == nil {
 		return nil
 	}
-	pendingFor := types.StringNull()
+	pendingFor := customtypes.NewDurationNull()
 	if interval.PendingFor != nil {
-		pendingFor = monitorV2DurationToType(time.Duration(*interval.PendingFor))
+		pendingFor = customtypes.NewDurationValue(monitorV2DurationToString(time.Duration(*interval.PendingFor)))
 	}
 	return &monitorV2EvaluationIntervalModel{
-		Interval:   monitorV2DurationToType(time.Duration(interval.Interval)),
+		Interval:   customtypes.NewDurationValue(monitorV2DurationToString(time.Duration(interval.Interval))),
 		PendingFor: pendingFor,
 	}
 }
@@ -1415,7 +1439,7 @@ func monitorV2NotificationSettingsFromSDK(ctx context.Context, settings *models.
 		ConnectedAppParams:     monitorV2ConnectedAppParamsType(ctx, settings.ConnectedAppParams, diags),
 		StatusFilters:          monitorV2IssueStatusListType(ctx, settings.StatusFilters, diags),
 		DisableRenotification:  types.BoolValue(settings.DisableRenotification),
-		RenotificationInterval: monitorV2AnyString(settings.RenotificationInterval),
+		RenotificationInterval: customtypes.Duration{StringValue: monitorV2AnyString(settings.RenotificationInterval)},
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/customtypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -34,6 +35,7 @@ var (
 	_ resource.ResourceWithConfigure      = &monitorV2JsonResource{}
 	_ resource.ResourceWithImportState    = &monitorV2JsonResource{}
 	_ resource.ResourceWithValidateConfig = &monitorV2JsonResource{}
+	_ resource.ResourceWithModifyPlan     = &monitorV2JsonResource{}
 )
 
 func NewMonitorV2JsonResource() resource.Resource {
@@ -42,6 +44,8 @@ func NewMonitorV2JsonResource() resource.Resource {
 
 type monitorV2JsonResource struct {
 	client ApiClient
+	// requiredMonitorLabels mirrors the provider's required_monitor_labels setting.
+	requiredMonitorLabels []string
 }
 
 // monitorV2JsonResourceModel mirrors monitorV2ResourceModel exactly except NotificationSettings,
@@ -70,12 +74,12 @@ type monitorV2JsonResourceModel struct {
 }
 
 type monitorV2JsonNotificationSettingsModel struct {
-	Method                 types.String `tfsdk:"method"`
-	ConnectedApps          types.List   `tfsdk:"connected_apps"`
-	ConnectedAppParams     types.String `tfsdk:"connected_app_params"`
-	StatusFilters          types.List   `tfsdk:"status_filters"`
-	DisableRenotification  types.Bool   `tfsdk:"disable_renotification"`
-	RenotificationInterval types.String `tfsdk:"renotification_interval"`
+	Method                 types.String         `tfsdk:"method"`
+	ConnectedApps          types.List           `tfsdk:"connected_apps"`
+	ConnectedAppParams     types.String         `tfsdk:"connected_app_params"`
+	StatusFilters          types.List           `tfsdk:"status_filters"`
+	DisableRenotification  types.Bool           `tfsdk:"disable_renotification"`
+	RenotificationInterval customtypes.Duration `tfsdk:"renotification_interval"`
 }
 
 // connectedAppParamJSON is the JSON shape of a single connected_app_params entry.
@@ -133,6 +137,13 @@ func (r *monitorV2JsonResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 	r.client = client
+	if pc, ok := req.ProviderData.(*providerClient); ok {
+		r.requiredMonitorLabels = pc.requiredMonitorLabels
+	}
+}
+
+func (r *monitorV2JsonResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkMonitorV2RequiredLabels(ctx, r.requiredMonitorLabels, req, &resp.Diagnostics)
 }
 
 func (r *monitorV2JsonResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
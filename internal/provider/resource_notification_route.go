@@ -8,8 +8,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/customtypes"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -31,6 +35,7 @@ var (
 	_ resource.Resource                = &notificationRouteResource{}
 	_ resource.ResourceWithConfigure   = &notificationRouteResource{}
 	_ resource.ResourceWithImportState = &notificationRouteResource{}
+	_ resource.ResourceWithModifyPlan  = &notificationRouteResource{}
 )
 
 func NewNotificationRouteResource() resource.Resource {
@@ -47,6 +52,7 @@ type notificationRouteResourceModel struct {
 	Query                types.String `tfsdk:"query"`
 	Routes               types.List   `tfsdk:"routes"`
 	NotificationSettings types.Object `tfsdk:"notification_settings"`
+	ValidateReferences   types.Bool   `tfsdk:"validate_references"`
 	CreatedBy            types.String `tfsdk:"created_by"`
 	CreatedAt            types.String `tfsdk:"created_at"`
 	ModifiedBy           types.String `tfsdk:"modified_by"`
@@ -94,7 +100,7 @@ type routeConnectedAppParamsWire struct {
 }
 
 type notificationSettingsModel struct {
-	RenotificationInterval types.String `tfsdk:"renotification_interval"`
+	RenotificationInterval customtypes.Duration `tfsdk:"renotification_interval"`
 }
 
 func (r *notificationRouteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -117,8 +123,11 @@ func (r *notificationRouteResource) Schema(_ context.Context, _ resource.SchemaR
 				Required:    true,
 			},
 			"query": schema.StringAttribute{
-				Description: "gcQL query to match issues.",
+				Description: "gcQL query to match issues. Checked at plan time for balanced quotes and parentheses, dangling `AND`/`OR`/`NOT` operators, and `key:value` terms missing a key or value.",
 				Required:    true,
+				Validators: []validator.String{
+					validators.GcQLQuery(),
+				},
 			},
 			"routes": schema.ListNestedAttribute{
 				Description: "List of routing rules that define which connected apps receive notifications based on issue status.",
@@ -129,6 +138,9 @@ func (r *notificationRouteResource) Schema(_ context.Context, _ resource.SchemaR
 							Description: "List of issue statuses that trigger this route (e.g., 'Alerting', 'Resolved').",
 							ElementType: types.StringType,
 							Required:    true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(validators.OneOfCaseInsensitive("Alerting", "Resolved")),
+							},
 						},
 						"connected_apps": schema.ListNestedAttribute{
 							Description: "List of connected apps to notify for this route.",
@@ -237,11 +249,20 @@ func (r *notificationRouteResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:    true,
 				Attributes: map[string]schema.Attribute{
 					"renotification_interval": schema.StringAttribute{
-						Description: "Duration between renotifications (e.g., '1h', '30m'). The API may normalize this value.",
+						Description: "Duration between renotifications (e.g., '1h', '30m'). The API may normalize this value; equivalent durations are not reported as changes.",
 						Optional:    true,
+						CustomType:  customtypes.DurationType{},
+						Validators: []validator.String{
+							validators.DurationString(),
+						},
 					},
 				},
 			},
+			"validate_references": schema.BoolAttribute{
+				Description: "When `true`, planning a new route or a change to `routes` lists the connected apps and fails if a `connected_apps` entry names an ID that does not exist or has another type, instead of the apply failing with a 404. " +
+					"IDs not known until apply, such as those of connected apps created in the same apply, are not checked. Costs one extra API call per planned change to `routes`. Defaults to `false`.",
+				Optional: true,
+			},
 			"created_by": schema.StringAttribute{
 				Description: "The user who created the notification route.",
 				Computed:    true,
@@ -311,18 +332,12 @@ func (r *notificationRouteResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	// Save the original plan's notification_settings for duration comparison
-	originalSettings := plan.NotificationSettings
-
 	// Populate state from GET response
 	mapNotificationRouteResponseToModel(ctx, route, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Preserve plan's notification_settings.renotification_interval if semantically equivalent
-	plan.NotificationSettings = preserveEquivalentDuration(ctx, originalSettings, plan.NotificationSettings)
-
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -353,15 +368,11 @@ func (r *notificationRouteResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	originalSettings := state.NotificationSettings
-
 	mapNotificationRouteResponseToModel(ctx, route, &state, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.NotificationSettings = preserveEquivalentDuration(ctx, originalSettings, state.NotificationSettings)
-
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -404,15 +415,11 @@ func (r *notificationRouteResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	originalSettings := plan.NotificationSettings
-
 	mapNotificationRouteResponseToModel(ctx, route, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	plan.NotificationSettings = preserveEquivalentDuration(ctx, originalSettings, plan.NotificationSettings)
-
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -444,8 +451,51 @@ func (r *notificationRouteResource) Delete(ctx context.Context, req resource.Del
 	tflog.Debug(ctx, fmt.Sprintf("Successfully deleted notification route resource: %s", routeId))
 }
 
+// notificationRouteImportNamePrefix marks an import ID that names the route
+// instead of giving its ID, e.g. "name=prod-alerts-route".
+const notificationRouteImportNamePrefix = "name="
+
 func (r *notificationRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	name, byName := strings.CutPrefix(req.ID, notificationRouteImportNamePrefix)
+	if !byName {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	routes, err := r.client.ListNotificationRoutes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Listing Notification Routes", fmt.Sprintf("Could not list notification routes to resolve %q: %s", name, err.Error()))
+		return
+	}
+
+	routeId, err := notificationRouteIDByName(routes, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Cannot Import Notification Route", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Resolved notification route name %q to ID %s", name, routeId))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), routeId)...)
+}
+
+// notificationRouteIDByName returns the ID of the only route named name. Names
+// are matched exactly; a name shared by several routes must be imported by ID.
+func notificationRouteIDByName(routes []*models.NotificationRouteListItemResponse, name string) (string, error) {
+	var ids []string
+	for _, route := range routes {
+		if route != nil && route.Name == name {
+			ids = append(ids, route.ID)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no notification route is named %q", name)
+	case 1:
+		return ids[0], nil
+	default:
+		sort.Strings(ids)
+		return "", fmt.Errorf("%d notification routes are named %q (IDs: %s); import one of them by ID instead", len(ids), name, strings.Join(ids, ", "))
+	}
 }
 
 // Helper functions for TF/SDK conversion
@@ -669,10 +719,9 @@ func notificationSettingsToSDK(ctx context.Context, settings types.Object) (*mod
 func notificationSettingsSDKToObject(ctx context.Context, sdkSettings *models.NotificationSettingsResponse) (types.Object, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	renotificationInterval := types.StringNull()
+	renotificationInterval := customtypes.NewDurationNull()
 	if sdkSettings != nil && sdkSettings.RenotificationInterval != "" {
-		normalized := normalizeDuration(sdkSettings.RenotificationInterval)
-		renotificationInterval = types.StringValue(normalized)
+		renotificationInterval = customtypes.NewDurationValue(sdkSettings.RenotificationInterval)
 	}
 
 	obj, objDiags := types.ObjectValue(
@@ -722,76 +771,6 @@ func mapNotificationRouteResponseToModel(ctx context.Context, route *models.Noti
 	}
 }
 
-func normalizeDuration(d string) string {
-	if d == "" {
-		return d
-	}
-
-	parsed, err := time.ParseDuration(d)
-	if err != nil {
-		return d
-	}
-
-	hours := int(parsed.Hours())
-	minutes := int(parsed.Minutes()) % 60
-	seconds := int(parsed.Seconds()) % 60
-
-	if hours > 0 && minutes == 0 && seconds == 0 {
-		return fmt.Sprintf("%dh", hours)
-	}
-	if hours > 0 && seconds == 0 {
-		return fmt.Sprintf("%dh%dm", hours, minutes)
-	}
-	if hours == 0 && minutes > 0 && seconds == 0 {
-		return fmt.Sprintf("%dm", minutes)
-	}
-
-	return parsed.String()
-}
-
-func durationsEqual(d1, d2 string) bool {
-	if d1 == d2 {
-		return true
-	}
-	if d1 == "" || d2 == "" {
-		return false
-	}
-
-	parsed1, err1 := time.ParseDuration(d1)
-	parsed2, err2 := time.ParseDuration(d2)
-	if err1 != nil || err2 != nil {
-		return false
-	}
-
-	return parsed1 == parsed2
-}
-
-func preserveEquivalentDuration(ctx context.Context, original, updated types.Object) types.Object {
-	if original.IsNull() || original.IsUnknown() {
-		return updated
-	}
-	if updated.IsNull() {
-		return updated
-	}
-
-	var origSettings, updSettings notificationSettingsModel
-	if diags := original.As(ctx, &origSettings, basetypes.ObjectAsOptions{}); diags.HasError() {
-		return updated
-	}
-	if diags := updated.As(ctx, &updSettings, basetypes.ObjectAsOptions{}); diags.HasError() {
-		return updated
-	}
-
-	origInterval := origSettings.RenotificationInterval.ValueString()
-	updInterval := updSettings.RenotificationInterval.ValueString()
-
-	if durationsEqual(origInterval, updInterval) {
-		return original
-	}
-
-	return updated
-}
-
 // routeParamsNotEmptyValidator rejects a params object whose attributes are
 // all null. routeConnectedAppParamsToSDK omits unset attributes from the API
 // request, so an all-null params object would be sent as absent, read back as
@@ -1017,6 +996,6 @@ func routeRuleAttrTypes() map[string]attr.Type {
 
 func notificationSettingsAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"renotification_interval": types.StringType,
+		"renotification_interval": customtypes.DurationType{},
 	}
 }
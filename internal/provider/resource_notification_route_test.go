@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"maps"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -50,6 +51,12 @@ func TestAccNotificationRoute_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "groundcover_notification_route.test",
+				ImportState:       true,
+				ImportStateId:     "name=" + name,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -772,3 +779,81 @@ func TestRouteParamsNotEmptyValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestNotificationRouteIDByName(t *testing.T) {
+	routes := []*models.NotificationRouteListItemResponse{
+		{ID: "route-1", Name: "prod-alerts"},
+		{ID: "route-3", Name: "shared"},
+		nil,
+		{ID: "route-2", Name: "shared"},
+	}
+
+	id, err := notificationRouteIDByName(routes, "prod-alerts")
+	if err != nil || id != "route-1" {
+		t.Fatalf("notificationRouteIDByName(prod-alerts) = %q, %v; want route-1", id, err)
+	}
+
+	if _, err := notificationRouteIDByName(routes, "Prod-Alerts"); err == nil {
+		t.Error("expected an error for a name that only differs in case")
+	}
+
+	_, err = notificationRouteIDByName(routes, "shared")
+	if err == nil || !strings.Contains(err.Error(), "route-2, route-3") {
+		t.Errorf("expected an error listing both IDs of the shared name, got %v", err)
+	}
+}
+
+// connectedAppsClient lists a fixed set of connected apps.
+type connectedAppsClient struct {
+	ApiClient
+	apps []*models.ConnectedAppListItemWithRoutesResponse
+}
+
+func (c *connectedAppsClient) ListConnectedApps(_ context.Context, _ *models.ListConnectedAppsRequest) ([]*models.ConnectedAppListItemWithRoutesResponse, error) {
+	return c.apps, nil
+}
+
+func TestNotificationRouteConnectedAppReferences(t *testing.T) {
+	r := &notificationRouteResource{client: &connectedAppsClient{apps: []*models.ConnectedAppListItemWithRoutesResponse{
+		{ID: "app-1", Name: "alerts", Type: "slack-webhook"},
+		nil,
+	}}}
+	params := types.ObjectNull(routeParamsTestAttrTypes())
+
+	tests := []struct {
+		name        string
+		appType     string
+		appID       string
+		wantSummary string
+	}{
+		{name: "existing app", appType: "slack-webhook", appID: "app-1"},
+		{name: "missing app", appType: "slack-webhook", appID: "app-9", wantSummary: "Connected App Not Found"},
+		{name: "wrong type", appType: "pagerduty", appID: "app-1", wantSummary: "Connected App Type Mismatch"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			references, diags := routeConnectedAppReferences(ctx, testRoutesList(t, tt.appType, tt.appID, params))
+			if diags.HasError() || len(references) != 1 {
+				t.Fatalf("routeConnectedAppReferences() = %v, %v", references, diags)
+			}
+			if got := references[0].path.String(); got != "routes[0].connected_apps[0].id" {
+				t.Fatalf("reference path = %s", got)
+			}
+
+			r.checkConnectedAppReferences(ctx, references, &diags)
+			switch {
+			case tt.wantSummary == "" && diags.HasError():
+				t.Fatalf("unexpected errors: %v", diags)
+			case tt.wantSummary != "" && (!diags.HasError() || diags.Errors()[0].Summary() != tt.wantSummary):
+				t.Fatalf("errors = %v, want %q", diags, tt.wantSummary)
+			}
+		})
+	}
+
+	unknown := testRoutesList(t, "slack-webhook", "app-1", params)
+	references, _ := routeConnectedAppReferences(context.Background(), types.ListUnknown(unknown.ElementType(context.Background())))
+	if len(references) != 0 {
+		t.Errorf("unknown routes must not be checked, got %v", references)
+	}
+}
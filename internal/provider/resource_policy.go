@@ -5,8 +5,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -35,10 +37,15 @@ var _ resource.ResourceWithConfigure = &policyResource{}
 
 var _ resource.ResourceWithImportState = &policyResource{}
 var _ resource.ResourceWithUpgradeState = &policyResource{}
+var _ resource.ResourceWithModifyPlan = &policyResource{}
 
 // policyResource defines the resource implementation.
 type policyResource struct {
 	client ApiClient // Removed unused 'version' field
+	// autoRetryOnConflict mirrors the provider's auto_retry_on_conflict setting.
+	autoRetryOnConflict bool
+	// failOnReadOnlyChanges mirrors the provider's fail_on_read_only_changes setting.
+	failOnReadOnlyChanges bool
 }
 
 // policyResourceModel describes the resource data model.
@@ -54,6 +61,9 @@ type policyResourceModel struct {
 	ReadOnly        types.Bool   `tfsdk:"read_only"`
 	Deprecated      types.Bool   `tfsdk:"deprecated"`
 	IsSystemDefined types.Bool   `tfsdk:"is_system_defined"`
+
+	ValidateUniqueName types.Bool `tfsdk:"validate_unique_name"`
+	ForceDelete        types.Bool `tfsdk:"force_delete"`
 }
 
 // dataScopeModel maps the data_scope block schema.
@@ -153,6 +163,53 @@ func groupSchemaAttributes() map[string]schema.Attribute {
 	}
 }
 
+// policyDataScopeAttribute returns the data_scope attribute of a policy.
+func policyDataScopeAttribute(planModifiers ...planmodifier.Object) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Defines the data scope restrictions for the policy. At most one of 'simple' or 'advanced' may be specified. Omitting data_scope, or providing an empty block, means no data restrictions (access to all data).",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"simple": schema.SingleNestedAttribute{
+				MarkdownDescription: "Simple data scope configuration. Applies a single set of filtering rules to all data types.",
+				Optional:            true,
+				Attributes:          groupSchemaAttributes(),
+			},
+			"advanced": schema.SingleNestedAttribute{
+				MarkdownDescription: "Advanced data scope configuration. Allows per-data-type filtering rules for fine-grained access control.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"events": schema.SingleNestedAttribute{
+						MarkdownDescription: "Data scope rules for events.",
+						Optional:            true,
+						Attributes:          groupSchemaAttributes(),
+					},
+					"logs": schema.SingleNestedAttribute{
+						MarkdownDescription: "Data scope rules for logs.",
+						Optional:            true,
+						Attributes:          groupSchemaAttributes(),
+					},
+					"metrics": schema.SingleNestedAttribute{
+						MarkdownDescription: "Data scope rules for metrics.",
+						Optional:            true,
+						Attributes:          groupSchemaAttributes(),
+					},
+					"traces": schema.SingleNestedAttribute{
+						MarkdownDescription: "Data scope rules for traces.",
+						Optional:            true,
+						Attributes:          groupSchemaAttributes(),
+					},
+					"workloads": schema.SingleNestedAttribute{
+						MarkdownDescription: "Data scope rules for workloads.",
+						Optional:            true,
+						Attributes:          groupSchemaAttributes(),
+					},
+				},
+			},
+		},
+		PlanModifiers: planModifiers,
+	}
+}
+
 func NewPolicyResource() resource.Resource {
 	return &policyResource{}
 }
@@ -200,51 +257,7 @@ func (r *policyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "SSO Role claim name used for mapping.",
 				Optional:            true,
 			},
-			"data_scope": schema.SingleNestedAttribute{
-				MarkdownDescription: "Defines the data scope restrictions for the policy. At most one of 'simple' or 'advanced' may be specified. Omitting data_scope, or providing an empty block, means no data restrictions (access to all data).",
-				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"simple": schema.SingleNestedAttribute{
-						MarkdownDescription: "Simple data scope configuration. Applies a single set of filtering rules to all data types.",
-						Optional:            true,
-						Attributes:          groupSchemaAttributes(),
-					},
-					"advanced": schema.SingleNestedAttribute{
-						MarkdownDescription: "Advanced data scope configuration. Allows per-data-type filtering rules for fine-grained access control.",
-						Optional:            true,
-						Attributes: map[string]schema.Attribute{
-							"events": schema.SingleNestedAttribute{
-								MarkdownDescription: "Data scope rules for events.",
-								Optional:            true,
-								Attributes:          groupSchemaAttributes(),
-							},
-							"logs": schema.SingleNestedAttribute{
-								MarkdownDescription: "Data scope rules for logs.",
-								Optional:            true,
-								Attributes:          groupSchemaAttributes(),
-							},
-							"metrics": schema.SingleNestedAttribute{
-								MarkdownDescription: "Data scope rules for metrics.",
-								Optional:            true,
-								Attributes:          groupSchemaAttributes(),
-							},
-							"traces": schema.SingleNestedAttribute{
-								MarkdownDescription: "Data scope rules for traces.",
-								Optional:            true,
-								Attributes:          groupSchemaAttributes(),
-							},
-							"workloads": schema.SingleNestedAttribute{
-								MarkdownDescription: "Data scope rules for workloads.",
-								Optional:            true,
-								Attributes:          groupSchemaAttributes(),
-							},
-						},
-					},
-				},
-				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.UseStateForUnknown(),
-				},
-			},
+			"data_scope": policyDataScopeAttribute(objectplanmodifier.UseStateForUnknown()),
 			"revision_number": schema.Int64Attribute{
 				MarkdownDescription: "Revision number of the policy, used for concurrency control.",
 				Computed:            true,
@@ -261,6 +274,11 @@ func (r *policyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "Indicates if the policy is system-defined.",
 				Computed:            true,
 			},
+			"validate_unique_name": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, planning a new policy or a rename lists the organization's policies and warns if another policy already uses `name`, instead of the apply failing with a conflict. Costs one extra API call per planned name change. Defaults to `false`.",
+				Optional:            true,
+			},
+			"force_delete": forceDeleteAttribute("service accounts or API keys"),
 		},
 	}
 }
@@ -281,9 +299,106 @@ func (r *policyResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 	r.client = client
+	if pc, ok := req.ProviderData.(*providerClient); ok {
+		r.autoRetryOnConflict = pc.autoRetryOnConflict
+		r.failOnReadOnlyChanges = pc.failOnReadOnlyChanges
+	}
 	tflog.Info(ctx, "Policy resource configured successfully")
 }
 
+// ModifyPlan flags planned changes to read-only policies and warns about
+// policy name collisions when validate_unique_name is enabled. Only creates and
+// renames are checked for collisions, so unchanged policies cost no extra API
+// calls.
+func (r *policyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.State.Raw.IsNull() && !planMatchesState(req.Plan, req.State) {
+		var state policyResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if policyIsReadOnly(state) {
+			r.addReadOnlyPolicyChangeDiagnostic(state, req.Plan.Raw.IsNull(), &resp.Diagnostics)
+			return
+		}
+	}
+
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan policyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.ValidateUniqueName.ValueBool() || plan.Name.IsUnknown() || plan.Name.IsNull() {
+		return
+	}
+
+	stateUUID := ""
+	if !req.State.Raw.IsNull() {
+		var state policyResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.Name.ValueString() == plan.Name.ValueString() {
+			return
+		}
+		stateUUID = state.UUID.ValueString()
+	}
+
+	r.warnOnPolicyNameCollision(ctx, plan.Name.ValueString(), stateUUID, &resp.Diagnostics)
+}
+
+// warnOnPolicyNameCollision adds a warning on name when a policy other than
+// ownUUID already uses that name. A failed lookup is logged and reported as a
+// warning so it never blocks the plan.
+func (r *policyResource) warnOnPolicyNameCollision(ctx context.Context, name, ownUUID string, diags *diag.Diagnostics) {
+	policies, err := r.client.ListPolicies(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to list policies for name uniqueness check", map[string]any{"name": name, "error": err.Error()})
+		diags.AddAttributeWarning(path.Root("name"), "Policy Name Not Checked",
+			fmt.Sprintf("Could not list policies to check whether the name %q is already in use: %s", name, err.Error()))
+		return
+	}
+
+	for _, policy := range policies {
+		if policy == nil || policy.Name == nil || *policy.Name != name || policy.UUID == ownUUID {
+			continue
+		}
+		diags.AddAttributeWarning(path.Root("name"), "Policy Name Already In Use",
+			fmt.Sprintf("A policy named %q already exists (UUID %s) and is not managed by this resource. "+
+				"Applying this plan will likely fail with a conflict; choose a different name or import the existing policy.", name, policy.UUID))
+		return
+	}
+}
+
+// policyIsReadOnly reports whether the API rejects changes to the policy.
+func policyIsReadOnly(state policyResourceModel) bool {
+	return state.ReadOnly.ValueBool() || state.IsSystemDefined.ValueBool()
+}
+
+// addReadOnlyPolicyChangeDiagnostic reports a planned update or destroy of a
+// read-only policy: an error when fail_on_read_only_changes is set, otherwise a
+// warning that the change will be skipped at apply.
+func (r *policyResource) addReadOnlyPolicyChangeDiagnostic(state policyResourceModel, destroy bool, diags *diag.Diagnostics) {
+	action, outcome := "update", "The update will be recorded in Terraform state only and the policy will not be changed."
+	if destroy {
+		action, outcome = "destroy", "The policy will be removed from Terraform state only and will not be deleted."
+	}
+	detail := fmt.Sprintf("Policy %q (%s) is read-only or system-defined, so the groundcover API rejects any attempt to %s it.",
+		state.Name.ValueString(), state.UUID.ValueString(), action)
+
+	if r.failOnReadOnlyChanges {
+		diags.AddError("Policy Is Read-Only",
+			detail+" Remove the change from the configuration, or unset the provider's fail_on_read_only_changes to skip it with a warning.")
+		return
+	}
+	diags.AddWarning("Policy Is Read-Only", detail+" "+outcome)
+}
+
 // --- CRUD Operations ---
 
 // Create creates the policy resource.
@@ -394,6 +509,11 @@ func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 	tflog.Debug(ctx, "Policy read successfully via SDK", map[string]any{"uuid": apiResponse.UUID})
 
+	// read_only drives the skip-on-apply handling of read-only policies, so keep it current.
+	if apiResponse.ReadOnly != nil {
+		state.ReadOnly = types.BoolValue(*apiResponse.ReadOnly)
+	}
+
 	// Update state from SDK Response
 	diags := mapPolicyApiResponseToModel(ctx, *apiResponse, &state)
 	resp.Diagnostics.Append(diags...)
@@ -434,6 +554,23 @@ func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if apiCurrentState.ReadOnly != nil && *apiCurrentState.ReadOnly {
+		state.ReadOnly = types.BoolValue(true)
+	}
+	if policyIsReadOnly(state) && !r.failOnReadOnlyChanges {
+		tflog.Warn(ctx, "Skipping update of read-only policy", map[string]any{"uuid": policyUUID})
+		resp.Diagnostics.AddWarning("Policy Update Skipped",
+			fmt.Sprintf("Policy %s is read-only or system-defined and was not updated. The planned values were recorded in Terraform state only.", policyUUID))
+		plan.ID = state.ID
+		plan.UUID = state.UUID
+		plan.RevisionNumber = state.RevisionNumber
+		plan.ReadOnly = state.ReadOnly
+		plan.Deprecated = state.Deprecated
+		plan.IsSystemDefined = state.IsSystemDefined
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	// Use the current revision number from the API for the update request
 	currentRevision := int64(apiCurrentState.RevisionNumber)
 	apiRequest, diags := mapPolicyModelToApiUpdateRequest(ctx, plan, currentRevision)
@@ -444,6 +581,9 @@ func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	tflog.Debug(ctx, "UpdatePolicy SDK Call Request constructed", map[string]any{"uuid": policyUUID, "revision": apiRequest.CurrentRevision})
 	apiResponse, err := r.client.UpdatePolicy(ctx, policyUUID, apiRequest)
+	if errors.Is(err, ErrConcurrency) && r.autoRetryOnConflict {
+		apiResponse, err = r.retryPolicyUpdateOnConflict(ctx, policyUUID, apiRequest, &resp.Diagnostics)
+	}
 	if err != nil {
 		// Add specific check for ReadOnly error if the wrapper returns it
 		if errors.Is(err, ErrReadOnly) {
@@ -544,6 +684,17 @@ func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	policyUUID := state.ID.ValueString()
+	if policyIsReadOnly(state) && !r.failOnReadOnlyChanges {
+		tflog.Warn(ctx, "Removing read-only policy from state without deleting it", map[string]any{"uuid": policyUUID})
+		resp.Diagnostics.AddWarning("Policy Delete Skipped",
+			fmt.Sprintf("Policy %s is read-only or system-defined and was not deleted. It was removed from Terraform state only.", policyUUID))
+		return
+	}
+
+	if !checkDeleteDependents(ctx, state.ForceDelete, "policy", policyUUID, policyDependents(r.client, policyUUID), &resp.Diagnostics) {
+		return
+	}
+
 	tflog.Debug(ctx, "DeletePolicy SDK Call Request", map[string]any{"uuid": policyUUID})
 	err := r.client.DeletePolicy(ctx, policyUUID)
 	if err != nil {
@@ -634,6 +785,68 @@ func mapPolicyModelToApiCreateRequest(ctx context.Context, plan policyResourceMo
 	return apiRequest, diags
 }
 
+// maxPolicyConflictRetries bounds how many times a conflicted update is re-sent.
+const maxPolicyConflictRetries = 3
+
+// retryPolicyUpdateOnConflict re-reads a policy whose update hit a revision
+// conflict. If the latest revision already matches the request, it is used
+// as-is; otherwise the update is re-sent against that revision. Either way a
+// warning records that a concurrent change was involved.
+func (r *policyResource) retryPolicyUpdateOnConflict(ctx context.Context, policyUUID string, apiRequest *models.UpdatePolicyRequest, diags *diag.Diagnostics) (*models.Policy, error) {
+	err := ErrConcurrency
+	for attempt := 1; attempt <= maxPolicyConflictRetries && errors.Is(err, ErrConcurrency); attempt++ {
+		latest, getErr := r.client.GetPolicy(ctx, policyUUID)
+		if getErr != nil {
+			return nil, getErr
+		}
+
+		if policyMatchesUpdateRequest(latest, apiRequest) {
+			diags.AddWarning(
+				"Policy Modified Concurrently",
+				fmt.Sprintf("Policy %s was changed outside Terraform (revision %d), but it already matches the configuration, so no update was sent.", policyUUID, latest.RevisionNumber),
+			)
+			return latest, nil
+		}
+
+		tflog.Info(ctx, "Retrying policy update against latest revision", map[string]any{"uuid": policyUUID, "revision": latest.RevisionNumber, "attempt": attempt})
+		apiRequest.CurrentRevision = latest.RevisionNumber
+		var updated *models.Policy
+		updated, err = r.client.UpdatePolicy(ctx, policyUUID, apiRequest)
+		if err == nil {
+			diags.AddWarning(
+				"Policy Modified Concurrently",
+				fmt.Sprintf("Policy %s was changed outside Terraform (revision %d). The update was retried against that revision and overwrote those changes.", policyUUID, latest.RevisionNumber),
+			)
+			return updated, nil
+		}
+	}
+	return nil, err
+}
+
+// policyMatchesUpdateRequest reports whether the user-specified fields of an
+// update request already hold on the policy.
+func policyMatchesUpdateRequest(policy *models.Policy, apiRequest *models.UpdatePolicyRequest) bool {
+	if policy == nil || policy.Name == nil || apiRequest.Name == nil {
+		return false
+	}
+	if *policy.Name != *apiRequest.Name ||
+		policy.Description != apiRequest.Description ||
+		policy.ClaimRole != apiRequest.ClaimRole ||
+		!maps.Equal(policy.Role, apiRequest.Role) {
+		return false
+	}
+	policyScope, err := json.Marshal(policy.DataScope)
+	if err != nil {
+		return false
+	}
+	requestScope, err := json.Marshal(apiRequest.DataScope)
+	if err != nil {
+		return false
+	}
+	same, err := CompareJSONSemantically(string(policyScope), string(requestScope))
+	return err == nil && same
+}
+
 // mapPolicyModelToApiUpdateRequest converts Terraform model to SDK request struct for Update.
 func mapPolicyModelToApiUpdateRequest(ctx context.Context, plan policyResourceModel, revision int64) (*models.UpdatePolicyRequest, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -0,0 +1,536 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = &policyBundleResource{}
+	_ resource.ResourceWithConfigure        = &policyBundleResource{}
+	_ resource.ResourceWithModifyPlan       = &policyBundleResource{}
+	_ resource.ResourceWithConfigValidators = &policyBundleResource{}
+)
+
+func NewPolicyBundleResource() resource.Resource {
+	return &policyBundleResource{}
+}
+
+type policyBundleResource struct {
+	client ApiClient
+}
+
+type policyBundleResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Policies types.List   `tfsdk:"policies"` // List of policyBundlePolicyObjectType
+}
+
+// policyBundlePolicyModel is one policy of a bundle.
+type policyBundlePolicyModel struct {
+	Name           types.String `tfsdk:"name"`
+	Role           types.Map    `tfsdk:"role"`
+	Description    types.String `tfsdk:"description"`
+	ClaimRole      types.String `tfsdk:"claim_role"`
+	DataScope      types.Object `tfsdk:"data_scope"`
+	UUID           types.String `tfsdk:"uuid"`
+	RevisionNumber types.Int64  `tfsdk:"revision_number"`
+}
+
+var policyBundlePolicyObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":            types.StringType,
+		"role":            types.MapType{ElemType: types.StringType},
+		"description":     types.StringType,
+		"claim_role":      types.StringType,
+		"data_scope":      types.ObjectType{AttrTypes: dataScopeAttrTypes()},
+		"uuid":            types.StringType,
+		"revision_number": types.Int64Type,
+	},
+}
+
+// policyModel returns p as a policyResourceModel, for the policy request mappers.
+func (p policyBundlePolicyModel) policyModel() policyResourceModel {
+	return policyResourceModel{
+		Name:        p.Name,
+		Role:        p.Role,
+		Description: p.Description,
+		ClaimRole:   p.ClaimRole,
+		DataScope:   p.DataScope,
+	}
+}
+
+// sameSpec reports whether p and other configure the same policy.
+func (p policyBundlePolicyModel) sameSpec(other policyBundlePolicyModel) bool {
+	return p.Name.Equal(other.Name) && p.Role.Equal(other.Role) && p.Description.Equal(other.Description) &&
+		p.ClaimRole.Equal(other.ClaimRole) && p.DataScope.Equal(other.DataScope)
+}
+
+func (r *policyBundleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_bundle"
+}
+
+func (r *policyBundleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of groundcover RBAC policies as a unit, such as the policies every tenant gets. " +
+			"An apply creates and updates the bundle's policies first and deletes the removed ones last. If creating or updating a policy fails, the policies already created in that apply are deleted and the ones already updated are restored, so a failed apply does not leave the bundle half-changed. " +
+			"The API has no transactions, so this rollback is done by the provider: a rollback step that fails is reported as an error, and the policies it concerns are kept in state. If deleting a policy fails, the other changes are kept and the policies not yet deleted stay in state, so the next apply retries them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the bundle. Same as `name`.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the bundle. It identifies the bundle in Terraform and is not sent to the API, so renaming the bundle leaves its policies unchanged.",
+				Required:            true,
+			},
+			"policies": schema.ListNestedAttribute{
+				MarkdownDescription: "The policies of the bundle. Policies are matched by `name`: renaming a policy deletes it and creates a policy with the new name. A policy may only appear once.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the policy.",
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"role": schema.MapAttribute{
+							MarkdownDescription: "Role definitions associated with the policy, as in `groundcover_policy`: the key is the access level, one of `read`, `write`, or `admin`, and the value is unused. Example: `role = { admin = \"admin\" }`.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.Map{
+								mapvalidator.KeysAre(stringvalidator.OneOf("read", "write", "admin")),
+							},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A description for the policy.",
+							Optional:            true,
+						},
+						"claim_role": schema.StringAttribute{
+							MarkdownDescription: "SSO Role claim name used for mapping.",
+							Optional:            true,
+						},
+						"data_scope": policyDataScopeAttribute(),
+						"uuid": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier (UUID) of the policy.",
+							Computed:            true,
+						},
+						"revision_number": schema.Int64Attribute{
+							MarkdownDescription: "Revision number of the policy.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *policyBundleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{policyBundleUniqueNamesValidator{}}
+}
+
+// policyBundleUniqueNamesValidator rejects a bundle that lists a policy name twice.
+type policyBundleUniqueNamesValidator struct{}
+
+func (v policyBundleUniqueNamesValidator) Description(_ context.Context) string {
+	return "each policy name appears once in policies"
+}
+
+func (v policyBundleUniqueNamesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v policyBundleUniqueNamesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var policies types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policies"), &policies)...)
+	if resp.Diagnostics.HasError() || policies.IsNull() || policies.IsUnknown() {
+		return
+	}
+	planned, diags := policyBundlePolicies(ctx, policies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, policy := range planned {
+		if policy.Name.IsNull() || policy.Name.IsUnknown() {
+			continue
+		}
+		name := policy.Name.ValueString()
+		if seen[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("policies").AtListIndex(i).AtName("name"),
+				"Duplicate Policy Name",
+				fmt.Sprintf("The bundle lists the policy %q more than once. Each policy may only appear once.", name),
+			)
+		}
+		seen[name] = true
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *policyBundleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan sets the computed attributes of the planned policies: a policy
+// already in the bundle keeps its UUID, and its revision number too unless
+// the policy changes.
+func (r *policyBundleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan policyBundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.Name
+	if plan.Policies.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	planned, diags := policyBundlePolicies(ctx, plan.Policies)
+	resp.Diagnostics.Append(diags...)
+	existing := map[string]policyBundlePolicyModel{}
+	if !req.State.Raw.IsNull() {
+		var state policyBundleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		current, diags := policyBundlePolicies(ctx, state.Policies)
+		resp.Diagnostics.Append(diags...)
+		for _, policy := range current {
+			existing[policy.Name.ValueString()] = policy
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, policy := range planned {
+		current, ok := existing[policy.Name.ValueString()]
+		switch {
+		case !ok:
+			planned[i].UUID = types.StringUnknown()
+			planned[i].RevisionNumber = types.Int64Unknown()
+		case current.sameSpec(policy):
+			planned[i].UUID = current.UUID
+			planned[i].RevisionNumber = current.RevisionNumber
+		default:
+			planned[i].UUID = current.UUID
+			planned[i].RevisionNumber = types.Int64Unknown()
+		}
+	}
+	plan.Policies, diags = types.ListValueFrom(ctx, policyBundlePolicyObjectType, planned)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// policyBundlePolicies returns the elements of the policies attribute.
+func policyBundlePolicies(ctx context.Context, policies types.List) ([]policyBundlePolicyModel, diag.Diagnostics) {
+	var result []policyBundlePolicyModel
+	if policies.IsNull() || policies.IsUnknown() {
+		return result, nil
+	}
+	diags := policies.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// policyBundleChange applies the bundle's planned policies, tracking the
+// policies that exist so state can be saved whether or not it succeeds.
+type policyBundleChange struct {
+	client ApiClient
+	// current holds the policies of the bundle that exist, by name.
+	current map[string]policyBundlePolicyModel
+	// order is the order of the policies in state: the planned order, then
+	// the policies that are no longer planned.
+	order []string
+	// undo reverts the creates and updates made so far, newest last.
+	undo []func(context.Context) error
+}
+
+func newPolicyBundleChange(client ApiClient, planned, existing []policyBundlePolicyModel) *policyBundleChange {
+	c := &policyBundleChange{client: client, current: map[string]policyBundlePolicyModel{}}
+	for _, policy := range planned {
+		c.order = append(c.order, policy.Name.ValueString())
+	}
+	for _, policy := range existing {
+		name := policy.Name.ValueString()
+		c.current[name] = policy
+		if !slices.Contains(c.order, name) {
+			c.order = append(c.order, name)
+		}
+	}
+	return c
+}
+
+// create creates policy, and records how to delete it again.
+func (c *policyBundleChange) create(ctx context.Context, policy policyBundlePolicyModel) error {
+	name := policy.Name.ValueString()
+	var diags diag.Diagnostics
+	if !validateDataScopeConfiguration(ctx, policy.DataScope, &diags) {
+		return fmt.Errorf("invalid data_scope of policy %q", name)
+	}
+	apiRequest, diags := mapPolicyModelToApiCreateRequest(ctx, policy.policyModel())
+	if diags.HasError() {
+		return fmt.Errorf("unable to build the create request of policy %q", name)
+	}
+	created, err := c.client.CreatePolicy(ctx, apiRequest)
+	if err != nil {
+		return fmt.Errorf("failed to create policy %q: %w", name, err)
+	}
+	tflog.Info(ctx, "Created bundle policy", map[string]any{"name": name, "uuid": created.UUID})
+
+	policy.UUID = types.StringValue(created.UUID)
+	policy.RevisionNumber = types.Int64Value(int64(created.RevisionNumber))
+	c.current[name] = policy
+	c.undo = append(c.undo, func(ctx context.Context) error {
+		if err := c.client.DeletePolicy(ctx, created.UUID); err != nil {
+			return fmt.Errorf("failed to delete policy %q (%s) created by this apply: %w", name, created.UUID, err)
+		}
+		delete(c.current, name)
+		return nil
+	})
+	return nil
+}
+
+// update sends policy over the existing policy of the same name, and records
+// how to restore the existing one.
+func (c *policyBundleChange) update(ctx context.Context, policy policyBundlePolicyModel) error {
+	name := policy.Name.ValueString()
+	previous := c.current[name]
+	updated, err := c.send(ctx, previous.UUID.ValueString(), policy)
+	if err != nil {
+		return fmt.Errorf("failed to update policy %q (%s): %w", name, previous.UUID.ValueString(), err)
+	}
+	tflog.Info(ctx, "Updated bundle policy", map[string]any{"name": name, "uuid": previous.UUID.ValueString()})
+
+	policy.UUID = previous.UUID
+	policy.RevisionNumber = types.Int64Value(int64(updated.RevisionNumber))
+	c.current[name] = policy
+	c.undo = append(c.undo, func(ctx context.Context) error {
+		restored, err := c.send(ctx, previous.UUID.ValueString(), previous)
+		if err != nil {
+			return fmt.Errorf("failed to restore policy %q (%s) updated by this apply: %w", name, previous.UUID.ValueString(), err)
+		}
+		previous.RevisionNumber = types.Int64Value(int64(restored.RevisionNumber))
+		c.current[name] = previous
+		return nil
+	})
+	return nil
+}
+
+// send updates the policy with uuid to policy, against its latest revision.
+func (c *policyBundleChange) send(ctx context.Context, uuid string, policy policyBundlePolicyModel) (*models.Policy, error) {
+	var diags diag.Diagnostics
+	if !validateDataScopeConfiguration(ctx, policy.DataScope, &diags) {
+		return nil, errors.New("invalid data_scope")
+	}
+	latest, err := c.client.GetPolicy(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	apiRequest, diags := mapPolicyModelToApiUpdateRequest(ctx, policy.policyModel(), int64(latest.RevisionNumber))
+	if diags.HasError() {
+		return nil, errors.New("unable to build the update request")
+	}
+	return c.client.UpdatePolicy(ctx, uuid, apiRequest)
+}
+
+// remove deletes the existing policy named name.
+func (c *policyBundleChange) remove(ctx context.Context, name string) error {
+	policy := c.current[name]
+	if err := c.client.DeletePolicy(ctx, policy.UUID.ValueString()); err != nil {
+		return fmt.Errorf("failed to delete policy %q (%s): %w", name, policy.UUID.ValueString(), err)
+	}
+	tflog.Info(ctx, "Deleted bundle policy", map[string]any{"name": name, "uuid": policy.UUID.ValueString()})
+	delete(c.current, name)
+	return nil
+}
+
+// apply creates and updates the planned policies that need it, then deletes
+// the existing policies that are not planned. A failed create or update rolls
+// back the changes made before it.
+func (c *policyBundleChange) apply(ctx context.Context, planned []policyBundlePolicyModel, diags *diag.Diagnostics) {
+	plannedNames := map[string]bool{}
+	for _, policy := range planned {
+		name := policy.Name.ValueString()
+		plannedNames[name] = true
+
+		var err error
+		current, exists := c.current[name]
+		switch {
+		case !exists:
+			err = c.create(ctx, policy)
+		case !current.sameSpec(policy):
+			err = c.update(ctx, policy)
+		}
+		if err != nil {
+			diags.AddError("Policy Bundle Change Failed", fmt.Sprintf("%s. The bundle's other changes in this apply are being rolled back.", err.Error()))
+			c.rollback(ctx, diags)
+			return
+		}
+	}
+
+	for _, name := range slices.Clone(c.order) {
+		if _, exists := c.current[name]; !exists || plannedNames[name] {
+			continue
+		}
+		if err := c.remove(ctx, name); err != nil {
+			diags.AddError("Policy Bundle Delete Failed",
+				fmt.Sprintf("%s. The bundle's other changes were applied; the policies not yet deleted stay in state and are deleted by the next apply.", err.Error()))
+			return
+		}
+	}
+}
+
+// rollback reverts the recorded creates and updates, newest first.
+func (c *policyBundleChange) rollback(ctx context.Context, diags *diag.Diagnostics) {
+	for i := len(c.undo) - 1; i >= 0; i-- {
+		if err := c.undo[i](ctx); err != nil {
+			diags.AddError("Policy Bundle Rollback Failed", fmt.Sprintf("%s. The policy is kept in state; the next apply reconciles it with the configuration.", err.Error()))
+		}
+	}
+	c.undo = nil
+}
+
+// policies returns the existing policies in state order.
+func (c *policyBundleChange) policies(ctx context.Context) (types.List, diag.Diagnostics) {
+	policies := []policyBundlePolicyModel{}
+	for _, name := range c.order {
+		if policy, ok := c.current[name]; ok {
+			policies = append(policies, policy)
+		}
+	}
+	return types.ListValueFrom(ctx, policyBundlePolicyObjectType, policies)
+}
+
+func (r *policyBundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan policyBundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	planned, diags := policyBundlePolicies(ctx, plan.Policies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	change := newPolicyBundleChange(r.client, planned, nil)
+	change.apply(ctx, planned, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() && len(change.current) == 0 {
+		// Fully rolled back: the bundle was not created.
+		return
+	}
+	r.saveState(ctx, plan, change, &resp.State, &resp.Diagnostics)
+}
+
+func (r *policyBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state policyBundleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	policies, diags := policyBundlePolicies(ctx, state.Policies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshed := make([]policyBundlePolicyModel, 0, len(policies))
+	for _, policy := range policies {
+		apiPolicy, err := r.client.GetPolicy(ctx, policy.UUID.ValueString())
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				tflog.Warn(ctx, "Bundle policy not found, removing it from state", map[string]any{"name": policy.Name.ValueString(), "uuid": policy.UUID.ValueString()})
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy %q (%s) of bundle %s: %s", policy.Name.ValueString(), policy.UUID.ValueString(), state.ID.ValueString(), err.Error()))
+			return
+		}
+		// Like groundcover_policy, only the revision is read back: the API
+		// does not return the other fields as they were sent.
+		policy.RevisionNumber = types.Int64Value(int64(apiPolicy.RevisionNumber))
+		refreshed = append(refreshed, policy)
+	}
+
+	state.Policies, diags = types.ListValueFrom(ctx, policyBundlePolicyObjectType, refreshed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *policyBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state policyBundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	planned, diags := policyBundlePolicies(ctx, plan.Policies)
+	resp.Diagnostics.Append(diags...)
+	existing, diags := policyBundlePolicies(ctx, state.Policies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	change := newPolicyBundleChange(r.client, planned, existing)
+	change.apply(ctx, planned, &resp.Diagnostics)
+	r.saveState(ctx, plan, change, &resp.State, &resp.Diagnostics)
+}
+
+func (r *policyBundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state policyBundleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	existing, diags := policyBundlePolicies(ctx, state.Policies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	change := newPolicyBundleChange(r.client, nil, existing)
+	change.apply(ctx, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		r.saveState(ctx, state, change, &resp.State, &resp.Diagnostics)
+	}
+}
+
+// saveState stores model with the policies that exist after change.
+func (r *policyBundleResource) saveState(ctx context.Context, model policyBundleResourceModel, change *policyBundleChange, state *tfsdk.State, diags *diag.Diagnostics) {
+	policies, listDiags := change.policies(ctx)
+	diags.Append(listDiags...)
+	if listDiags.HasError() {
+		return
+	}
+	model.ID = model.Name
+	model.Policies = policies
+	diags.Append(state.Set(ctx, &model)...)
+}
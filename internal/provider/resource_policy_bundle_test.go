@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccPolicyBundleResource(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("test-bundle")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyBundleResourceConfig(prefix, `
+    { name = "${local.prefix}-read", role = { read = "read" } },
+    { name = "${local.prefix}-write", role = { write = "write" }, description = "Writers" },`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("groundcover_policy_bundle.test", "id", prefix),
+					resource.TestCheckResourceAttr("groundcover_policy_bundle.test", "policies.#", "2"),
+					resource.TestCheckResourceAttrSet("groundcover_policy_bundle.test", "policies.0.uuid"),
+					resource.TestCheckResourceAttrSet("groundcover_policy_bundle.test", "policies.1.uuid"),
+				),
+			},
+			// Update one policy, add one, and remove one.
+			{
+				Config: testAccPolicyBundleResourceConfig(prefix, `
+    { name = "${local.prefix}-read", role = { admin = "admin" } },
+    { name = "${local.prefix}-admin", role = { admin = "admin" }, claim_role = "sso-admins" },`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("groundcover_policy_bundle.test", "policies.#", "2"),
+					resource.TestCheckResourceAttr("groundcover_policy_bundle.test", "policies.0.role.admin", "admin"),
+					resource.TestCheckResourceAttr("groundcover_policy_bundle.test", "policies.1.name", prefix+"-admin"),
+					resource.TestCheckResourceAttrSet("groundcover_policy_bundle.test", "policies.1.uuid"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyBundleResourceConfig(prefix, policies string) string {
+	return fmt.Sprintf(`
+locals {
+  prefix = %[1]q
+}
+
+resource "groundcover_policy_bundle" "test" {
+  name = local.prefix
+  policies = [%[2]s
+  ]
+}
+`, prefix, policies)
+}
+
+// policyBundlePolicy returns a bundle policy with only name and role set.
+func policyBundlePolicy(name, role string) policyBundlePolicyModel {
+	return policyBundlePolicyModel{
+		Name:           types.StringValue(name),
+		Role:           types.MapValueMust(types.StringType, map[string]attr.Value{role: types.StringValue(role)}),
+		Description:    types.StringNull(),
+		ClaimRole:      types.StringNull(),
+		DataScope:      types.ObjectNull(dataScopeAttrTypes()),
+		UUID:           types.StringUnknown(),
+		RevisionNumber: types.Int64Unknown(),
+	}
+}
+
+func policyBundleModel(t *testing.T, policies ...policyBundlePolicyModel) policyBundleResourceModel {
+	list, diags := types.ListValueFrom(context.Background(), policyBundlePolicyObjectType, policies)
+	require.False(t, diags.HasError(), "%v", diags)
+	return policyBundleResourceModel{ID: types.StringUnknown(), Name: types.StringValue("tenant"), Policies: list}
+}
+
+// mockPolicyNames returns the names of the mock's policies, sorted.
+func mockPolicyNames(t *testing.T, client ApiClient) []string {
+	policies, err := client.ListPolicies(context.Background())
+	require.NoError(t, err)
+	var names []string
+	for _, policy := range policies {
+		names = append(names, *policy.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestPolicyBundleCreateRollsBack(t *testing.T) {
+	ctx := context.Background()
+	_, client := newMockAPIClient(t)
+	taken := "taken"
+	_, err := client.CreatePolicy(ctx, &models.CreatePolicyRequest{Name: &taken})
+	require.NoError(t, err)
+
+	r := &policyBundleResource{client: client}
+	s := resourceSchema(ctx, r)
+	plan := tfsdk.Plan{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	require.False(t, plan.Set(ctx, policyBundleModel(t, policyBundlePolicy("a", "read"), policyBundlePolicy("b", "read"), policyBundlePolicy("taken", "read"))).HasError())
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), `failed to create policy "taken"`)
+	assert.True(t, resp.State.Raw.IsNull(), "a rolled back bundle is not created")
+	assert.Equal(t, []string{"taken"}, mockPolicyNames(t, client))
+}
+
+func TestPolicyBundleUpdateRollsBack(t *testing.T) {
+	ctx := context.Background()
+	_, client := newMockAPIClient(t)
+	r := &policyBundleResource{client: client}
+	s := resourceSchema(ctx, r)
+
+	// Create the bundle with policies a and b.
+	plan := tfsdk.Plan{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	require.False(t, plan.Set(ctx, policyBundleModel(t, policyBundlePolicy("a", "read"), policyBundlePolicy("b", "read"))).HasError())
+	created := fwresource.CreateResponse{State: tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &created)
+	require.False(t, created.Diagnostics.HasError(), "%v", created.Diagnostics)
+	var state policyBundleResourceModel
+	require.False(t, created.State.Get(ctx, &state).HasError())
+	existing, _ := policyBundlePolicies(ctx, state.Policies)
+	require.Len(t, existing, 2)
+
+	taken := "taken"
+	_, err := client.CreatePolicy(ctx, &models.CreatePolicyRequest{Name: &taken})
+	require.NoError(t, err)
+
+	// Update a, add c, remove b, and fail on creating taken.
+	updatedA := policyBundlePolicy("a", "admin")
+	updatedA.UUID = existing[0].UUID
+	require.False(t, plan.Set(ctx, policyBundleModel(t, updatedA, policyBundlePolicy("c", "read"), policyBundlePolicy("taken", "read"))).HasError())
+	resp := fwresource.UpdateResponse{State: created.State}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: created.State}, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+
+	assert.Equal(t, []string{"a", "b", "taken"}, mockPolicyNames(t, client))
+	a, err := client.GetPolicy(ctx, existing[0].UUID.ValueString())
+	require.NoError(t, err)
+	assert.Equal(t, models.RoleMap{"read": "read"}, a.Role, "the update of a is rolled back")
+
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	policies, _ := policyBundlePolicies(ctx, state.Policies)
+	require.Len(t, policies, 2)
+	assert.Equal(t, "a", policies[0].Name.ValueString())
+	assert.Equal(t, existing[0].Role, policies[0].Role)
+	assert.Equal(t, int64(a.RevisionNumber), policies[0].RevisionNumber.ValueInt64())
+	assert.Equal(t, "b", policies[1].Name.ValueString())
+}
+
+func TestPolicyBundleDeleteKeepsUndeletedPolicies(t *testing.T) {
+	ctx := context.Background()
+	m, client := newMockAPIClient(t)
+	r := &policyBundleResource{client: client}
+	s := resourceSchema(ctx, r)
+
+	plan := tfsdk.Plan{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	require.False(t, plan.Set(ctx, policyBundleModel(t, policyBundlePolicy("a", "read"), policyBundlePolicy("b", "read"))).HasError())
+	created := fwresource.CreateResponse{State: tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &created)
+	require.False(t, created.Diagnostics.HasError(), "%v", created.Diagnostics)
+	var state policyBundleResourceModel
+	require.False(t, created.State.Get(ctx, &state).HasError())
+	existing, _ := policyBundlePolicies(ctx, state.Policies)
+
+	m.fail(http.MethodDelete, "/api/rbac/policy/"+existing[1].UUID.ValueString(), http.StatusBadRequest, 1)
+	resp := fwresource.DeleteResponse{State: created.State}
+	r.Delete(ctx, fwresource.DeleteRequest{State: created.State}, &resp)
+	require.True(t, resp.Diagnostics.HasError())
+
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	policies, _ := policyBundlePolicies(ctx, state.Policies)
+	require.Len(t, policies, 1)
+	assert.Equal(t, "b", policies[0].Name.ValueString())
+	assert.Equal(t, []string{"b"}, mockPolicyNames(t, client))
+}
@@ -4,11 +4,14 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -585,3 +588,175 @@ func TestPolicyResource_StateUpgradeFunc(t *testing.T) {
 
 	t.Logf("✓ State upgrade correctly sets ID=%s from UUID=%s", upgradedStateData.ID.ValueString(), upgradedStateData.UUID.ValueString())
 }
+
+// conflictingPolicyClient serves GetPolicy from a fixed policy and rejects
+// UpdatePolicy with ErrConcurrency until the request carries that policy's revision.
+type conflictingPolicyClient struct {
+	ApiClient
+	latest  *models.Policy
+	updates int
+}
+
+func (c *conflictingPolicyClient) GetPolicy(_ context.Context, _ string) (*models.Policy, error) {
+	return c.latest, nil
+}
+
+func (c *conflictingPolicyClient) UpdatePolicy(_ context.Context, _ string, req *models.UpdatePolicyRequest) (*models.Policy, error) {
+	c.updates++
+	if req.CurrentRevision != c.latest.RevisionNumber {
+		return nil, ErrConcurrency
+	}
+	return &models.Policy{Name: req.Name, RevisionNumber: req.CurrentRevision + 1}, nil
+}
+
+func TestRetryPolicyUpdateOnConflict(t *testing.T) {
+	ctx := context.Background()
+	name := "team-a"
+
+	t.Run("retries against the latest revision", func(t *testing.T) {
+		client := &conflictingPolicyClient{latest: &models.Policy{Name: &name, Description: "edited in UI", RevisionNumber: 7}}
+		r := &policyResource{client: client, autoRetryOnConflict: true}
+		var diags diag.Diagnostics
+
+		policy, err := r.retryPolicyUpdateOnConflict(ctx, "uuid", &models.UpdatePolicyRequest{Name: &name, CurrentRevision: 6}, &diags)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if policy.RevisionNumber != 8 || client.updates != 1 {
+			t.Fatalf("expected one update producing revision 8, got revision %d after %d updates", policy.RevisionNumber, client.updates)
+		}
+		if diags.WarningsCount() != 1 {
+			t.Fatalf("expected a warning, got %v", diags)
+		}
+	})
+
+	t.Run("skips the update when the latest revision already matches", func(t *testing.T) {
+		client := &conflictingPolicyClient{latest: &models.Policy{Name: &name, RevisionNumber: 7}}
+		r := &policyResource{client: client, autoRetryOnConflict: true}
+		var diags diag.Diagnostics
+
+		policy, err := r.retryPolicyUpdateOnConflict(ctx, "uuid", &models.UpdatePolicyRequest{Name: &name, CurrentRevision: 6}, &diags)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if policy != client.latest || client.updates != 0 {
+			t.Fatalf("expected the latest policy without an update, got %d updates", client.updates)
+		}
+	})
+
+	t.Run("gives up after repeated conflicts", func(t *testing.T) {
+		client := &alwaysConflictingPolicyClient{conflictingPolicyClient{latest: &models.Policy{Name: &name, Description: "edited in UI", RevisionNumber: 7}}}
+		r := &policyResource{client: client, autoRetryOnConflict: true}
+		var diags diag.Diagnostics
+
+		_, err := r.retryPolicyUpdateOnConflict(ctx, "uuid", &models.UpdatePolicyRequest{Name: &name, CurrentRevision: 6}, &diags)
+		if !errors.Is(err, ErrConcurrency) {
+			t.Fatalf("expected ErrConcurrency, got %v", err)
+		}
+		if client.updates != maxPolicyConflictRetries {
+			t.Fatalf("expected %d update attempts, got %d", maxPolicyConflictRetries, client.updates)
+		}
+	})
+}
+
+type alwaysConflictingPolicyClient struct {
+	conflictingPolicyClient
+}
+
+func (c *alwaysConflictingPolicyClient) UpdatePolicy(_ context.Context, _ string, _ *models.UpdatePolicyRequest) (*models.Policy, error) {
+	c.updates++
+	return nil, ErrConcurrency
+}
+
+// listingPolicyClient serves ListPolicies from a fixed list or error.
+type listingPolicyClient struct {
+	ApiClient
+	policies []*models.PolicyWithEntityCount
+	err      error
+}
+
+func (c *listingPolicyClient) ListPolicies(_ context.Context) ([]*models.PolicyWithEntityCount, error) {
+	return c.policies, c.err
+}
+
+func TestWarnOnPolicyNameCollision(t *testing.T) {
+	ctx := context.Background()
+	taken := "team-a"
+	other := "team-b"
+	existing := []*models.PolicyWithEntityCount{
+		{Policy: models.Policy{UUID: "uuid-a", Name: &taken}},
+		{Policy: models.Policy{UUID: "uuid-b", Name: &other}},
+	}
+
+	tests := []struct {
+		name         string
+		client       *listingPolicyClient
+		policyName   string
+		ownUUID      string
+		wantWarnings int
+		wantSummary  string
+	}{
+		{name: "new policy with a free name", client: &listingPolicyClient{policies: existing}, policyName: "team-c"},
+		{name: "new policy with a taken name", client: &listingPolicyClient{policies: existing}, policyName: taken, wantWarnings: 1, wantSummary: "Policy Name Already In Use"},
+		{name: "rename onto another policy", client: &listingPolicyClient{policies: existing}, policyName: taken, ownUUID: "uuid-b", wantWarnings: 1, wantSummary: "Policy Name Already In Use"},
+		{name: "name held by the policy itself", client: &listingPolicyClient{policies: existing}, policyName: taken, ownUUID: "uuid-a"},
+		{name: "list failure", client: &listingPolicyClient{err: errors.New("boom")}, policyName: taken, wantWarnings: 1, wantSummary: "Policy Name Not Checked"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &policyResource{client: tt.client}
+			var diags diag.Diagnostics
+
+			r.warnOnPolicyNameCollision(ctx, tt.policyName, tt.ownUUID, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if diags.WarningsCount() != tt.wantWarnings {
+				t.Fatalf("expected %d warnings, got %v", tt.wantWarnings, diags)
+			}
+			if tt.wantWarnings > 0 && diags.Warnings()[0].Summary() != tt.wantSummary {
+				t.Fatalf("expected warning %q, got %q", tt.wantSummary, diags.Warnings()[0].Summary())
+			}
+		})
+	}
+}
+
+func TestAddReadOnlyPolicyChangeDiagnostic(t *testing.T) {
+	state := policyResourceModel{
+		UUID:            types.StringValue("uuid-a"),
+		Name:            types.StringValue("Admin"),
+		ReadOnly:        types.BoolValue(false),
+		IsSystemDefined: types.BoolValue(true),
+	}
+	if !policyIsReadOnly(state) {
+		t.Fatal("expected a system-defined policy to be read-only")
+	}
+	if policyIsReadOnly(policyResourceModel{ReadOnly: types.BoolValue(false), IsSystemDefined: types.BoolNull()}) {
+		t.Fatal("expected a regular policy not to be read-only")
+	}
+
+	for _, strict := range []bool{false, true} {
+		for _, destroy := range []bool{false, true} {
+			r := &policyResource{failOnReadOnlyChanges: strict}
+			var diags diag.Diagnostics
+			r.addReadOnlyPolicyChangeDiagnostic(state, destroy, &diags)
+
+			if strict {
+				if diags.ErrorsCount() != 1 || diags.WarningsCount() != 0 {
+					t.Fatalf("strict=%v destroy=%v: expected one error, got %v", strict, destroy, diags)
+				}
+				continue
+			}
+			if diags.ErrorsCount() != 0 || diags.WarningsCount() != 1 {
+				t.Fatalf("strict=%v destroy=%v: expected one warning, got %v", strict, destroy, diags)
+			}
+			wantAction := "update"
+			if destroy {
+				wantAction = "destroy"
+			}
+			if detail := diags.Warnings()[0].Detail(); !strings.Contains(detail, wantAction) {
+				t.Fatalf("expected warning detail to mention %q, got %q", wantAction, detail)
+			}
+		}
+	}
+}
@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &retentionPolicyResource{}
+	_ resource.ResourceWithConfigure   = &retentionPolicyResource{}
+	_ resource.ResourceWithImportState = &retentionPolicyResource{}
+)
+
+func NewRetentionPolicyResource() resource.Resource {
+	return &retentionPolicyResource{}
+}
+
+type retentionPolicyResource struct {
+	client ApiClient
+	// autoRetryOnConflict mirrors the provider's auto_retry_on_conflict setting.
+	autoRetryOnConflict bool
+}
+
+type retentionPolicyResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	DataType         types.String `tfsdk:"data_type"`
+	Retention        types.String `tfsdk:"retention"`
+	CustomRules      types.List   `tfsdk:"custom_rules"`
+	ColdMoveDuration types.String `tfsdk:"cold_move_duration"`
+	ColdVolume       types.String `tfsdk:"cold_volume"`
+	UUID             types.String `tfsdk:"uuid"`
+	Version          types.Int64  `tfsdk:"version"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+}
+
+type retentionPolicyCustomRuleModel struct {
+	Name      types.String `tfsdk:"name"`
+	Filters   types.String `tfsdk:"filters"`
+	Retention types.String `tfsdk:"retention"`
+}
+
+var retentionPolicyCustomRuleAttrTypes = map[string]attr.Type{
+	"name":      types.StringType,
+	"filters":   types.StringType,
+	"retention": types.StringType,
+}
+
+func (r *retentionPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_retention_policy"
+}
+
+func (r *retentionPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the retention of one data type (for example logs or traces) through the groundcover storage management policy. " +
+			"Each data type has exactly one policy: creating the resource takes over the existing policy if there is one, and because the API cannot delete policies, destroying the resource only removes it from Terraform state and leaves the last applied retention in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data type, used as the resource ID.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"data_type": schema.StringAttribute{
+				MarkdownDescription: "The data type the policy applies to, as named by the storage management API (for example `logs` or `traces`). Changing it manages a different policy.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"retention": schema.StringAttribute{
+				MarkdownDescription: "How long data of this type is kept, for example `30d`.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"custom_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Retention overrides for the data matching a filter, for example a shorter retention for a noisy namespace.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the rule.",
+							Required:            true,
+						},
+						"filters": schema.StringAttribute{
+							MarkdownDescription: "Filter selecting the data the rule applies to, in groundcover query syntax.",
+							Required:            true,
+						},
+						"retention": schema.StringAttribute{
+							MarkdownDescription: "How long the matching data is kept.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"cold_move_duration": schema.StringAttribute{
+				MarkdownDescription: "How long data stays in hot storage before it is moved to the cold volume.",
+				Optional:            true,
+			},
+			"cold_volume": schema.StringAttribute{
+				MarkdownDescription: "The cold storage volume data is moved to.",
+				Optional:            true,
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the policy.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "The policy version, sent back with each update to detect concurrent changes.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the current version of the policy was created.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *retentionPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	if pc, ok := req.ProviderData.(*providerClient); ok {
+		r.autoRetryOnConflict = pc.autoRetryOnConflict
+	}
+}
+
+// Create takes over the existing policy of the data type, or creates the
+// first one.
+func (r *retentionPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan retentionPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	dataType := plan.DataType.ValueString()
+
+	existing, err := r.client.GetRetentionPolicy(ctx, dataType)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		resp.Diagnostics.AddError("Error Creating Retention Policy", fmt.Sprintf("Could not read the existing %s retention policy: %s", dataType, err))
+		return
+	}
+
+	var policy *models.StorageManagementPolicyResponse
+	if existing != nil {
+		tflog.Debug(ctx, "Taking over existing retention policy", map[string]any{"data_type": dataType, "uuid": existing.UUID})
+		policy, err = r.update(ctx, plan, existing.Version, &resp.Diagnostics)
+	} else {
+		apiRequest := retentionPolicyRequestFromModel(ctx, plan, 1, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		policy, err = r.client.CreateRetentionPolicy(ctx, dataType, apiRequest)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Retention Policy", fmt.Sprintf("Could not create the %s retention policy: %s", dataType, err))
+		return
+	}
+
+	state := retentionPolicyModelFromAPI(ctx, plan, policy, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *retentionPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state retentionPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	dataType := state.DataType.ValueString()
+
+	policy, err := r.client.GetRetentionPolicy(ctx, dataType)
+	if errors.Is(err, ErrNotFound) {
+		tflog.Warn(ctx, "Retention policy not found, removing from state", map[string]any{"data_type": dataType})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Retention Policy", fmt.Sprintf("Could not read the %s retention policy: %s", dataType, err))
+		return
+	}
+
+	refreshed := retentionPolicyModelFromAPI(ctx, state, policy, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &refreshed)...)
+}
+
+// Update sends the plan with the policy's current version, read from the API
+// so that changes made outside Terraform since the last refresh do not cause
+// a version conflict.
+func (r *retentionPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan retentionPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	dataType := plan.DataType.ValueString()
+
+	current, err := r.client.GetRetentionPolicy(ctx, dataType)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Retention Policy", fmt.Sprintf("Could not read the %s retention policy before updating it: %s", dataType, err))
+		return
+	}
+
+	policy, err := r.update(ctx, plan, current.Version, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Retention Policy", fmt.Sprintf("Could not update the %s retention policy: %s", dataType, err))
+		return
+	}
+
+	state := retentionPolicyModelFromAPI(ctx, plan, policy, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// update sends plan as a new version of the policy on top of version. With
+// auto_retry_on_conflict, a version conflict is retried once against the
+// latest version.
+func (r *retentionPolicyResource) update(ctx context.Context, plan retentionPolicyResourceModel, version int64, diags *diag.Diagnostics) (*models.StorageManagementPolicyResponse, error) {
+	dataType := plan.DataType.ValueString()
+	apiRequest := retentionPolicyRequestFromModel(ctx, plan, version, diags)
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	policy, err := r.client.UpdateRetentionPolicy(ctx, dataType, apiRequest)
+	if errors.Is(err, ErrConcurrency) && r.autoRetryOnConflict {
+		tflog.Info(ctx, "Retention policy version conflict, retrying against the latest version", map[string]any{"data_type": dataType})
+		latest, getErr := r.client.GetRetentionPolicy(ctx, dataType)
+		if getErr != nil {
+			return nil, getErr
+		}
+		apiRequest.Version = &latest.Version
+		policy, err = r.client.UpdateRetentionPolicy(ctx, dataType, apiRequest)
+	}
+	if errors.Is(err, ErrConcurrency) {
+		return nil, fmt.Errorf("the policy was changed concurrently; refresh and apply again, or enable auto_retry_on_conflict: %w", err)
+	}
+	return policy, err
+}
+
+// Delete only removes the policy from state: the API has no way to delete or
+// reset a storage management policy.
+func (r *retentionPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state retentionPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Retention Policy Left in Place",
+		fmt.Sprintf("The groundcover API cannot delete storage management policies, so the %s retention policy was only removed from Terraform state. Its last applied settings remain in effect.", state.DataType.ValueString()),
+	)
+}
+
+// ImportState imports a policy by its data type.
+func (r *retentionPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data_type"), req.ID)...)
+}
+
+func retentionPolicyRequestFromModel(ctx context.Context, model retentionPolicyResourceModel, version int64, diags *diag.Diagnostics) *models.StorageManagementPolicyRequest {
+	customRules := []*models.CustomRule{}
+	if !model.CustomRules.IsNull() && !model.CustomRules.IsUnknown() {
+		var rules []retentionPolicyCustomRuleModel
+		diags.Append(model.CustomRules.ElementsAs(ctx, &rules, false)...)
+		for _, rule := range rules {
+			customRules = append(customRules, &models.CustomRule{
+				Name:      rule.Name.ValueStringPointer(),
+				Filters:   rule.Filters.ValueStringPointer(),
+				Retention: rule.Retention.ValueStringPointer(),
+			})
+		}
+	}
+
+	return &models.StorageManagementPolicyRequest{
+		Retention:        model.Retention.ValueStringPointer(),
+		CustomRules:      customRules,
+		ColdMoveDuration: model.ColdMoveDuration.ValueString(),
+		ColdVolume:       model.ColdVolume.ValueString(),
+		Version:          &version,
+	}
+}
+
+// retentionPolicyModelFromAPI returns prior refreshed from policy. Optional
+// attributes that are null in prior stay null while the API reports them
+// empty, so unset attributes do not show a diff.
+func retentionPolicyModelFromAPI(ctx context.Context, prior retentionPolicyResourceModel, policy *models.StorageManagementPolicyResponse, diags *diag.Diagnostics) retentionPolicyResourceModel {
+	model := prior
+	dataType := prior.DataType.ValueString()
+	if policy.DataType != "" {
+		dataType = policy.DataType
+	}
+	model.ID = types.StringValue(dataType)
+	model.DataType = types.StringValue(dataType)
+	model.Retention = types.StringValue(policy.Retention)
+	model.ColdMoveDuration = optionalStringValue(prior.ColdMoveDuration, policy.ColdMoveDuration)
+	model.ColdVolume = optionalStringValue(prior.ColdVolume, policy.ColdVolume)
+	model.UUID = types.StringValue(policy.UUID)
+	model.Version = types.Int64Value(policy.Version)
+	model.UpdatedAt = types.StringValue(policy.CreatedTimestamp.String())
+
+	if len(policy.CustomRules) == 0 && prior.CustomRules.IsNull() {
+		model.CustomRules = types.ListNull(types.ObjectType{AttrTypes: retentionPolicyCustomRuleAttrTypes})
+		return model
+	}
+	rules := make([]retentionPolicyCustomRuleModel, 0, len(policy.CustomRules))
+	for _, rule := range policy.CustomRules {
+		if rule == nil {
+			continue
+		}
+		rules = append(rules, retentionPolicyCustomRuleModel{
+			Name:      types.StringPointerValue(rule.Name),
+			Filters:   types.StringPointerValue(rule.Filters),
+			Retention: types.StringPointerValue(rule.Retention),
+		})
+	}
+	customRules, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: retentionPolicyCustomRuleAttrTypes}, rules)
+	diags.Append(listDiags...)
+	model.CustomRules = customRules
+	return model
+}
+
+// optionalStringValue returns the API value of an optional attribute, keeping
+// a null prior value while the API value is empty.
+func optionalStringValue(prior types.String, apiValue string) types.String {
+	if apiValue == "" && prior.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(apiValue)
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// versionedRetentionClient holds one retention policy and rejects updates
+// that do not carry its current version.
+type versionedRetentionClient struct {
+	ApiClient
+	policy  *models.StorageManagementPolicyResponse
+	creates int
+	updates int
+}
+
+func (c *versionedRetentionClient) GetRetentionPolicy(_ context.Context, _ string) (*models.StorageManagementPolicyResponse, error) {
+	if c.policy == nil {
+		return nil, ErrNotFound
+	}
+	return c.policy, nil
+}
+
+func (c *versionedRetentionClient) CreateRetentionPolicy(_ context.Context, dataType string, req *models.StorageManagementPolicyRequest) (*models.StorageManagementPolicyResponse, error) {
+	c.creates++
+	c.policy = &models.StorageManagementPolicyResponse{UUID: "policy-1", DataType: dataType, Retention: *req.Retention, CustomRules: req.CustomRules, Version: *req.Version}
+	return c.policy, nil
+}
+
+func (c *versionedRetentionClient) UpdateRetentionPolicy(_ context.Context, dataType string, req *models.StorageManagementPolicyRequest) (*models.StorageManagementPolicyResponse, error) {
+	c.updates++
+	if *req.Version != c.policy.Version {
+		return nil, ErrConcurrency
+	}
+	c.policy = &models.StorageManagementPolicyResponse{UUID: c.policy.UUID, DataType: dataType, Retention: *req.Retention, CustomRules: req.CustomRules, Version: c.policy.Version + 1}
+	return c.policy, nil
+}
+
+func retentionPolicyTestPlan(t *testing.T, r *retentionPolicyResource, model retentionPolicyResourceModel) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+	s := resourceSchema(ctx, r)
+	plan := tfsdk.Plan{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	require.False(t, plan.Set(ctx, &model).HasError())
+	return plan
+}
+
+func TestRetentionPolicyCreate(t *testing.T) {
+	ctx := context.Background()
+	planned := retentionPolicyResourceModel{
+		ID:               types.StringUnknown(),
+		DataType:         types.StringValue("logs"),
+		Retention:        types.StringValue("30d"),
+		CustomRules:      types.ListNull(types.ObjectType{AttrTypes: retentionPolicyCustomRuleAttrTypes}),
+		ColdMoveDuration: types.StringNull(),
+		ColdVolume:       types.StringNull(),
+		UUID:             types.StringUnknown(),
+		Version:          types.Int64Unknown(),
+		UpdatedAt:        types.StringUnknown(),
+	}
+
+	tests := []struct {
+		name        string
+		existing    *models.StorageManagementPolicyResponse
+		wantCreates int
+		wantUpdates int
+		wantVersion int64
+	}{
+		{name: "creates the first policy", wantCreates: 1, wantVersion: 1},
+		{
+			name:        "takes over an existing policy",
+			existing:    &models.StorageManagementPolicyResponse{UUID: "policy-1", DataType: "logs", Retention: "7d", Version: 4},
+			wantUpdates: 1,
+			wantVersion: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &versionedRetentionClient{policy: tt.existing}
+			r := &retentionPolicyResource{client: client}
+			s := resourceSchema(ctx, r)
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+
+			r.Create(ctx, resource.CreateRequest{Plan: retentionPolicyTestPlan(t, r, planned)}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var state retentionPolicyResourceModel
+			require.False(t, resp.State.Get(ctx, &state).HasError())
+			assert.Equal(t, tt.wantCreates, client.creates)
+			assert.Equal(t, tt.wantUpdates, client.updates)
+			assert.Equal(t, "logs", state.ID.ValueString())
+			assert.Equal(t, "30d", state.Retention.ValueString())
+			assert.Equal(t, tt.wantVersion, state.Version.ValueInt64())
+			assert.True(t, state.CustomRules.IsNull(), "unset custom_rules must stay null")
+			assert.True(t, state.ColdVolume.IsNull(), "unset cold_volume must stay null")
+		})
+	}
+}
+
+func TestRetentionPolicyUpdateConflict(t *testing.T) {
+	ctx := context.Background()
+	plan := retentionPolicyResourceModel{
+		DataType:    types.StringValue("traces"),
+		Retention:   types.StringValue("14d"),
+		CustomRules: types.ListNull(types.ObjectType{AttrTypes: retentionPolicyCustomRuleAttrTypes}),
+	}
+
+	t.Run("fails without auto retry", func(t *testing.T) {
+		client := &versionedRetentionClient{policy: &models.StorageManagementPolicyResponse{Version: 3}}
+		r := &retentionPolicyResource{client: client}
+		var diags diag.Diagnostics
+
+		_, err := r.update(ctx, plan, 2, &diags)
+		assert.True(t, errors.Is(err, ErrConcurrency), "got %v", err)
+		assert.Equal(t, 1, client.updates)
+	})
+
+	t.Run("retries against the latest version", func(t *testing.T) {
+		client := &versionedRetentionClient{policy: &models.StorageManagementPolicyResponse{Version: 3}}
+		r := &retentionPolicyResource{client: client, autoRetryOnConflict: true}
+		var diags diag.Diagnostics
+
+		policy, err := r.update(ctx, plan, 2, &diags)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), policy.Version)
+		assert.Equal(t, 2, client.updates)
+	})
+}
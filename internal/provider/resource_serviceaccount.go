@@ -6,11 +6,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
 
 	// SDK Imports
 	models "github.com/groundcover-com/groundcover-sdk-go/pkg/models"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,11 +37,13 @@ type serviceAccountResource struct {
 }
 
 type serviceAccountResourceModel struct {
-	ID          types.String `tfsdk:"id"`           // Service Account ID (computed)
-	Name        types.String `tfsdk:"name"`         // Service Account Name (required)
-	Email       types.String `tfsdk:"email"`        // Service Account Email (required)
-	PolicyUUIDs types.List   `tfsdk:"policy_uuids"` // List of Policy UUIDs (required)
-	Description types.String `tfsdk:"description"`  // Optional description
+	ID          types.String `tfsdk:"id"`            // Service Account ID (computed)
+	Name        types.String `tfsdk:"name"`          // Service Account Name (required)
+	Email       types.String `tfsdk:"email"`         // Service Account Email (required)
+	PolicyUUIDs types.List   `tfsdk:"policy_uuids"`  // List of Policy UUIDs (required)
+	Description types.String `tfsdk:"description"`   // Optional description
+	ApiKeyCount types.Int64  `tfsdk:"api_key_count"` // Number of active API keys (computed)
+	LastActive  types.String `tfsdk:"last_active"`   // Last use of any API key (computed)
 }
 
 func (r *serviceAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -73,6 +79,14 @@ func (r *serviceAccountResource) Schema(ctx context.Context, req resource.Schema
 				MarkdownDescription: "An optional description for the service account.",
 				Optional:            true,
 			},
+			"api_key_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of active (neither revoked nor expired) API keys of the service account. Updated on refresh.",
+				Computed:            true,
+			},
+			"last_active": schema.StringAttribute{
+				MarkdownDescription: "When any API key of the service account, including revoked and expired keys, was last used, in RFC3339 format. Null when no key has been used. Updated on refresh, so dormant accounts can be flagged from outputs.",
+				Computed:            true,
+			},
 			// Secret attribute removed
 		},
 	}
@@ -138,6 +152,9 @@ func (r *serviceAccountResource) Create(ctx context.Context, req resource.Create
 	}
 	plan.ID = types.StringValue(*saGeneratedIdPtr)
 	// Persist Name, Email, PolicyUUIDs, Description from the plan as they were the desired state
+	// A new service account has no API keys yet
+	plan.ApiKeyCount = types.Int64Value(0)
+	plan.LastActive = types.StringNull()
 
 	tflog.Info(ctx, "Saving new service account to state", map[string]any{"id": plan.ID.ValueString()})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -195,6 +212,8 @@ func (r *serviceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 	state.PolicyUUIDs = policyList
 
+	resp.Diagnostics.Append(r.readKeyActivity(ctx, &state)...)
+
 	tflog.Info(ctx, "Saving updated service account to state", map[string]any{"id": state.ID.ValueString(), "policies_count": len(policyUUIDs)})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -240,6 +259,7 @@ func (r *serviceAccountResource) Update(ctx context.Context, req resource.Update
 	tflog.Info(ctx, "Service Account updated successfully via SDK", map[string]any{"id": saID})
 
 	plan.ID = types.StringValue(saID)
+	resp.Diagnostics.Append(r.readKeyActivity(ctx, &plan)...)
 
 	tflog.Info(ctx, "Saving updated service account to state", map[string]any{"id": plan.ID.ValueString()})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -266,6 +286,51 @@ func (r *serviceAccountResource) Delete(ctx context.Context, req resource.Delete
 	// Terraform automatically removes the resource from state when Delete returns no error.
 }
 
+// readKeyActivity sets api_key_count and last_active of model from the
+// organization's API keys. A failure to list the keys is only a warning, so
+// tokens that cannot list API keys can still manage service accounts; the
+// attributes are then null.
+func (r *serviceAccountResource) readKeyActivity(ctx context.Context, model *serviceAccountResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	withRevoked := true
+	withExpired := true
+	keys, err := r.client.ListApiKeys(ctx, &withRevoked, &withExpired)
+	if err != nil {
+		diags.AddWarning("Cannot Read Service Account API Keys",
+			fmt.Sprintf("Could not list API keys to report api_key_count and last_active of service account %s: %s", model.ID.ValueString(), err.Error()))
+		model.ApiKeyCount = types.Int64Null()
+		model.LastActive = types.StringNull()
+		return diags
+	}
+
+	count, lastActive := serviceAccountKeyActivity(keys, model.ID.ValueString(), time.Now())
+	model.ApiKeyCount = types.Int64Value(count)
+	model.LastActive = optionalDateTimeString(lastActive)
+	return diags
+}
+
+// serviceAccountKeyActivity returns the number of keys of the service account
+// that are neither revoked nor expired at now, and the latest use of any of
+// its keys.
+func serviceAccountKeyActivity(keys []*models.ListAPIKeysResponseItem, serviceAccountID string, now time.Time) (int64, strfmt.DateTime) {
+	var count int64
+	var lastActive strfmt.DateTime
+	for _, key := range keys {
+		if key == nil || key.ServiceAccountID != serviceAccountID {
+			continue
+		}
+		expired := !key.ExpiredAt.IsZero() && !time.Time(key.ExpiredAt).After(now)
+		if key.RevokedAt.IsZero() && !expired {
+			count++
+		}
+		if time.Time(key.LastActive).After(time.Time(lastActive)) {
+			lastActive = key.LastActive
+		}
+	}
+	return count, lastActive
+}
+
 func (r *serviceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
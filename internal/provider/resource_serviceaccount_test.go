@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -28,6 +31,8 @@ func TestAccServiceAccountResource(t *testing.T) {
 					resource.TestCheckResourceAttr("groundcover_serviceaccount.test", "name", name),
 					resource.TestCheckResourceAttrSet("groundcover_serviceaccount.test", "id"),
 					resource.TestCheckResourceAttr("groundcover_serviceaccount.test", "email", "test-"+name+"@example.com"),
+					resource.TestCheckResourceAttr("groundcover_serviceaccount.test", "api_key_count", "0"),
+					resource.TestCheckNoResourceAttr("groundcover_serviceaccount.test", "last_active"),
 				),
 			},
 			// Update and Read testing
@@ -149,3 +154,30 @@ func testAccCheckServiceAccountResourceDisappears(n string) resource.TestCheckFu
 		return nil
 	}
 }
+
+func TestServiceAccountKeyActivity(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := func(days int) strfmt.DateTime { return strfmt.DateTime(now.AddDate(0, 0, days)) }
+
+	keys := []*models.ListAPIKeysResponseItem{
+		{ID: "active", ServiceAccountID: "sa", LastActive: at(-10)},
+		{ID: "expires later", ServiceAccountID: "sa", ExpiredAt: at(30)},
+		{ID: "revoked", ServiceAccountID: "sa", RevokedAt: at(-2), LastActive: at(-3)},
+		{ID: "expired", ServiceAccountID: "sa", ExpiredAt: at(-1), LastActive: at(-5)},
+		{ID: "other account", ServiceAccountID: "other", LastActive: at(0)},
+		nil,
+	}
+
+	count, lastActive := serviceAccountKeyActivity(keys, "sa", now)
+	if count != 2 {
+		t.Fatalf("api_key_count = %d, want 2 (active and expires later)", count)
+	}
+	if !time.Time(lastActive).Equal(now.AddDate(0, 0, -3)) {
+		t.Fatalf("last_active = %s, want the revoked key's last use", lastActive)
+	}
+
+	count, lastActive = serviceAccountKeyActivity(keys, "unused", now)
+	if count != 0 || !lastActive.IsZero() {
+		t.Fatalf("serviceAccountKeyActivity() for an account without keys = %d, %s, want 0 and unset", count, lastActive)
+	}
+}
@@ -11,12 +11,15 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -35,13 +38,19 @@ type silenceResource struct {
 }
 
 type silenceResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	StartsAt types.String `tfsdk:"starts_at"`
-	EndsAt   types.String `tfsdk:"ends_at"`
-	Comment  types.String `tfsdk:"comment"`
-	Matchers types.List   `tfsdk:"matchers"`
+	ID             types.String `tfsdk:"id"`
+	StartsAt       types.String `tfsdk:"starts_at"`
+	EndsAt         types.String `tfsdk:"ends_at"`
+	Comment        types.String `tfsdk:"comment"`
+	Matchers       types.List   `tfsdk:"matchers"`
+	ExpireBehavior types.String `tfsdk:"expire_behavior"`
 }
 
+const (
+	silenceExpireBehaviorKeep            = "keep"
+	silenceExpireBehaviorRemoveFromState = "remove_from_state"
+)
+
 func (r *silenceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_silence"
 }
@@ -52,7 +61,9 @@ func (r *silenceResource) Schema(ctx context.Context, req resource.SchemaRequest
 
 Silences allow you to suppress alerts for a specific time window based on matching criteria. This is useful for planned maintenance, deployments, or other situations where you want to temporarily mute alerts.
 
-A silence is defined by: a time window (starts_at, ends_at), a comment describing the reason for the silence, and one or more matchers that define which alerts to silence.`,
+A silence is defined by: a time window (starts_at, ends_at), a comment describing the reason for the silence, and one or more matchers that define which alerts to silence.
+
+Set ` + "`expire_behavior`" + ` to ` + "`\"remove_from_state\"`" + ` to drop silences from state once they have ended, instead of refreshing expired silences forever.`,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -65,10 +76,16 @@ A silence is defined by: a time window (starts_at, ends_at), a comment describin
 			"starts_at": schema.StringAttribute{
 				MarkdownDescription: "The start time of the silence in RFC3339 format UTC 0 (e.g., `2024-01-15T10:00:00Z`).",
 				Required:            true,
+				Validators: []validator.String{
+					validators.RFC3339Time(),
+				},
 			},
 			"ends_at": schema.StringAttribute{
 				MarkdownDescription: "The end time of the silence in RFC3339 format UTC 0 (e.g., `2024-01-15T12:00:00Z`).",
 				Required:            true,
+				Validators: []validator.String{
+					validators.RFC3339Time(),
+				},
 			},
 			"comment": schema.StringAttribute{
 				MarkdownDescription: "A comment describing the reason for the silence.",
@@ -106,6 +123,13 @@ A silence is defined by: a time window (starts_at, ends_at), a comment describin
 					},
 				},
 			},
+			"expire_behavior": schema.StringAttribute{
+				MarkdownDescription: "What refresh does once the silence has ended (`ends_at` is in the past): `\"keep\"` keeps it in state, `\"remove_from_state\"` removes it from state with a warning. The expired silence is not deleted in groundcover. Remove its configuration too, for example by filtering a `for_each` on `timecmp(ends_at, plantimestamp()) > 0`, or the next plan creates it again. Defaults to `\"keep\"`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(silenceExpireBehaviorKeep, silenceExpireBehaviorRemoveFromState),
+				},
+			},
 		},
 	}
 }
@@ -139,27 +163,10 @@ func (r *silenceResource) ValidateConfig(ctx context.Context, req resource.Valid
 		return
 	}
 
-	startsAt, err := time.Parse(time.RFC3339, config.StartsAt.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("starts_at"),
-			"Invalid starts_at format",
-			fmt.Sprintf("starts_at must be in RFC3339 format UTC 0 (e.g., 2024-01-15T10:00:00Z): %s", err.Error()),
-		)
-		return
-	}
-
-	endsAt, err := time.Parse(time.RFC3339, config.EndsAt.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("ends_at"),
-			"Invalid ends_at format",
-			fmt.Sprintf("ends_at must be in RFC3339 format UTC 0 (e.g., 2024-01-15T12:00:00Z): %s", err.Error()),
-		)
-		return
-	}
-
-	if !endsAt.After(startsAt) {
+	// Malformed timestamps are reported by the attributes' RFC3339Time validators.
+	startsAt, startErr := time.Parse(time.RFC3339, config.StartsAt.ValueString())
+	endsAt, endErr := time.Parse(time.RFC3339, config.EndsAt.ValueString())
+	if startErr == nil && endErr == nil && !endsAt.After(startsAt) {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("ends_at"),
 			"Invalid time range",
@@ -196,6 +203,11 @@ func parseRFC3339(value string) (strfmt.DateTime, error) {
 	return strfmt.DateTime(t), nil
 }
 
+// silenceExpired reports whether a silence ending at endsAt has ended at now.
+func silenceExpired(endsAt strfmt.DateTime, now time.Time) bool {
+	return !endsAt.IsZero() && !time.Time(endsAt).After(now)
+}
+
 // --- CRUD Operations ---
 
 func (r *silenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -316,6 +328,16 @@ func (r *silenceResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	tflog.Info(ctx, "Silence read successfully via SDK", map[string]any{"id": silenceID})
 
+	if state.ExpireBehavior.ValueString() == silenceExpireBehaviorRemoveFromState && silenceExpired(apiResponse.EndsAt, time.Now()) {
+		resp.Diagnostics.AddWarning(
+			"Expired Silence Removed From State",
+			fmt.Sprintf("Silence %s ended at %s and expire_behavior is \"remove_from_state\", so it was removed from Terraform state. It was not deleted in groundcover. Remove it from the configuration, or the next plan creates it again.",
+				silenceID, time.Time(apiResponse.EndsAt).Format(time.RFC3339)),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Unconditionally update state from API response to ensure state reflects the API
 	if apiResponse.UUID.String() != "" {
 		state.ID = types.StringValue(apiResponse.UUID.String())
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -170,6 +171,28 @@ func TestAccSilenceResource_applyLoop_noComment(t *testing.T) {
 	})
 }
 
+func TestSilenceExpired(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		endsAt strfmt.DateTime
+		want   bool
+	}{
+		{name: "ended", endsAt: strfmt.DateTime(now.Add(-time.Minute)), want: true},
+		{name: "ends now", endsAt: strfmt.DateTime(now), want: true},
+		{name: "still active", endsAt: strfmt.DateTime(now.Add(time.Minute)), want: false},
+		{name: "no end returned", endsAt: strfmt.DateTime{}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := silenceExpired(tt.endsAt, now); got != tt.want {
+				t.Fatalf("silenceExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func testAccSilenceResourceConfig_noComment(startsAt, endsAt string) string {
 	return fmt.Sprintf(`
 resource "groundcover_silence" "test" {
@@ -4,9 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/customtypes"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -30,6 +35,7 @@ var (
 	_ resource.ResourceWithConfigure      = &syntheticTestResource{}
 	_ resource.ResourceWithImportState    = &syntheticTestResource{}
 	_ resource.ResourceWithValidateConfig = &syntheticTestResource{}
+	_ resource.ResourceWithModifyPlan     = &syntheticTestResource{}
 )
 
 func NewSyntheticTestResource() resource.Resource {
@@ -43,11 +49,11 @@ type syntheticTestResource struct {
 // --- Terraform state models ---
 
 type syntheticTestResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	Enabled  types.Bool   `tfsdk:"enabled"`
-	Interval types.String `tfsdk:"interval"`
-	Version  types.Int64  `tfsdk:"version"`
+	ID       types.String         `tfsdk:"id"`
+	Name     types.String         `tfsdk:"name"`
+	Enabled  types.Bool           `tfsdk:"enabled"`
+	Interval customtypes.Duration `tfsdk:"interval"`
+	Version  types.Int64          `tfsdk:"version"`
 
 	HTTPCheck *syntheticHTTPCheckModel `tfsdk:"http_check"`
 	SSLCheck  *syntheticSSLCheckModel  `tfsdk:"ssl_check"`
@@ -57,15 +63,19 @@ type syntheticTestResourceModel struct {
 	Retry     *syntheticRetryModel     `tfsdk:"retry"`
 	Labels    types.Map                `tfsdk:"labels"`
 	Monitor   *syntheticMonitorModel   `tfsdk:"monitor"`
+
+	CreateMonitor types.Bool   `tfsdk:"create_monitor"`
+	MonitorID     types.String `tfsdk:"monitor_id"`
 }
 
 type syntheticHTTPCheckModel struct {
-	URL             types.String `tfsdk:"url"`
-	Method          types.String `tfsdk:"method"`
-	Timeout         types.String `tfsdk:"timeout"`
-	Headers         types.Map    `tfsdk:"headers"`
-	FollowRedirects types.Bool   `tfsdk:"follow_redirects"`
-	AllowInsecure   types.Bool   `tfsdk:"allow_insecure"`
+	URL             types.String         `tfsdk:"url"`
+	Method          types.String         `tfsdk:"method"`
+	Timeout         customtypes.Duration `tfsdk:"timeout"`
+	Headers         types.Map            `tfsdk:"headers"`
+	UserAgent       types.String         `tfsdk:"user_agent"`
+	FollowRedirects types.Bool           `tfsdk:"follow_redirects"`
+	AllowInsecure   types.Bool           `tfsdk:"allow_insecure"`
 
 	Body *syntheticHTTPBodyModel `tfsdk:"body"`
 	Auth *syntheticHTTPAuthModel `tfsdk:"auth"`
@@ -84,30 +94,30 @@ type syntheticHTTPAuthModel struct {
 }
 
 type syntheticSSLCheckModel struct {
-	Host       types.String `tfsdk:"host"`
-	Port       types.Int64  `tfsdk:"port"`
-	Verify     types.Bool   `tfsdk:"verify"`
-	MinVersion types.String `tfsdk:"min_version"`
-	Sni        types.String `tfsdk:"sni"`
-	Timeout    types.String `tfsdk:"timeout"`
+	Host       types.String         `tfsdk:"host"`
+	Port       types.Int64          `tfsdk:"port"`
+	Verify     types.Bool           `tfsdk:"verify"`
+	MinVersion types.String         `tfsdk:"min_version"`
+	Sni        types.String         `tfsdk:"sni"`
+	Timeout    customtypes.Duration `tfsdk:"timeout"`
 }
 
 type syntheticTCPCheckModel struct {
-	Host            types.String `tfsdk:"host"`
-	Port            types.Int64  `tfsdk:"port"`
-	Send            types.String `tfsdk:"send"`
-	ExpectResponse  types.Bool   `tfsdk:"expect_response"`
-	ReceiveMaxBytes types.Int64  `tfsdk:"receive_max_bytes"`
-	Timeout         types.String `tfsdk:"timeout"`
+	Host            types.String         `tfsdk:"host"`
+	Port            types.Int64          `tfsdk:"port"`
+	Send            types.String         `tfsdk:"send"`
+	ExpectResponse  types.Bool           `tfsdk:"expect_response"`
+	ReceiveMaxBytes types.Int64          `tfsdk:"receive_max_bytes"`
+	Timeout         customtypes.Duration `tfsdk:"timeout"`
 }
 
 type syntheticDNSCheckModel struct {
-	Domain     types.String `tfsdk:"domain"`
-	Port       types.Int64  `tfsdk:"port"`
-	Resolver   types.String `tfsdk:"resolver"`
-	RecordType types.String `tfsdk:"record_type"`
-	Dnssec     types.Bool   `tfsdk:"dnssec"`
-	Timeout    types.String `tfsdk:"timeout"`
+	Domain     types.String         `tfsdk:"domain"`
+	Port       types.Int64          `tfsdk:"port"`
+	Resolver   types.String         `tfsdk:"resolver"`
+	RecordType types.String         `tfsdk:"record_type"`
+	Dnssec     types.Bool           `tfsdk:"dnssec"`
+	Timeout    customtypes.Duration `tfsdk:"timeout"`
 }
 
 type syntheticAssertionModel struct {
@@ -146,9 +156,20 @@ var propertyAsSources = map[string]struct{}{
 
 var baseSources = []string{
 	"statusCode", "responseTime", "responseHeader", "jsonBody", "responseBody",
+	"responseSize", "dnsTime", "tlsTime", "ttfb",
 	"ssl", "tcp", "dnsAnswer",
 }
 
+// httpMeasurementSources are the HTTP sources that assert on a whole-number
+// measurement: the response body size in bytes, or a phase of the request in
+// milliseconds.
+var httpMeasurementSources = map[string]string{
+	"responseSize": "bytes",
+	"dnsTime":      "milliseconds",
+	"tlsTime":      "milliseconds",
+	"ttfb":         "milliseconds",
+}
+
 func allValidSources() []string {
 	s := make([]string, len(baseSources), len(baseSources)+len(propertyAsSources))
 	copy(s, baseSources)
@@ -159,8 +180,8 @@ func allValidSources() []string {
 }
 
 type syntheticRetryModel struct {
-	Count    types.Int64  `tfsdk:"count"`
-	Interval types.String `tfsdk:"interval"`
+	Count    types.Int64          `tfsdk:"count"`
+	Interval customtypes.Duration `tfsdk:"interval"`
 }
 
 type syntheticMonitorModel struct {
@@ -170,8 +191,8 @@ type syntheticMonitorModel struct {
 	IssueDescription       types.String                       `tfsdk:"issue_description"`
 	NoDataState            types.String                       `tfsdk:"no_data_state"`
 	ExecutionErrorState    types.String                       `tfsdk:"execution_error_state"`
-	LookbehindWindow       types.String                       `tfsdk:"lookbehind_window"`
-	RenotificationInterval types.String                       `tfsdk:"renotification_interval"`
+	LookbehindWindow       customtypes.Duration               `tfsdk:"lookbehind_window"`
+	RenotificationInterval customtypes.Duration               `tfsdk:"renotification_interval"`
 	EnabledWorkflows       types.List                         `tfsdk:"enabled_workflows"`
 	NotificationMethod     types.String                       `tfsdk:"notification_method"`
 	ConnectedApps          types.List                         `tfsdk:"connected_apps"`
@@ -181,8 +202,8 @@ type syntheticMonitorModel struct {
 }
 
 type syntheticMonitorEvalIntervalModel struct {
-	Interval   types.String `tfsdk:"interval"`
-	PendingFor types.String `tfsdk:"pending_for"`
+	Interval   customtypes.Duration `tfsdk:"interval"`
+	PendingFor customtypes.Duration `tfsdk:"pending_for"`
 }
 
 // --- Schema ---
@@ -214,6 +235,7 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 			},
 			"interval": schema.StringAttribute{
 				Description: "How often the check runs. Supported values: `15s`, `30s`, `1m`, `5m`, `10m`, `15m`, `30m`, `1h`.",
+				CustomType:  customtypes.DurationType{},
 				Required:    true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("15s", "30s", "1m", "5m", "10m", "15m", "30m", "1h"),
@@ -239,6 +261,9 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 					"url": schema.StringAttribute{
 						Description: "(Required) The URL to check (must include http:// or https://).",
 						Optional:    true,
+						Validators: []validator.String{
+							validators.HTTPURL(),
+						},
 					},
 					"method": schema.StringAttribute{
 						Description: "(Required) HTTP method. Supported: `GET`, `POST`, `PUT`, `PATCH`, `DELETE`, `HEAD`, `OPTIONS`.",
@@ -249,15 +274,23 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 					},
 					"timeout": schema.StringAttribute{
 						Description: "Request timeout (e.g. `10s`, `30s`).",
+						CustomType:  customtypes.DurationType{},
 						Optional:    true,
 						Computed:    true,
 						Default:     stringdefault.StaticString("10s"),
+						Validators: []validator.String{
+							validators.DurationString(),
+						},
 					},
 					"headers": schema.MapAttribute{
 						Description: "HTTP headers to send with the request.",
 						Optional:    true,
 						ElementType: types.StringType,
 					},
+					"user_agent": schema.StringAttribute{
+						Description: "Custom `User-Agent` for the request. Sent as a `User-Agent` header; cannot be combined with a `User-Agent` entry in `headers`.",
+						Optional:    true,
+					},
 					"follow_redirects": schema.BoolAttribute{
 						Description: "Whether to follow HTTP redirects.",
 						Optional:    true,
@@ -340,7 +373,11 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 					},
 					"timeout": schema.StringAttribute{
 						Description: "Timeout for the SSL check (e.g. `5s`, `10s`).",
+						CustomType:  customtypes.DurationType{},
 						Optional:    true,
+						Validators: []validator.String{
+							validators.DurationString(),
+						},
 					},
 				},
 			},
@@ -375,7 +412,11 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 					},
 					"timeout": schema.StringAttribute{
 						Description: "Timeout for the TCP check (e.g. `5s`, `10s`).",
+						CustomType:  customtypes.DurationType{},
 						Optional:    true,
+						Validators: []validator.String{
+							validators.DurationString(),
+						},
 					},
 				},
 			},
@@ -410,7 +451,11 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 					},
 					"timeout": schema.StringAttribute{
 						Description: "Timeout for the DNS check (e.g. `5s`, `10s`).",
+						CustomType:  customtypes.DurationType{},
 						Optional:    true,
+						Validators: []validator.String{
+							validators.DurationString(),
+						},
 					},
 				},
 			},
@@ -419,7 +464,7 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"source": schema.StringAttribute{
-							Description: "What to assert on. All check types: `responseTime`. HTTP: `statusCode`, `responseHeader`, `jsonBody`, `responseBody`. " +
+							Description: "What to assert on. All check types: `responseTime`. HTTP: `statusCode`, `responseHeader`, `jsonBody`, `responseBody`, `responseSize`, `dnsTime`, `tlsTime`, `ttfb`. " +
 								"SSL/TLS: `certificateValid`, `certificateExpiresIn`, `tlsVersion`, `chainValid`, `cipherSuite`. " +
 								"TCP: `tcpConnection`, `responseContains`. DNS: `dnsAnswer`.",
 							Required: true,
@@ -461,12 +506,16 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 					},
 					"interval": schema.StringAttribute{
 						Description: "Delay between retries (e.g. `1s`, `500ms`).",
+						CustomType:  customtypes.DurationType{},
 						Optional:    true,
+						Validators: []validator.String{
+							validators.DurationString(),
+						},
 					},
 				},
 			},
 			"monitor": schema.SingleNestedBlock{
-				Description: "Monitor configuration for the synthetic test. Controls the monitor that is automatically created for this test, including alerting behavior and notification routing.",
+				Description: "Monitor configuration for the synthetic test. Controls the monitor that is automatically created for this test unless `create_monitor` is `false`, including alerting behavior and notification routing.",
 				Attributes: map[string]schema.Attribute{
 					"monitor_name": schema.StringAttribute{
 						Description: "Custom name for the monitor. If not set, a default name is derived from the synthetic test name.",
@@ -503,11 +552,19 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 					},
 					"lookbehind_window": schema.StringAttribute{
 						Description: "The time window the monitor looks back for evaluation (e.g. `5m`, `10m`).",
+						CustomType:  customtypes.DurationType{},
 						Optional:    true,
+						Validators: []validator.String{
+							validators.DurationString(),
+						},
 					},
 					"renotification_interval": schema.StringAttribute{
 						Description: "How long to wait before sending another notification while the alert is still firing (e.g. `15m`, `1h`, `4h`).",
+						CustomType:  customtypes.DurationType{},
 						Optional:    true,
+						Validators: []validator.String{
+							validators.DurationString(),
+						},
 					},
 					"enabled_workflows": schema.ListAttribute{
 						Description: "List of workflow IDs to route notifications to. Workflows and notification policies run simultaneously.",
@@ -530,6 +587,9 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 						Description: "Which issue statuses trigger notifications. Supported values: `Alerting`, `Resolved`. Only applicable when `notification_method` is `connectedApps`.",
 						Optional:    true,
 						ElementType: types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(validators.OneOfCaseInsensitive("Alerting", "Resolved")),
+						},
 					},
 					"disable_renotification": schema.BoolAttribute{
 						Description: "Disable repeated notifications for the same issue.",
@@ -542,11 +602,19 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 						Attributes: map[string]schema.Attribute{
 							"interval": schema.StringAttribute{
 								Description: "How often the monitor evaluates (e.g. `1m`, `5m`).",
+								CustomType:  customtypes.DurationType{},
 								Optional:    true,
+								Validators: []validator.String{
+									validators.DurationString(),
+								},
 							},
 							"pending_for": schema.StringAttribute{
 								Description: "How long all evaluations must stay true before firing (e.g. `0s`, `1m`, `5m`).",
+								CustomType:  customtypes.DurationType{},
 								Optional:    true,
+								Validators: []validator.String{
+									validators.DurationString(),
+								},
 							},
 						},
 					},
@@ -554,6 +622,9 @@ func (r *syntheticTestResource) Schema(_ context.Context, _ resource.SchemaReque
 			},
 		},
 	}
+	for name, attribute := range syntheticTestMonitorAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -635,6 +706,17 @@ func (r *syntheticTestResource) ValidateConfig(ctx context.Context, req resource
 				)
 			}
 		}
+		if !config.HTTPCheck.UserAgent.IsNull() && !config.HTTPCheck.Headers.IsNull() && !config.HTTPCheck.Headers.IsUnknown() {
+			for k := range config.HTTPCheck.Headers.Elements() {
+				if strings.EqualFold(k, userAgentHeader) {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("http_check").AtName("user_agent"),
+						"Conflicting user agent configuration",
+						fmt.Sprintf("user_agent cannot be set together with a %q entry in headers.", k),
+					)
+				}
+			}
+		}
 	}
 
 	if hasSSL {
@@ -716,8 +798,40 @@ func (r *syntheticTestResource) ValidateConfig(ctx context.Context, req resource
 				fmt.Sprintf("When source is %q, the property field must not be set — the source already specifies the property.", src),
 			)
 		}
+
+		isCertExpiry := src == "certificateExpiresIn" ||
+			(src == "ssl" && hasProp && assertion.Property.ValueString() == "certificateExpiresIn")
+		if isCertExpiry && !assertion.Operator.IsUnknown() && !assertion.Target.IsUnknown() {
+			if msg := certificateExpiryAssertionError(assertion.Operator.ValueString(), assertion.Target.ValueString()); msg != "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("assertion").AtListIndex(i),
+					"Invalid certificate expiry assertion",
+					msg,
+				)
+			}
+		}
+
+		if unit, ok := httpMeasurementSources[src]; ok {
+			if !hasHTTP {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("assertion").AtListIndex(i).AtName("source"),
+					"Invalid assertion source",
+					fmt.Sprintf("The %s source is only supported with http_check.", src),
+				)
+			} else if !assertion.Operator.IsUnknown() && !assertion.Target.IsUnknown() {
+				if msg := measurementAssertionError(src, unit, assertion.Operator.ValueString(), assertion.Target.ValueString()); msg != "" {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("assertion").AtListIndex(i),
+						"Invalid measurement assertion",
+						msg,
+					)
+				}
+			}
+		}
 	}
 
+	validateMonitorConfig(config, &resp.Diagnostics)
+
 	// Validate notification routing invariants
 	if config.Monitor != nil {
 		method := config.Monitor.NotificationMethod
@@ -805,6 +919,7 @@ func (r *syntheticTestResource) Create(ctx context.Context, req resource.CreateR
 
 	plan.ID = types.StringValue(createdResp.ID)
 	plan.Version = types.Int64Value(1)
+	r.readMonitor(ctx, &plan, false, &resp.Diagnostics)
 
 	tflog.Debug(ctx, fmt.Sprintf("Synthetic Test created with ID: %s", createdResp.ID))
 
@@ -852,6 +967,7 @@ func (r *syntheticTestResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	fromSDKResponse(ctx, sdkResp, &state)
+	r.readMonitor(ctx, &state, true, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -886,7 +1002,26 @@ func (r *syntheticTestResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	plan.Version = types.Int64Value(1)
+	// Read the test back rather than trusting the request, so values the API
+	// normalizes are stored as it holds them. fromSDKResponse keeps configured
+	// values that are equivalent to what the API returns.
+	sdkResp, err := r.client.GetSyntheticTest(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Updated Synthetic Test",
+			fmt.Sprintf("Could not read Synthetic Test %s after update: %s", plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+	if sdkResp == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Updated Synthetic Test",
+			fmt.Sprintf("Synthetic Test %s was not found after update.", plan.ID.ValueString()),
+		)
+		return
+	}
+	fromSDKResponse(ctx, sdkResp, &plan)
+	r.readMonitor(ctx, &plan, false, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -994,6 +1129,36 @@ func assertionModelsFromList(ctx context.Context, assertions types.List, allowUn
 	return assertionModels, diags
 }
 
+// certificateExpiryAssertionError returns a validation message for a
+// certificateExpiresIn assertion, or "" when it is valid. The backend compares
+// the remaining certificate lifetime in whole days, so only gt/lt against a
+// non-negative integer target is meaningful.
+func certificateExpiryAssertionError(operator, target string) string {
+	if operator != "gt" && operator != "lt" {
+		return fmt.Sprintf("certificateExpiresIn only supports the \"gt\" and \"lt\" operators, got %q.", operator)
+	}
+	days, err := strconv.Atoi(target)
+	if err != nil || days < 0 {
+		return fmt.Sprintf("certificateExpiresIn target must be a non-negative number of days (e.g. \"30\"), got %q.", target)
+	}
+	return ""
+}
+
+// measurementAssertionError returns a validation message for an assertion on
+// one of httpMeasurementSources, or "" when it is valid. The backend compares
+// these measurements numerically, so only gt/lt against a non-negative integer
+// target in the source's unit is meaningful.
+func measurementAssertionError(source, unit, operator, target string) string {
+	if operator != "gt" && operator != "lt" {
+		return fmt.Sprintf("%s only supports the \"gt\" and \"lt\" operators, got %q.", source, operator)
+	}
+	value, err := strconv.Atoi(target)
+	if err != nil || value < 0 {
+		return fmt.Sprintf("%s target must be a non-negative number of %s, got %q.", source, unit, target)
+	}
+	return ""
+}
+
 func assertionHasUnknownValues(assertion syntheticAssertionModel) bool {
 	return assertion.Source.IsUnknown() ||
 		assertion.Operator.IsUnknown() ||
@@ -1049,6 +1214,8 @@ func toSDKRequest(ctx context.Context, plan *syntheticTestResourceModel) (*model
 		Enabled:  plan.Enabled.ValueBool(),
 		Interval: plan.Interval.ValueString(),
 		Version:  1, // Always use version 1
+
+		CreateMonitor: createMonitorRequest(plan),
 	}
 
 	// Build CheckConfig
@@ -1107,6 +1274,13 @@ func toSDKRequest(ctx context.Context, plan *syntheticTestResourceModel) (*model
 			httpReq.Headers = headers
 		}
 
+		if !plan.HTTPCheck.UserAgent.IsNull() && !plan.HTTPCheck.UserAgent.IsUnknown() {
+			if httpReq.Headers == nil {
+				httpReq.Headers = make(map[string]string)
+			}
+			httpReq.Headers[userAgentHeader] = plan.HTTPCheck.UserAgent.ValueString()
+		}
+
 		if plan.HTTPCheck.Body != nil && (!plan.HTTPCheck.Body.Type.IsNull() || !plan.HTTPCheck.Body.Content.IsNull()) {
 			bodyType := plan.HTTPCheck.Body.Type.ValueString()
 			if bodyType == "" && !plan.HTTPCheck.Body.Content.IsNull() {
@@ -1343,10 +1517,48 @@ func toSDKRequest(ctx context.Context, plan *syntheticTestResourceModel) (*model
 
 // --- Conversion: SDK response → Terraform state ---
 
+// userAgentHeader is the header that carries http_check.user_agent.
+const userAgentHeader = "User-Agent"
+
+// splitUserAgentHeader returns the User-Agent value from headers (matched
+// case-insensitively) and a copy of headers without it.
+func splitUserAgentHeader(headers map[string]string) (string, map[string]string) {
+	var userAgent string
+	rest := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if strings.EqualFold(k, userAgentHeader) {
+			userAgent = v
+			continue
+		}
+		rest[k] = v
+	}
+	return userAgent, rest
+}
+
+// syntheticStringMapValue converts a string map returned by the API into a
+// Terraform map. The API does not distinguish an omitted map from an empty one,
+// so when it returns no entries the prior value decides: an explicitly
+// configured empty map stays empty, anything else becomes null.
+func syntheticStringMapValue(ctx context.Context, apiValue map[string]string, prior types.Map) types.Map {
+	if len(apiValue) > 0 {
+		mapValue, diags := types.MapValueFrom(ctx, types.StringType, apiValue)
+		if !diags.HasError() {
+			return mapValue
+		}
+		return types.MapNull(types.StringType)
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+
+	return types.MapNull(types.StringType)
+}
+
 func fromSDKResponse(ctx context.Context, sdkResp *models.SyntheticTestCreateRequest, state *syntheticTestResourceModel) {
 	state.Name = types.StringValue(sdkResp.Name)
 	state.Enabled = types.BoolValue(sdkResp.Enabled)
-	state.Interval = types.StringValue(sdkResp.Interval)
+	state.Interval = customtypes.NewDurationValue(sdkResp.Interval)
 	state.Version = types.Int64Value(sdkResp.Version)
 
 	if sdkResp.CheckConfig == nil {
@@ -1355,20 +1567,13 @@ func fromSDKResponse(ctx context.Context, sdkResp *models.SyntheticTestCreateReq
 
 	cc := sdkResp.CheckConfig
 
-	// Labels - set from API response or clear if none returned
-	if cc.Metadata != nil && len(cc.Metadata.Labels) > 0 {
-		labels := make(map[string]string)
-		for k, v := range cc.Metadata.Labels {
-			labels[k] = v
-		}
-		labelsMap, diags := types.MapValueFrom(ctx, types.StringType, labels)
-		if !diags.HasError() {
-			state.Labels = labelsMap
-		}
-	} else if !state.Labels.IsNull() {
-		// API returned no labels but state had labels - clear them
-		state.Labels = types.MapNull(types.StringType)
+	// Labels - an explicitly configured empty map is kept as-is; otherwise an
+	// absent or empty label set from the API reads back as null.
+	var labels map[string]string
+	if cc.Metadata != nil {
+		labels = cc.Metadata.Labels
 	}
+	state.Labels = syntheticStringMapValue(ctx, labels, state.Labels)
 
 	// HTTP Check
 	if cc.Request != nil && cc.Request.HTTP != nil {
@@ -1376,24 +1581,29 @@ func fromSDKResponse(ctx context.Context, sdkResp *models.SyntheticTestCreateReq
 		httpModel := &syntheticHTTPCheckModel{
 			URL:     types.StringValue(http.URL),
 			Method:  types.StringValue(http.Method),
-			Timeout: types.StringValue(http.Timeout),
-		}
-
-		// Headers - set from API, preserve an explicitly configured empty map,
-		// or clear to null when API omits headers.
-		if http.Headers != nil {
-			headersMap, diags := types.MapValueFrom(ctx, types.StringType, http.Headers)
-			if !diags.HasError() {
-				httpModel.Headers = headersMap
+			Timeout: customtypes.NewDurationValue(http.Timeout),
+		}
+
+		// Headers - on import an empty map from the API is reflected as-is; on a
+		// normal read the prior value keeps null and empty-map configs distinct.
+		priorHeaders := types.MapNull(types.StringType)
+		if state.HTTPCheck != nil {
+			priorHeaders = state.HTTPCheck.Headers
+		} else if http.Headers != nil {
+			priorHeaders = types.MapValueMust(types.StringType, map[string]attr.Value{})
+		}
+		apiHeaders := http.Headers
+		httpModel.UserAgent = types.StringNull()
+		if state.HTTPCheck != nil && !state.HTTPCheck.UserAgent.IsNull() {
+			// user_agent is carried as a header; split it back out so it does
+			// not show up as an unconfigured headers entry.
+			var userAgent string
+			userAgent, apiHeaders = splitUserAgentHeader(apiHeaders)
+			if userAgent != "" {
+				httpModel.UserAgent = types.StringValue(userAgent)
 			}
-		} else if state.HTTPCheck != nil &&
-			!state.HTTPCheck.Headers.IsNull() &&
-			!state.HTTPCheck.Headers.IsUnknown() &&
-			len(state.HTTPCheck.Headers.Elements()) == 0 {
-			httpModel.Headers = state.HTTPCheck.Headers
-		} else {
-			httpModel.Headers = types.MapNull(types.StringType)
 		}
+		httpModel.Headers = syntheticStringMapValue(ctx, apiHeaders, priorHeaders)
 
 		if state.HTTPCheck == nil {
 			// Import: no prior state — set from API when explicitly present
@@ -1466,7 +1676,7 @@ func fromSDKResponse(ctx context.Context, sdkResp *models.SyntheticTestCreateReq
 				sslModel.Sni = types.StringValue(ssl.Sni)
 			}
 			if ssl.Timeout != "" {
-				sslModel.Timeout = types.StringValue(ssl.Timeout)
+				sslModel.Timeout = customtypes.NewDurationValue(ssl.Timeout)
 			}
 		} else {
 			// Normal read: preserve user's config values to avoid perpetual
@@ -1503,7 +1713,7 @@ func fromSDKResponse(ctx context.Context, sdkResp *models.SyntheticTestCreateReq
 				tcpModel.ReceiveMaxBytes = types.Int64Value(tcp.ReceiveMaxBytes)
 			}
 			if tcp.Timeout != "" {
-				tcpModel.Timeout = types.StringValue(tcp.Timeout)
+				tcpModel.Timeout = customtypes.NewDurationValue(tcp.Timeout)
 			}
 		} else {
 			// Normal read: preserve user's config values to avoid perpetual
@@ -1546,9 +1756,9 @@ func fromSDKResponse(ctx context.Context, sdkResp *models.SyntheticTestCreateReq
 				dnsModel.Dnssec = types.BoolNull()
 			}
 			if dns.Timeout != "" {
-				dnsModel.Timeout = types.StringValue(dns.Timeout)
+				dnsModel.Timeout = customtypes.NewDurationValue(dns.Timeout)
 			} else {
-				dnsModel.Timeout = types.StringNull()
+				dnsModel.Timeout = customtypes.NewDurationNull()
 			}
 		} else {
 			// Normal read: if the user never configured a field (null),
@@ -1572,7 +1782,7 @@ func fromSDKResponse(ctx context.Context, sdkResp *models.SyntheticTestCreateReq
 			if state.DNSCheck.Timeout.IsNull() || state.DNSCheck.Timeout.IsUnknown() {
 				dnsModel.Timeout = state.DNSCheck.Timeout
 			} else {
-				dnsModel.Timeout = types.StringValue(dns.Timeout)
+				dnsModel.Timeout = customtypes.NewDurationValue(dns.Timeout)
 			}
 		}
 
@@ -1593,7 +1803,7 @@ func fromSDKResponse(ctx context.Context, sdkResp *models.SyntheticTestCreateReq
 	if cc.ExecutionPolicy != nil && cc.ExecutionPolicy.Retries != nil && cc.ExecutionPolicy.Retries.Count > 0 {
 		state.Retry = &syntheticRetryModel{
 			Count:    types.Int64Value(cc.ExecutionPolicy.Retries.Count),
-			Interval: types.StringValue(cc.ExecutionPolicy.Retries.Interval),
+			Interval: customtypes.NewDurationValue(cc.ExecutionPolicy.Retries.Interval),
 		}
 	} else {
 		state.Retry = nil
@@ -1628,10 +1838,10 @@ func fromSDKResponse(ctx context.Context, sdkResp *models.SyntheticTestCreateReq
 			monitorModel.ExecutionErrorState = types.StringValue(mon.ExecutionErrorState)
 		}
 		if !prev.LookbehindWindow.IsNull() {
-			monitorModel.LookbehindWindow = types.StringValue(normalizeDuration(mon.LookbehindWindow))
+			monitorModel.LookbehindWindow = customtypes.NewDurationValue(mon.LookbehindWindow)
 		}
 		if !prev.RenotificationInterval.IsNull() {
-			monitorModel.RenotificationInterval = types.StringValue(normalizeDuration(mon.RenotificationInterval))
+			monitorModel.RenotificationInterval = customtypes.NewDurationValue(mon.RenotificationInterval)
 		}
 
 		if !prev.EnabledWorkflows.IsNull() {
@@ -1683,10 +1893,10 @@ func fromSDKResponse(ctx context.Context, sdkResp *models.SyntheticTestCreateReq
 		if mon.EvaluationInterval != nil && prev.EvaluationInterval != nil {
 			evalModel := &syntheticMonitorEvalIntervalModel{}
 			if !prev.EvaluationInterval.Interval.IsNull() {
-				evalModel.Interval = types.StringValue(normalizeDuration(mon.EvaluationInterval.Interval))
+				evalModel.Interval = customtypes.NewDurationValue(mon.EvaluationInterval.Interval)
 			}
 			if !prev.EvaluationInterval.PendingFor.IsNull() {
-				evalModel.PendingFor = types.StringValue(normalizeDuration(mon.EvaluationInterval.PendingFor))
+				evalModel.PendingFor = customtypes.NewDurationValue(mon.EvaluationInterval.PendingFor)
 			}
 			monitorModel.EvaluationInterval = evalModel
 		}
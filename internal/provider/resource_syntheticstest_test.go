@@ -10,8 +10,12 @@ import (
 	"testing"
 
 	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/groundcover-com/terraform-provider-groundcover/internal/customtypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -104,6 +108,257 @@ func syntheticTestResponseWithHeaders(headers map[string]string) *models.Synthet
 	}
 }
 
+// storedSyntheticTestClient accepts updates and serves a fixed stored test,
+// standing in for an API that normalizes what it is sent.
+type storedSyntheticTestClient struct {
+	ApiClient
+	stored  *models.SyntheticTestCreateRequest
+	listed  []*models.SyntheticTestListItem
+	updates int
+}
+
+func (c *storedSyntheticTestClient) ListSyntheticTests(_ context.Context) ([]*models.SyntheticTestListItem, error) {
+	return c.listed, nil
+}
+
+func (c *storedSyntheticTestClient) UpdateSyntheticTest(_ context.Context, _ string, _ *models.SyntheticTestCreateRequest) error {
+	c.updates++
+	return nil
+}
+
+func (c *storedSyntheticTestClient) GetSyntheticTest(_ context.Context, _ string) (*models.SyntheticTestCreateRequest, error) {
+	return c.stored, nil
+}
+
+func TestSyntheticTestUpdateReadsBackStoredTest(t *testing.T) {
+	ctx := context.Background()
+	stored := syntheticTestResponseWithHeaders(nil)
+	stored.Version = 2
+	client := &storedSyntheticTestClient{stored: stored}
+	r := &syntheticTestResource{client: client}
+	s := resourceSchema(ctx, r)
+
+	planned := syntheticTestResourceModel{
+		ID:       types.StringValue("test-1"),
+		Name:     types.StringValue("empty-headers"),
+		Enabled:  types.BoolValue(true),
+		Interval: customtypes.NewDurationValue("60s"),
+		Version:  types.Int64Value(1),
+		HTTPCheck: &syntheticHTTPCheckModel{
+			URL:     types.StringValue("https://example.com"),
+			Method:  types.StringValue("GET"),
+			Timeout: customtypes.NewDurationValue("10s"),
+			Headers: types.MapNull(types.StringType),
+		},
+		Assertion: types.ListNull(syntheticAssertionObjectType()),
+		Labels:    types.MapNull(types.StringType),
+	}
+	plan := tfsdk.Plan{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, &planned); diags.HasError() {
+		t.Fatalf("plan.Set: %v", diags)
+	}
+	resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+
+	var state syntheticTestResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("State.Get: %v", diags)
+	}
+	if client.updates != 1 {
+		t.Fatalf("expected one update, got %d", client.updates)
+	}
+	if got := state.Version.ValueInt64(); got != 2 {
+		t.Fatalf("expected version read back from the API (2), got %d", got)
+	}
+}
+
+func TestSyntheticTestReadRefreshesMonitor(t *testing.T) {
+	tests := []struct {
+		name              string
+		listed            []*models.SyntheticTestListItem
+		wantMonitorID     string
+		wantCreateMonitor bool
+	}{
+		{
+			name: "paired monitor",
+			listed: []*models.SyntheticTestListItem{
+				{ID: "test-2", Monitor: &models.SyntheticTestMonitor{UUID: "monitor-2"}},
+				{ID: "test-1", Monitor: &models.SyntheticTestMonitor{UUID: "monitor-1"}},
+			},
+			wantMonitorID:     "monitor-1",
+			wantCreateMonitor: true,
+		},
+		{
+			name:              "monitor deleted outside Terraform",
+			listed:            []*models.SyntheticTestListItem{{ID: "test-1"}},
+			wantCreateMonitor: false,
+		},
+		{
+			name:              "test missing from the list keeps the prior state",
+			listed:            []*models.SyntheticTestListItem{{ID: "test-2", Monitor: &models.SyntheticTestMonitor{UUID: "monitor-2"}}},
+			wantMonitorID:     "monitor-1",
+			wantCreateMonitor: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &syntheticTestResource{client: &storedSyntheticTestClient{stored: syntheticTestResponseWithHeaders(nil), listed: tt.listed}}
+			s := resourceSchema(ctx, r)
+
+			prior := syntheticTestResourceModel{
+				ID:            types.StringValue("test-1"),
+				Name:          types.StringValue("empty-headers"),
+				Enabled:       types.BoolValue(true),
+				Interval:      customtypes.NewDurationValue("1m"),
+				Version:       types.Int64Value(1),
+				HTTPCheck:     &syntheticHTTPCheckModel{Headers: types.MapNull(types.StringType)},
+				Assertion:     types.ListNull(syntheticAssertionObjectType()),
+				Labels:        types.MapNull(types.StringType),
+				CreateMonitor: types.BoolValue(true),
+				MonitorID:     types.StringValue("monitor-1"),
+			}
+			state := tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if diags := state.Set(ctx, &prior); diags.HasError() {
+				t.Fatalf("state.Set: %v", diags)
+			}
+
+			resp := fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", resp.Diagnostics)
+			}
+
+			var refreshed syntheticTestResourceModel
+			if diags := resp.State.Get(ctx, &refreshed); diags.HasError() {
+				t.Fatalf("State.Get: %v", diags)
+			}
+			if got := refreshed.MonitorID.ValueString(); got != tt.wantMonitorID {
+				t.Fatalf("expected monitor_id %q, got %q", tt.wantMonitorID, got)
+			}
+			if got := refreshed.CreateMonitor.ValueBool(); got != tt.wantCreateMonitor {
+				t.Fatalf("expected create_monitor %t, got %t", tt.wantCreateMonitor, got)
+			}
+		})
+	}
+}
+
+func TestSyntheticTestFromSDKResponseLabelsNullVersusEmpty(t *testing.T) {
+	ctx := context.Background()
+	emptyLabels := types.MapValueMust(types.StringType, map[string]attr.Value{})
+	configuredLabels := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team": types.StringValue("platform"),
+	})
+
+	tests := []struct {
+		name        string
+		prior       types.Map
+		sdkLabels   map[string]string
+		wantMapNull bool
+		wantLen     int
+	}{
+		{
+			name:        "keeps configured empty labels when API returns none",
+			prior:       emptyLabels,
+			wantMapNull: false,
+		},
+		{
+			name:        "keeps configured empty labels when API returns an empty map",
+			prior:       emptyLabels,
+			sdkLabels:   map[string]string{},
+			wantMapNull: false,
+		},
+		{
+			name:        "keeps unset labels null when API returns an empty map",
+			prior:       types.MapNull(types.StringType),
+			sdkLabels:   map[string]string{},
+			wantMapNull: true,
+		},
+		{
+			name:        "clears removed labels to null",
+			prior:       configuredLabels,
+			wantMapNull: true,
+		},
+		{
+			name:        "reflects labels returned by the API",
+			prior:       emptyLabels,
+			sdkLabels:   map[string]string{"team": "platform"},
+			wantMapNull: false,
+			wantLen:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &syntheticTestResourceModel{Labels: tt.prior}
+			sdkResp := syntheticTestResponseWithHeaders(nil)
+			sdkResp.CheckConfig.Metadata = &models.Metadata{Labels: tt.sdkLabels}
+
+			fromSDKResponse(ctx, sdkResp, state)
+
+			if got := state.Labels.IsNull(); got != tt.wantMapNull {
+				t.Fatalf("expected labels IsNull=%t, got %t", tt.wantMapNull, got)
+			}
+			if got := len(state.Labels.Elements()); got != tt.wantLen {
+				t.Fatalf("expected %d labels, got %d", tt.wantLen, got)
+			}
+		})
+	}
+}
+
+func TestSyntheticTestFromSDKResponseHeadersNullVersusEmpty(t *testing.T) {
+	ctx := context.Background()
+
+	state := &syntheticTestResourceModel{
+		HTTPCheck: &syntheticHTTPCheckModel{
+			Headers: types.MapNull(types.StringType),
+		},
+	}
+	fromSDKResponse(ctx, syntheticTestResponseWithHeaders(map[string]string{}), state)
+
+	if !state.HTTPCheck.Headers.IsNull() {
+		t.Fatalf("expected unset headers to stay null when API returns an empty map, got %s", state.HTTPCheck.Headers)
+	}
+}
+
+func TestSyntheticTestUserAgentRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	plan := &syntheticTestResourceModel{
+		Name:     types.StringValue("user-agent"),
+		Interval: customtypes.NewDurationValue("1m"),
+		Labels:   types.MapNull(types.StringType),
+		HTTPCheck: &syntheticHTTPCheckModel{
+			URL:       types.StringValue("https://example.com"),
+			Method:    types.StringValue("GET"),
+			Headers:   types.MapNull(types.StringType),
+			UserAgent: types.StringValue("groundcover-probe/1.0"),
+		},
+		Assertion: types.ListNull(syntheticAssertionObjectType()),
+	}
+
+	sdkReq, diags := toSDKRequest(ctx, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	headers := sdkReq.CheckConfig.Request.HTTP.Headers
+	if got := headers["User-Agent"]; got != "groundcover-probe/1.0" {
+		t.Fatalf("expected User-Agent header to be sent, got %q", got)
+	}
+
+	fromSDKResponse(ctx, syntheticTestResponseWithHeaders(headers), plan)
+
+	if got := plan.HTTPCheck.UserAgent.ValueString(); got != "groundcover-probe/1.0" {
+		t.Fatalf("expected user_agent to be read back, got %q", got)
+	}
+	if !plan.HTTPCheck.Headers.IsNull() {
+		t.Fatalf("expected User-Agent to be stripped from headers, got %s", plan.HTTPCheck.Headers)
+	}
+}
+
 func TestSyntheticAssertionsToListUsesNullForAbsentAssertions(t *testing.T) {
 	for _, assertions := range [][]*models.Assertion{nil, {}} {
 		assertionsList := syntheticAssertionsToList(assertions)
@@ -154,6 +409,52 @@ func TestAssertionModelsFromListHandlesUnknownValues(t *testing.T) {
 	}
 }
 
+func TestCertificateExpiryAssertionError(t *testing.T) {
+	tests := []struct {
+		operator string
+		target   string
+		wantErr  bool
+	}{
+		{operator: "gt", target: "30"},
+		{operator: "lt", target: "0"},
+		{operator: "eq", target: "30", wantErr: true},
+		{operator: "gt", target: "thirty", wantErr: true},
+		{operator: "gt", target: "", wantErr: true},
+		{operator: "gt", target: "-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		msg := certificateExpiryAssertionError(tt.operator, tt.target)
+		if got := msg != ""; got != tt.wantErr {
+			t.Errorf("certificateExpiryAssertionError(%q, %q) = %q, wantErr %t", tt.operator, tt.target, msg, tt.wantErr)
+		}
+	}
+}
+
+func TestMeasurementAssertionError(t *testing.T) {
+	tests := []struct {
+		source   string
+		operator string
+		target   string
+		wantErr  bool
+	}{
+		{source: "responseSize", operator: "lt", target: "1048576"},
+		{source: "ttfb", operator: "lt", target: "200"},
+		{source: "dnsTime", operator: "gt", target: "0"},
+		{source: "tlsTime", operator: "eq", target: "100", wantErr: true},
+		{source: "ttfb", operator: "lt", target: "200ms", wantErr: true},
+		{source: "responseSize", operator: "gt", target: "", wantErr: true},
+		{source: "responseSize", operator: "gt", target: "-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		msg := measurementAssertionError(tt.source, httpMeasurementSources[tt.source], tt.operator, tt.target)
+		if got := msg != ""; got != tt.wantErr {
+			t.Errorf("measurementAssertionError(%q, %q, %q) = %q, wantErr %t", tt.source, tt.operator, tt.target, msg, tt.wantErr)
+		}
+	}
+}
+
 func TestAccSyntheticTestResource_basic(t *testing.T) {
 	name := acctest.RandomWithPrefix("test-synth")
 
@@ -250,6 +551,28 @@ func TestAccSyntheticTestResource_applyLoop(t *testing.T) {
 	})
 }
 
+func TestAccSyntheticTestResource_emptyMapsApplyLoop(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-synth-empty-maps")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyntheticTestResourceConfig_emptyMaps(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("groundcover_synthetic_test.test", "labels.%", "0"),
+					resource.TestCheckResourceAttr("groundcover_synthetic_test.test", "http_check.headers.%", "0"),
+				),
+			},
+			{
+				Config:   testAccSyntheticTestResourceConfig_emptyMaps(name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccSyntheticTestResource_disappears(t *testing.T) {
 	name := acctest.RandomWithPrefix("test-synth-disappear")
 
@@ -553,6 +876,30 @@ resource "groundcover_synthetic_test" "test" {
 `, name)
 }
 
+func testAccSyntheticTestResourceConfig_emptyMaps(name string) string {
+	return fmt.Sprintf(`
+resource "groundcover_synthetic_test" "test" {
+  name     = %[1]q
+  enabled  = true
+  interval = "1m"
+  labels   = {}
+
+  http_check {
+    url     = "https://httpbin.org/status/200"
+    method  = "GET"
+    timeout = "10s"
+    headers = {}
+  }
+
+  assertion {
+    source   = "statusCode"
+    operator = "eq"
+    target   = "200"
+  }
+}
+`, name)
+}
+
 func testAccSyntheticTestResourceConfig_updated(name string) string {
 	return fmt.Sprintf(`
 resource "groundcover_synthetic_test" "test" {
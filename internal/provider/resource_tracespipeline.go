@@ -145,9 +145,9 @@ func (r *tracesPipelineResource) Read(ctx context.Context, req resource.ReadRequ
 		createdAt = configEntry.CreatedTimestamp.String()
 	}
 
-	// Update state
+	// Update state, keeping the configured YAML when the server only reformatted it
 	state.UpdatedAt = types.StringValue(createdAt)
-	state.Value = types.StringValue(value)
+	state.Value = types.StringValue(pipelineValueForState(ctx, state.Value.ValueString(), value))
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
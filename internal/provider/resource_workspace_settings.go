@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &workspaceSettingsResource{}
+	_ resource.ResourceWithConfigure   = &workspaceSettingsResource{}
+	_ resource.ResourceWithImportState = &workspaceSettingsResource{}
+)
+
+func NewWorkspaceSettingsResource() resource.Resource {
+	return &workspaceSettingsResource{}
+}
+
+type workspaceSettingsResource struct {
+	client ApiClient
+}
+
+type workspaceSettingsResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	AIFeaturesOptOut  types.Bool   `tfsdk:"ai_features_opt_out"`
+	AIFeaturesAllowed types.Bool   `tfsdk:"ai_features_allowed"`
+	AIFeaturesEnabled types.Bool   `tfsdk:"ai_features_enabled"`
+	BackendName       types.String `tfsdk:"backend_name"`
+}
+
+func (r *workspaceSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_settings"
+}
+
+func (r *workspaceSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the tenant-wide settings of the groundcover workspace. Singleton resource: declare it once per workspace. " +
+			"The API currently exposes only the AI features setting; the default timezone, issue auto-resolve times, and data obfuscation cannot be managed yet. " +
+			"The settings always exist, so creating the resource takes them over, and destroying it only removes it from Terraform state and leaves the last applied settings in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `workspace-settings`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ai_features_opt_out": schema.BoolAttribute{
+				MarkdownDescription: "Whether the workspace opts out of groundcover AI features. When unset, the current setting is kept and read into state.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"ai_features_allowed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the groundcover backend allows AI features for the workspace, regardless of `ai_features_opt_out`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"ai_features_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether AI features are in effect: they are allowed and the workspace did not opt out.",
+				Computed:            true,
+			},
+			"backend_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the groundcover backend the settings apply to.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *workspaceSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ApiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected provider.ApiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create takes over the workspace settings, which exist for every workspace.
+func (r *workspaceSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan workspaceSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Workspace Settings", fmt.Sprintf("Could not apply the workspace settings: %s", err.Error()))
+		return
+	}
+
+	workspaceSettingsModelFromAPI(settings, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *workspaceSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workspaceSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetTenantAISettings(ctx)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			tflog.Warn(ctx, "Workspace settings not found, removing from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Workspace Settings", fmt.Sprintf("Could not read the workspace settings: %s", err.Error()))
+		return
+	}
+
+	workspaceSettingsModelFromAPI(settings, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *workspaceSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan workspaceSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Workspace Settings", fmt.Sprintf("Could not apply the workspace settings: %s", err.Error()))
+		return
+	}
+
+	workspaceSettingsModelFromAPI(settings, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *workspaceSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"Workspace Settings Left in Place",
+		"Workspace settings cannot be deleted, so they were only removed from Terraform state. Their last applied values remain in effect.",
+	)
+}
+
+// ImportState imports the workspace settings under any ID.
+func (r *workspaceSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), workspaceSettingsResourceId)...)
+}
+
+// apply sends the configured settings, or only reads the current ones when
+// none is configured.
+func (r *workspaceSettingsResource) apply(ctx context.Context, plan workspaceSettingsResourceModel) (*models.TenantAISettingsResponse, error) {
+	if plan.AIFeaturesOptOut.IsNull() || plan.AIFeaturesOptOut.IsUnknown() {
+		tflog.Debug(ctx, "No workspace setting configured, reading the current settings")
+		return r.client.GetTenantAISettings(ctx)
+	}
+
+	tflog.Debug(ctx, "Updating workspace settings", map[string]any{"ai_features_opt_out": plan.AIFeaturesOptOut.ValueBool()})
+	return r.client.UpdateTenantAISettings(ctx, &models.UpdateTenantAISettingsRequest{
+		CustomerAIFeaturesOptOut: plan.AIFeaturesOptOut.ValueBoolPointer(),
+	})
+}
+
+func workspaceSettingsModelFromAPI(settings *models.TenantAISettingsResponse, model *workspaceSettingsResourceModel) {
+	if settings == nil {
+		settings = &models.TenantAISettingsResponse{}
+	}
+	model.ID = types.StringValue(workspaceSettingsResourceId)
+	model.AIFeaturesOptOut = types.BoolValue(settings.CustomerAIFeaturesOptOut)
+	model.AIFeaturesAllowed = types.BoolValue(settings.AllowAIFeatures)
+	model.AIFeaturesEnabled = types.BoolValue(settings.EffectiveAIFeaturesEnabled)
+	model.BackendName = types.StringValue(settings.BackendName)
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tenantSettingsClient holds the AI settings of one tenant whose backend
+// allows AI features.
+type tenantSettingsClient struct {
+	ApiClient
+	optOut  bool
+	updates int
+}
+
+func (c *tenantSettingsClient) settings() *models.TenantAISettingsResponse {
+	return &models.TenantAISettingsResponse{
+		AllowAIFeatures:            true,
+		BackendName:                "prod",
+		CustomerAIFeaturesOptOut:   c.optOut,
+		EffectiveAIFeaturesEnabled: !c.optOut,
+	}
+}
+
+func (c *tenantSettingsClient) GetTenantAISettings(_ context.Context) (*models.TenantAISettingsResponse, error) {
+	return c.settings(), nil
+}
+
+func (c *tenantSettingsClient) UpdateTenantAISettings(_ context.Context, req *models.UpdateTenantAISettingsRequest) (*models.TenantAISettingsResponse, error) {
+	c.updates++
+	c.optOut = *req.CustomerAIFeaturesOptOut
+	return c.settings(), nil
+}
+
+func TestWorkspaceSettingsCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		optOut      types.Bool
+		wantUpdates int
+		wantOptOut  bool
+	}{
+		{name: "opt out", optOut: types.BoolValue(true), wantUpdates: 1, wantOptOut: true},
+		{name: "unset keeps the current setting", optOut: types.BoolUnknown(), wantUpdates: 0, wantOptOut: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := &tenantSettingsClient{}
+			r := &workspaceSettingsResource{client: client}
+			s := resourceSchema(ctx, r)
+
+			plan := tfsdk.Plan{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			require.False(t, plan.Set(ctx, workspaceSettingsResourceModel{
+				ID:                types.StringUnknown(),
+				AIFeaturesOptOut:  tt.optOut,
+				AIFeaturesAllowed: types.BoolUnknown(),
+				AIFeaturesEnabled: types.BoolUnknown(),
+				BackendName:       types.StringUnknown(),
+			}).HasError())
+
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, tt.wantUpdates, client.updates)
+
+			var state workspaceSettingsResourceModel
+			require.False(t, resp.State.Get(ctx, &state).HasError())
+			assert.Equal(t, workspaceSettingsResourceId, state.ID.ValueString())
+			assert.Equal(t, tt.wantOptOut, state.AIFeaturesOptOut.ValueBool())
+			assert.Equal(t, !tt.wantOptOut, state.AIFeaturesEnabled.ValueBool())
+			assert.True(t, state.AIFeaturesAllowed.ValueBool())
+			assert.Equal(t, "prod", state.BackendName.ValueString())
+		})
+	}
+}
+
+func TestWorkspaceSettingsDeleteLeavesSettings(t *testing.T) {
+	ctx := context.Background()
+	client := &tenantSettingsClient{optOut: true}
+	r := &workspaceSettingsResource{client: client}
+	s := resourceSchema(ctx, r)
+
+	state := tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	model := workspaceSettingsResourceModel{}
+	workspaceSettingsModelFromAPI(client.settings(), &model)
+	require.False(t, state.Set(ctx, model).HasError())
+
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	assert.Equal(t, 0, client.updates)
+	assert.True(t, client.optOut, "the opt-out must stay in place")
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+)
+
+// NewProviderServer returns the protocol 6 server that Terraform talks to.
+//
+// The framework provider is served through tf6muxserver so that resources and
+// data sources built on another SDK can be added to the list below without
+// changing how the binary is served. A protocol 5 server, such as one written
+// with terraform-plugin-sdk/v2, can join after wrapping it with
+// tf5to6server.UpgradeServer.
+//
+// Serving protocol 5 itself (tf6to5server) is not possible while the schemas
+// use nested attributes, which protocol 5 has no representation for.
+func NewProviderServer(ctx context.Context, version string) (func() tfprotov6.ProviderServer, error) {
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(New(version)()),
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProviderServerServesFrameworkSchemas(t *testing.T) {
+	ctx := context.Background()
+
+	newServer, err := NewProviderServer(ctx, "test")
+	require.NoError(t, err)
+
+	resp, err := newServer().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	for _, d := range resp.Diagnostics {
+		assert.NotEqual(t, tfprotov6.DiagnosticSeverityError, d.Severity, "%s: %s", d.Summary, d.Detail)
+	}
+
+	assert.Contains(t, resp.ResourceSchemas, "groundcover_monitor")
+	assert.Contains(t, resp.DataSourceSchemas, "groundcover_dashboards")
+	require.NotNil(t, resp.Provider)
+	var providerAttributes []string
+	for _, attr := range resp.Provider.Block.Attributes {
+		providerAttributes = append(providerAttributes, attr.Name)
+	}
+	assert.Contains(t, providerAttributes, "api_key")
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/groundcover-com/groundcover-sdk-go/pkg/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func syntheticTestMonitorAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"create_monitor": schema.BoolAttribute{
+			Description: "Whether groundcover creates and keeps a monitor paired with the synthetic test, alerting when the check fails. Configure it with the `monitor` block, for example its `severity` and `lookbehind_window`. " +
+				"Setting it to `false` deletes the paired monitor. A refresh sets it to whether the monitor exists, so a monitor deleted outside Terraform is recreated on the next apply. Default: `true`.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
+		},
+		"monitor_id": schema.StringAttribute{
+			Description: "The UUID of the paired monitor, for example to reference it from a silence or a dashboard. Null when the test has no monitor.",
+			Computed:    true,
+		},
+	}
+}
+
+// ModifyPlan keeps monitor_id known when an update keeps the paired monitor,
+// and plans it as null when the update deletes it.
+func (r *syntheticTestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var createMonitor types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("create_monitor"), &createMonitor)...)
+	var monitorID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("monitor_id"), &monitorID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case !createMonitor.IsUnknown() && !createMonitor.ValueBool():
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("monitor_id"), types.StringNull())...)
+	case createMonitor.ValueBool() && !monitorID.IsNull():
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("monitor_id"), monitorID)...)
+	}
+}
+
+// validateMonitorConfig rejects a monitor block on a test without a monitor,
+// which the API refuses.
+func validateMonitorConfig(config syntheticTestResourceModel, diags *diag.Diagnostics) {
+	if config.Monitor == nil || config.CreateMonitor.IsNull() || config.CreateMonitor.IsUnknown() || config.CreateMonitor.ValueBool() {
+		return
+	}
+	diags.AddAttributeError(
+		path.Root("create_monitor"),
+		"Monitor Configured Without a Monitor",
+		"The monitor block configures the paired monitor, so it cannot be set when create_monitor is false. Remove the block, or set create_monitor to true.",
+	)
+}
+
+// readMonitor sets monitor_id of data to the test's paired monitor, and
+// create_monitor too when syncCreateMonitor is set. The test list is the only
+// API response that carries the monitor; when it cannot be read, a warning is
+// added and monitor_id keeps its known value.
+func (r *syntheticTestResource) readMonitor(ctx context.Context, data *syntheticTestResourceModel, syncCreateMonitor bool, diags *diag.Diagnostics) {
+	tests, err := r.client.ListSyntheticTests(ctx)
+	if err != nil {
+		if data.MonitorID.IsUnknown() {
+			data.MonitorID = types.StringNull()
+		}
+		diags.AddWarning(
+			"Cannot Read Synthetic Test Monitor",
+			fmt.Sprintf("Could not list synthetic tests to find the monitor of %s, so monitor_id was not refreshed: %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	monitorID, found := syntheticTestMonitorID(tests, data.ID.ValueString())
+	if !found {
+		if data.MonitorID.IsUnknown() {
+			data.MonitorID = types.StringNull()
+		}
+		tflog.Warn(ctx, "Synthetic test missing from the test list, monitor_id not refreshed", map[string]any{"id": data.ID.ValueString()})
+		return
+	}
+	tflog.Debug(ctx, "Read synthetic test monitor", map[string]any{"id": data.ID.ValueString(), "monitor_id": monitorID})
+	if monitorID == "" {
+		data.MonitorID = types.StringNull()
+	} else {
+		data.MonitorID = types.StringValue(monitorID)
+	}
+	if syncCreateMonitor {
+		data.CreateMonitor = types.BoolValue(monitorID != "")
+	}
+}
+
+// syntheticTestMonitorID returns the UUID of the monitor of test id, empty
+// when it has none, and whether tests includes the test at all.
+func syntheticTestMonitorID(tests []*models.SyntheticTestListItem, id string) (string, bool) {
+	for _, test := range tests {
+		if test == nil || test.ID != id {
+			continue
+		}
+		if test.Monitor == nil {
+			return "", true
+		}
+		return test.Monitor.UUID, true
+	}
+	return "", false
+}
+
+// createMonitorRequest is the createMonitor flag sent for plan: unset when
+// it is unknown, which keeps the API default.
+func createMonitorRequest(plan *syntheticTestResourceModel) *bool {
+	if plan.CreateMonitor.IsNull() || plan.CreateMonitor.IsUnknown() {
+		return nil
+	}
+	return plan.CreateMonitor.ValueBoolPointer()
+}
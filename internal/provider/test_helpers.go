@@ -3,12 +3,19 @@
 package provider
 
 import (
+	"context"
 	"crypto/rand"
 	"fmt"
 	"math/big"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // TestProvider returns a configured provider for testing
@@ -46,3 +53,64 @@ func NewTestAccExampleData() TestAccExampleData {
 		ServiceAccountName: testAccResourceName("test-serviceaccount"),
 	}
 }
+
+// testAccCheckSingletonImport verifies that importing a singleton resource
+// produced exactly one instance with the given attributes populated.
+func testAccCheckSingletonImport(attrs ...string) func([]*terraform.InstanceState) error {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported instance, got %d", len(states))
+		}
+		for _, attr := range attrs {
+			if states[0].Attributes[attr] == "" {
+				return fmt.Errorf("expected imported attribute %q to be set", attr)
+			}
+		}
+		return nil
+	}
+}
+
+// testMonitorModel returns the plan of a new groundcover_monitor with the given
+// monitor_yaml, its other arguments unset and its computed attributes unknown.
+func testMonitorModel(monitorYaml string) monitorResourceModel {
+	return monitorResourceModel{
+		Id:                 types.StringUnknown(),
+		MonitorYaml:        newMonitorYamlValue(monitorYaml),
+		ExpandYamlAnchors:  types.BoolNull(),
+		Annotations:        types.MapNull(types.StringType),
+		ThresholdOverrides: types.MapNull(types.Float64Type),
+		OwnerTeam:          types.StringNull(),
+		OwnerSlackChannel:  types.StringNull(),
+		OwnerQuery:         types.StringUnknown(),
+		DestroyBehavior:    types.StringNull(),
+		IgnoreYamlPaths:    types.ListNull(types.StringType),
+		StartPaused:        types.BoolNull(),
+		Reconcile:          types.BoolNull(),
+		DriftDetected:      types.BoolUnknown(),
+		RemoteMonitorYaml:  types.StringUnknown(),
+		NotificationRoutes: types.ListUnknown(types.ObjectType{AttrTypes: monitorNotificationRouteAttrTypes}),
+		LabelsAll:          types.MapUnknown(types.StringType),
+		DefaultsApplied:    types.MapUnknown(types.StringType),
+	}
+}
+
+// testMonitorPlan returns model as a plan of r.
+func testMonitorPlan(ctx context.Context, r *monitorResource, model monitorResourceModel) (tfsdk.Plan, diag.Diagnostics) {
+	s := resourceSchema(ctx, r)
+	plan := tfsdk.Plan{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags := plan.Set(ctx, model)
+	return plan, diags
+}
+
+// testMonitorCreate creates the monitor planned by model through r and returns
+// the state it leaves, as an apply does.
+func testMonitorCreate(ctx context.Context, r *monitorResource, model monitorResourceModel) (tfsdk.State, diag.Diagnostics) {
+	s := resourceSchema(ctx, r)
+	plan, diags := testMonitorPlan(ctx, r, model)
+	if diags.HasError() {
+		return tfsdk.State{}, diags
+	}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: *s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	return resp.State, resp.Diagnostics
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"regexp"
@@ -363,6 +364,290 @@ func filterAstNodeByKeys(ctx context.Context, node ast.Node, allowedKeys map[str
 	}
 }
 
+// ExpandYamlAnchors resolves every alias and `<<` merge key in yamlString and
+// drops the anchors, so the result describes the same document without them.
+// Merged keys take the position of their `<<` entry; explicit keys win over
+// merged ones, and earlier merge sources win over later ones, as the YAML merge
+// key specification requires. The output is re-encoded with two-space
+// indentation, so the same input always yields the same output.
+func ExpandYamlAnchors(yamlString string) (string, error) {
+	if strings.TrimSpace(yamlString) == "" {
+		return yamlString, nil
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(yamlString), &doc); err != nil {
+		return "", fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(expandYamlNode(&doc)); err != nil {
+		return "", fmt.Errorf("failed to encode expanded YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode expanded YAML: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// expandYamlNode returns a copy of node with aliases replaced by copies of the
+// nodes they point to, merge keys applied, and anchors removed.
+func expandYamlNode(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.AliasNode {
+		return expandYamlNode(node.Alias)
+	}
+
+	expanded := *node
+	expanded.Anchor = ""
+	expanded.Content = nil
+
+	if node.Kind != yaml.MappingNode {
+		for _, child := range node.Content {
+			expanded.Content = append(expanded.Content, expandYamlNode(child))
+		}
+		return &expanded
+	}
+
+	// Explicit keys override merged keys wherever they appear in the mapping.
+	explicit := make(map[string]bool)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if key := node.Content[i]; !isYamlMergeKey(key) {
+			explicit[key.Value] = true
+		}
+	}
+
+	added := make(map[string]bool)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if !isYamlMergeKey(key) {
+			expanded.Content = append(expanded.Content, expandYamlNode(key), expandYamlNode(value))
+			continue
+		}
+
+		source := expandYamlNode(value)
+		sources := []*yaml.Node{source}
+		if source.Kind == yaml.SequenceNode {
+			sources = source.Content
+		}
+		for _, merged := range sources {
+			if merged.Kind != yaml.MappingNode {
+				continue
+			}
+			for j := 0; j+1 < len(merged.Content); j += 2 {
+				mergedKey := merged.Content[j].Value
+				if explicit[mergedKey] || added[mergedKey] {
+					continue
+				}
+				added[mergedKey] = true
+				expanded.Content = append(expanded.Content, merged.Content[j], merged.Content[j+1])
+			}
+		}
+	}
+	return &expanded
+}
+
+func isYamlMergeKey(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.ShortTag() == "!!merge"
+}
+
+// OrderYamlKeysLikeTemplate re-encodes sourceYaml with the keys of each
+// mapping in the order they have in the corresponding mapping of templateYaml.
+// Keys the template does not have keep their relative order after the ones it
+// does. Sequence items follow the template item at the same index, or the last
+// one for extra items. The values themselves are not changed, so the result
+// describes the same document as sourceYaml.
+func OrderYamlKeysLikeTemplate(sourceYaml, templateYaml string) (string, error) {
+	if strings.TrimSpace(sourceYaml) == "" || strings.TrimSpace(templateYaml) == "" {
+		return sourceYaml, nil
+	}
+
+	var source, template yaml.Node
+	if err := yaml.Unmarshal([]byte(sourceYaml), &source); err != nil {
+		return "", fmt.Errorf("failed to parse source YAML: %w", err)
+	}
+	if err := yaml.Unmarshal([]byte(templateYaml), &template); err != nil {
+		return "", fmt.Errorf("failed to parse template YAML: %w", err)
+	}
+	orderYamlNodeLike(&source, &template)
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&source); err != nil {
+		return "", fmt.Errorf("failed to encode reordered YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode reordered YAML: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// orderYamlNodeLike reorders the mapping keys of node in place to follow template.
+func orderYamlNodeLike(node, template *yaml.Node) {
+	for template != nil && template.Kind == yaml.AliasNode {
+		template = template.Alias
+	}
+	if node == nil || template == nil {
+		return
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) > 0 && template.Kind == yaml.DocumentNode && len(template.Content) > 0 {
+			orderYamlNodeLike(node.Content[0], template.Content[0])
+		}
+	case yaml.SequenceNode:
+		if template.Kind != yaml.SequenceNode || len(template.Content) == 0 {
+			return
+		}
+		for i, item := range node.Content {
+			orderYamlNodeLike(item, template.Content[min(i, len(template.Content)-1)])
+		}
+	case yaml.MappingNode:
+		if template.Kind != yaml.MappingNode {
+			return
+		}
+		position := make(map[string]int)
+		templateValues := make(map[string]*yaml.Node)
+		for i := 0; i+1 < len(template.Content); i += 2 {
+			key := template.Content[i].Value
+			if _, seen := position[key]; !seen {
+				position[key] = i / 2
+				templateValues[key] = template.Content[i+1]
+			}
+		}
+
+		type pair struct{ key, value *yaml.Node }
+		pairs := make([]pair, 0, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			pairs = append(pairs, pair{node.Content[i], node.Content[i+1]})
+		}
+		rank := func(p pair) int {
+			if pos, ok := position[p.key.Value]; ok {
+				return pos
+			}
+			return len(position)
+		}
+		sort.SliceStable(pairs, func(i, j int) bool { return rank(pairs[i]) < rank(pairs[j]) })
+
+		node.Content = node.Content[:0]
+		for _, p := range pairs {
+			orderYamlNodeLike(p.value, templateValues[p.key.Value])
+			node.Content = append(node.Content, p.key, p.value)
+		}
+	}
+}
+
+// OverlayYamlPaths re-encodes targetYaml with the value at each of paths taken
+// from sourceYaml, or removed where sourceYaml has none. A path is a
+// dot-separated list of mapping keys, such as `display.description`; mappings
+// missing from targetYaml along a path are created. The rest of targetYaml,
+// including its key order, is unchanged.
+func OverlayYamlPaths(targetYaml, sourceYaml string, paths []string) (string, error) {
+	if len(paths) == 0 || strings.TrimSpace(targetYaml) == "" {
+		return targetYaml, nil
+	}
+
+	var target, source yaml.Node
+	if err := yaml.Unmarshal([]byte(targetYaml), &target); err != nil {
+		return "", fmt.Errorf("failed to parse target YAML: %w", err)
+	}
+	if err := yaml.Unmarshal([]byte(sourceYaml), &source); err != nil {
+		return "", fmt.Errorf("failed to parse source YAML: %w", err)
+	}
+	if len(target.Content) == 0 || target.Content[0].Kind != yaml.MappingNode {
+		return targetYaml, nil
+	}
+
+	var sourceRoot *yaml.Node
+	if len(source.Content) > 0 {
+		sourceRoot = source.Content[0]
+	}
+	for _, p := range paths {
+		keys := strings.Split(p, ".")
+		if value := lookupYamlPath(sourceRoot, keys); value != nil {
+			setYamlPath(target.Content[0], keys, value)
+		} else {
+			deleteYamlPath(target.Content[0], keys)
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&target); err != nil {
+		return "", fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// yamlMappingValue returns the value of key in mapping node, or nil.
+func yamlMappingValue(node *yaml.Node, key string) *yaml.Node {
+	for node != nil && node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func lookupYamlPath(node *yaml.Node, keys []string) *yaml.Node {
+	for _, key := range keys {
+		node = yamlMappingValue(node, key)
+		if node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+func setYamlPath(node *yaml.Node, keys []string, value *yaml.Node) {
+	for i, key := range keys {
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+		child := yamlMappingValue(node, key)
+		if i == len(keys)-1 {
+			if child != nil {
+				*child = *value
+			} else {
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+			}
+			return
+		}
+		if child == nil {
+			child = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, child)
+		}
+		node = child
+	}
+}
+
+func deleteYamlPath(node *yaml.Node, keys []string) {
+	parent := lookupYamlPath(node, keys[:len(keys)-1])
+	if parent == nil || parent.Kind != yaml.MappingNode {
+		return
+	}
+	key := keys[len(keys)-1]
+	for i := 0; i+1 < len(parent.Content); i += 2 {
+		if parent.Content[i].Value == key {
+			parent.Content = append(parent.Content[:i], parent.Content[i+2:]...)
+			return
+		}
+	}
+}
+
 // NormalizeTimeStringsInYaml normalizes time duration strings in YAML to a consistent format
 // e.g., "30m0s" -> "30m", "1h0m0s" -> "1h", "10 minutes" -> "10m", "1d" -> "24h"
 func NormalizeTimeStringsInYaml(yamlString string) string {
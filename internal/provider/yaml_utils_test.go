@@ -329,6 +329,281 @@ func normalizeYamlForComparison(yaml string) string {
 }
 
 // TestNormalizeTimeString tests the time string normalization function directly
+func TestExpandYamlAnchors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name: "alias to scalar and mapping",
+			input: `defaults: &defaults
+  interval: 1m
+  for: 5m
+title: &title checkout errors
+evaluation: *defaults
+display:
+  header: *title
+`,
+			expected: `defaults:
+  interval: 1m
+  for: 5m
+title: checkout errors
+evaluation:
+  interval: 1m
+  for: 5m
+display:
+  header: checkout errors
+`,
+		},
+		{
+			name: "merge keys keep position and explicit keys win",
+			input: `base: &base
+  severity: warning
+  team: payments
+labels:
+  env: prod
+  <<: *base
+  severity: critical
+`,
+			expected: `base:
+  severity: warning
+  team: payments
+labels:
+  env: prod
+  team: payments
+  severity: critical
+`,
+		},
+		{
+			name: "earlier merge sources win",
+			input: `a: &a
+  x: from-a
+b: &b
+  x: from-b
+  y: from-b
+merged:
+  <<: [*a, *b]
+`,
+			expected: `a:
+  x: from-a
+b:
+  x: from-b
+  y: from-b
+merged:
+  x: from-a
+  y: from-b
+`,
+		},
+		{
+			name: "aliases inside sequences",
+			input: `threshold: &threshold
+  value: 5
+thresholds:
+  - *threshold
+  - value: 10
+`,
+			expected: `threshold:
+  value: 5
+thresholds:
+  - value: 5
+  - value: 10
+`,
+		},
+		{
+			name:     "empty input",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpandYamlAnchors(tt.input)
+			if err != nil {
+				t.Fatalf("ExpandYamlAnchors() error = %v", err)
+			}
+			if got != tt.expected {
+				t.Fatalf("ExpandYamlAnchors() =\n%s\nwant\n%s", got, tt.expected)
+			}
+			again, err := ExpandYamlAnchors(got)
+			if err != nil || again != got {
+				t.Fatalf("ExpandYamlAnchors() is not stable: %q then %q (err %v)", got, again, err)
+			}
+		})
+	}
+
+	if _, err := ExpandYamlAnchors("evaluation: *missing\n"); err == nil {
+		t.Fatal("expected an error for an undefined alias")
+	}
+}
+
+func TestMonitorYamlsEqualExpanded(t *testing.T) {
+	ctx := context.Background()
+	configured := `title: checkout errors
+thresholds:
+  - &critical
+    name: critical
+    value: 5
+  - *critical
+`
+	remote := `thresholds:
+- name: critical
+  value: 5
+- name: critical
+  value: 5
+title: checkout errors
+`
+	if !monitorYamlsEqualExpanded(ctx, configured, remote) {
+		t.Fatal("expected anchored YAML to equal its expanded form")
+	}
+	changed := strings.Replace(remote, "value: 5\ntitle", "value: 6\ntitle", 1)
+	if monitorYamlsEqualExpanded(ctx, configured, changed) {
+		t.Fatal("expected a changed threshold to be detected")
+	}
+}
+
+func TestOrderYamlKeysLikeTemplate(t *testing.T) {
+	template := `title: checkout errors
+display:
+  header: errors
+  description: checkout
+model:
+  queries:
+    - name: threshold_input_query
+      expression: rate(errors[5m])
+      dataType: metrics
+thresholds:
+  - name: critical
+    values: [5]
+`
+	remote := `display:
+  description: checkout
+  header: errors
+model:
+  queries:
+  - dataType: metrics
+    expression: rate(errors[10m])
+    name: threshold_input_query
+  - dataType: metrics
+    expression: rate(requests[5m])
+    name: second
+executionErrorState: OK
+thresholds:
+- inputName: threshold_input_query
+  name: critical
+  values:
+  - 5
+title: checkout errors
+`
+	expected := `title: checkout errors
+display:
+  header: errors
+  description: checkout
+model:
+  queries:
+    - name: threshold_input_query
+      expression: rate(errors[10m])
+      dataType: metrics
+    - name: second
+      expression: rate(requests[5m])
+      dataType: metrics
+thresholds:
+  - name: critical
+    values:
+      - 5
+    inputName: threshold_input_query
+executionErrorState: OK
+`
+
+	got, err := OrderYamlKeysLikeTemplate(remote, template)
+	if err != nil {
+		t.Fatalf("OrderYamlKeysLikeTemplate() error = %v", err)
+	}
+	if got != expected {
+		t.Fatalf("OrderYamlKeysLikeTemplate() =\n%s\nwant\n%s", got, expected)
+	}
+	same, err := CompareYamlSemantically(got, remote)
+	if err != nil || !same {
+		t.Fatalf("reordered YAML is not semantically equal to the source (err %v)", err)
+	}
+
+	if got, err := OrderYamlKeysLikeTemplate(remote, ""); err != nil || got != remote {
+		t.Fatalf("expected the source unchanged without a template, got %q (err %v)", got, err)
+	}
+	if _, err := OrderYamlKeysLikeTemplate(remote, "title: [unclosed"); err == nil {
+		t.Fatal("expected an error for an unparseable template")
+	}
+}
+
+func TestOverlayYamlPaths(t *testing.T) {
+	remote := `title: checkout errors
+display:
+  header: errors
+  description: enriched by bot
+labels:
+  team: payments
+  owner: bot
+`
+	state := `title: checkout errors
+display:
+  header: errors
+  description: checkout
+labels:
+  team: payments
+`
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  string
+	}{
+		{
+			name:  "copies and removes paths",
+			paths: []string{"display.description", "labels.owner"},
+			want: `title: checkout errors
+display:
+  header: errors
+  description: checkout
+labels:
+  team: payments
+`,
+		},
+		{
+			name:  "ignores paths missing from both",
+			paths: []string{"display.footer", "missing.key"},
+			want:  "title: checkout errors\ndisplay:\n  header: errors\n  description: enriched by bot\nlabels:\n  team: payments\n  owner: bot\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OverlayYamlPaths(remote, state, tt.paths)
+			if err != nil {
+				t.Fatalf("OverlayYamlPaths() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("OverlayYamlPaths() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+
+	got, err := OverlayYamlPaths(state, remote, []string{"labels.owner", "notes.summary"})
+	if err != nil {
+		t.Fatalf("OverlayYamlPaths() error = %v", err)
+	}
+	same, err := CompareYamlSemantically(got, state+"  owner: bot\n")
+	if err != nil || !same {
+		t.Fatalf("expected labels.owner to be added, got\n%s (err %v)", got, err)
+	}
+
+	if got, err := OverlayYamlPaths(remote, state, nil); err != nil || got != remote {
+		t.Fatalf("expected the target unchanged without paths, got %q (err %v)", got, err)
+	}
+	if _, err := OverlayYamlPaths(remote, "title: [unclosed", []string{"labels.owner"}); err == nil {
+		t.Fatal("expected an error for an unparseable source")
+	}
+}
+
 func TestNormalizeTimeString(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = gcqlQueryValidator{}
+
+// GcQLQuery returns a validator which checks the structure of a gcQL filter
+// query such as `severity:critical AND (env:prod OR env:staging)`. It catches
+// unterminated quotes, unbalanced parentheses, dangling AND/OR/NOT operators
+// and `key:value` terms with an empty key or value. It does not know which
+// keys exist, so a well-formed query can still be rejected by the API.
+func GcQLQuery() validator.String {
+	return gcqlQueryValidator{}
+}
+
+type gcqlQueryValidator struct{}
+
+func (gcqlQueryValidator) Description(context.Context) string {
+	return "value must be a well-formed gcQL query such as `severity:critical` or `*`"
+}
+
+func (v gcqlQueryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (gcqlQueryValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := ValidateGcQLQuery(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid gcQL Query",
+			fmt.Sprintf("%s in query %q.", err.Error(), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+type gcqlTokenKind int
+
+const (
+	gcqlTerm gcqlTokenKind = iota
+	gcqlOpen
+	gcqlClose
+	gcqlBinaryOperator // AND, OR
+	gcqlNot
+)
+
+type gcqlToken struct {
+	kind  gcqlTokenKind
+	text  string
+	start int
+}
+
+// ValidateGcQLQuery reports the first structural problem in query, or nil.
+// Empty queries are left to the resource's required-field checks.
+func ValidateGcQLQuery(query string) error {
+	tokens, err := tokenizeGcQL(query)
+	if err != nil {
+		return err
+	}
+
+	depth := 0
+	// expectOperand is true where a term, `(` or NOT must come next.
+	expectOperand := true
+	var last gcqlToken
+	for i, tok := range tokens {
+		switch tok.kind {
+		case gcqlTerm:
+			// `key:(a OR b)` groups values, so the term before `(` may end in a colon.
+			groupsValues := i+1 < len(tokens) && tokens[i+1].kind == gcqlOpen
+			if err := validateGcQLTerm(tok, groupsValues); err != nil {
+				return err
+			}
+			expectOperand = false
+		case gcqlOpen:
+			depth++
+			expectOperand = true
+		case gcqlClose:
+			if depth == 0 {
+				return fmt.Errorf("unmatched `)` at position %d", tok.start+1)
+			}
+			if last.kind == gcqlOpen {
+				return fmt.Errorf("empty parentheses at position %d", last.start+1)
+			}
+			if expectOperand {
+				return fmt.Errorf("operator %s at position %d has no right-hand operand", last.text, last.start+1)
+			}
+			depth--
+		case gcqlBinaryOperator:
+			if expectOperand {
+				return fmt.Errorf("operator %s at position %d has no left-hand operand", tok.text, tok.start+1)
+			}
+			expectOperand = true
+		case gcqlNot:
+			expectOperand = true
+		}
+		last = tok
+	}
+
+	if depth > 0 {
+		return errors.New("unmatched `(`")
+	}
+	if len(tokens) > 0 && expectOperand {
+		return fmt.Errorf("operator %s at position %d has no right-hand operand", last.text, last.start+1)
+	}
+	return nil
+}
+
+// validateGcQLTerm checks that a `key:value` term has both sides, except that
+// the value may be empty when groupsValues is set. Terms without a colon (free
+// text, `*`) are accepted as they are.
+func validateGcQLTerm(tok gcqlToken, groupsValues bool) error {
+	term := strings.TrimLeft(tok.text, "-!")
+	if term == "" {
+		return fmt.Errorf("negation at position %d has no term", tok.start+1)
+	}
+	if term[0] == '"' {
+		return nil
+	}
+	key, value, found := strings.Cut(term, ":")
+	if !found {
+		return nil
+	}
+	if key == "" {
+		return fmt.Errorf("term %q at position %d has no key before `:`", tok.text, tok.start+1)
+	}
+	if value == "" && !groupsValues {
+		return fmt.Errorf("term %q at position %d has no value after `:`", tok.text, tok.start+1)
+	}
+	return nil
+}
+
+// tokenizeGcQL splits query into terms, parentheses and operators. Double-quoted
+// strings (with backslash escapes) stay inside their term; single quotes are
+// ordinary characters, as in `workload:o'brien`.
+func tokenizeGcQL(query string) ([]gcqlToken, error) {
+	var tokens []gcqlToken
+	var term strings.Builder
+	termStart := -1
+
+	flush := func() {
+		if termStart < 0 {
+			return
+		}
+		text := term.String()
+		kind := gcqlTerm
+		switch text {
+		case "AND", "OR":
+			kind = gcqlBinaryOperator
+		case "NOT":
+			kind = gcqlNot
+		}
+		tokens = append(tokens, gcqlToken{kind: kind, text: text, start: termStart})
+		term.Reset()
+		termStart = -1
+	}
+
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '"':
+			if termStart < 0 {
+				termStart = i
+			}
+			end := i + 1
+			for ; end < len(query) && query[end] != c; end++ {
+				if query[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(query) {
+				return nil, fmt.Errorf("unterminated quote starting at position %d", i+1)
+			}
+			term.WriteString(query[i : end+1])
+			i = end
+		case c == '\\' && i+1 < len(query):
+			if termStart < 0 {
+				termStart = i
+			}
+			term.WriteString(query[i : i+2])
+			i++
+		case c == '(' || c == ')':
+			flush()
+			kind := gcqlOpen
+			if c == ')' {
+				kind = gcqlClose
+			}
+			tokens = append(tokens, gcqlToken{kind: kind, text: string(c), start: i})
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			flush()
+		default:
+			if termStart < 0 {
+				termStart = i
+			}
+			term.WriteByte(c)
+		}
+	}
+	flush()
+	return tokens, nil
+}
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GcQLMatch is the result of matching a gcQL query against a set of labels
+// that may be incomplete.
+type GcQLMatch int
+
+const (
+	// GcQLNoMatch means the query cannot match, whatever other labels are set.
+	GcQLNoMatch GcQLMatch = iota
+	// GcQLMaybeMatch means the outcome depends on labels that are not known,
+	// or on terms (such as free text) that cannot be checked against labels.
+	GcQLMaybeMatch
+	// GcQLMatches means the query matches the known labels.
+	GcQLMatches
+)
+
+func (m GcQLMatch) and(other GcQLMatch) GcQLMatch {
+	return min(m, other)
+}
+
+func (m GcQLMatch) or(other GcQLMatch) GcQLMatch {
+	return max(m, other)
+}
+
+func (m GcQLMatch) not() GcQLMatch {
+	return GcQLMatches - m
+}
+
+// MatchGcQLQuery matches query against labels. A term on a key missing from
+// labels matches maybe, since the labels may only be part of what the query
+// is eventually evaluated against. Values may use `*` wildcards, and `key:*`
+// matches any value of key. Adjacent terms are combined with AND.
+func MatchGcQLQuery(query string, labels map[string]string) (GcQLMatch, error) {
+	if err := ValidateGcQLQuery(query); err != nil {
+		return GcQLMaybeMatch, err
+	}
+	tokens, err := tokenizeGcQL(query)
+	if err != nil {
+		return GcQLMaybeMatch, err
+	}
+	if len(tokens) == 0 {
+		return GcQLMatches, nil
+	}
+
+	p := gcqlMatcher{tokens: tokens, labels: labels}
+	result := p.or()
+	if p.pos < len(p.tokens) {
+		return GcQLMaybeMatch, fmt.Errorf("unexpected %q at position %d", p.tokens[p.pos].text, p.tokens[p.pos].start+1)
+	}
+	return result, nil
+}
+
+// gcqlMatcher evaluates a validated token stream by recursive descent.
+type gcqlMatcher struct {
+	tokens []gcqlToken
+	pos    int
+	labels map[string]string
+	// groupKey is set inside `key:( ... )`, whose terms are values of key.
+	groupKey string
+}
+
+func (p *gcqlMatcher) peek() (gcqlToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return gcqlToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *gcqlMatcher) or() GcQLMatch {
+	result := p.and()
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.kind != gcqlBinaryOperator || tok.text != "OR" {
+			return result
+		}
+		p.pos++
+		result = result.or(p.and())
+	}
+}
+
+func (p *gcqlMatcher) and() GcQLMatch {
+	result := p.unary()
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.kind == gcqlClose || (tok.kind == gcqlBinaryOperator && tok.text == "OR") {
+			return result
+		}
+		if tok.kind == gcqlBinaryOperator {
+			p.pos++
+		}
+		result = result.and(p.unary())
+	}
+}
+
+func (p *gcqlMatcher) unary() GcQLMatch {
+	tok, _ := p.peek()
+	p.pos++
+	switch tok.kind {
+	case gcqlNot:
+		return p.unary().not()
+	case gcqlOpen:
+		result := p.or()
+		p.pos++ // the closing parenthesis; the query was validated
+		return result
+	}
+
+	if next, ok := p.peek(); ok && next.kind == gcqlOpen && strings.HasSuffix(tok.text, ":") {
+		negated, key := stripGcQLNegation(tok.text)
+		p.pos++
+		outerKey := p.groupKey
+		p.groupKey = strings.TrimSuffix(key, ":")
+		result := p.or()
+		p.groupKey = outerKey
+		p.pos++
+		if negated {
+			return result.not()
+		}
+		return result
+	}
+	return p.term(tok.text)
+}
+
+func (p *gcqlMatcher) term(text string) GcQLMatch {
+	negated, term := stripGcQLNegation(text)
+	key, value := p.groupKey, term
+	if key == "" {
+		if term == "*" {
+			return GcQLMatches
+		}
+		var found bool
+		key, value, found = strings.Cut(term, ":")
+		if !found || strings.HasPrefix(term, `"`) {
+			// Free text is matched against the whole record, not labels.
+			return GcQLMaybeMatch
+		}
+	}
+
+	result := matchGcQLValue(p.labels, key, value)
+	if negated {
+		return result.not()
+	}
+	return result
+}
+
+func stripGcQLNegation(text string) (bool, string) {
+	trimmed := strings.TrimLeft(text, "-!")
+	return (len(text)-len(trimmed))%2 == 1, trimmed
+}
+
+func matchGcQLValue(labels map[string]string, key, value string) GcQLMatch {
+	actual, ok := labels[key]
+	if !ok {
+		return GcQLMaybeMatch
+	}
+	matched := actual == value
+	if strings.HasPrefix(value, `"`) {
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			matched = actual == unquoted
+		}
+	} else if strings.Contains(value, "*") {
+		matched = matchGcQLWildcard(value, actual)
+	}
+	if matched {
+		return GcQLMatches
+	}
+	return GcQLNoMatch
+}
+
+// matchGcQLWildcard reports whether value matches pattern, in which each `*`
+// stands for any run of characters.
+func matchGcQLWildcard(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	last := len(parts) - 1
+	for _, part := range parts[1:last] {
+		idx := strings.Index(value, part)
+		if idx < 0 {
+			return false
+		}
+		value = value[idx+len(part):]
+	}
+	return strings.HasSuffix(value, parts[last])
+}
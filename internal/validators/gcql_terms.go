@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"strconv"
+	"strings"
+)
+
+// GcQLTerm is a term of a gcQL query: a `key:value` filter, or free text
+// when Key is empty.
+type GcQLTerm struct {
+	Key   string
+	Value string
+	// Negated is set for a term prefixed with `-` or `!`, or directly preceded
+	// by NOT. Negations of whole parenthesized groups are not reflected.
+	Negated bool
+}
+
+// ParseGcQLTerms returns the terms of query in order, after checking it with
+// ValidateGcQLQuery. Each value of `key:(a OR b)` is a term of key, and
+// quoted values are unquoted. `*` alone matches everything and is not a term.
+func ParseGcQLTerms(query string) ([]GcQLTerm, error) {
+	if err := ValidateGcQLQuery(query); err != nil {
+		return nil, err
+	}
+	tokens, err := tokenizeGcQL(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var terms []GcQLTerm
+	// groupKeys holds, for each open parenthesis, the key its terms are values
+	// of, or "" for an ordinary group.
+	var groupKeys []string
+	notPending := false
+	for i, tok := range tokens {
+		switch tok.kind {
+		case gcqlOpen:
+			groupKeys = append(groupKeys, "")
+			if i > 0 && tokens[i-1].kind == gcqlTerm && strings.HasSuffix(tokens[i-1].text, ":") {
+				groupKeys[len(groupKeys)-1] = strings.TrimSuffix(strings.TrimLeft(tokens[i-1].text, "-!"), ":")
+			} else if len(groupKeys) > 1 {
+				groupKeys[len(groupKeys)-1] = groupKeys[len(groupKeys)-2]
+			}
+			notPending = false
+		case gcqlClose:
+			groupKeys = groupKeys[:len(groupKeys)-1]
+		case gcqlNot:
+			notPending = true
+		case gcqlBinaryOperator:
+			notPending = false
+		case gcqlTerm:
+			negated, text := stripGcQLNegation(tok.text)
+			negated = negated != notPending
+			notPending = false
+			if i+1 < len(tokens) && tokens[i+1].kind == gcqlOpen && strings.HasSuffix(text, ":") {
+				continue // The key of a value group; its values follow.
+			}
+
+			term := GcQLTerm{Value: text, Negated: negated}
+			if len(groupKeys) > 0 && groupKeys[len(groupKeys)-1] != "" {
+				term.Key = groupKeys[len(groupKeys)-1]
+			} else if text == "*" {
+				continue
+			} else if key, value, found := strings.Cut(text, ":"); found && !strings.HasPrefix(text, `"`) {
+				term.Key, term.Value = key, value
+			}
+			if strings.HasPrefix(term.Value, `"`) {
+				if unquoted, err := strconv.Unquote(term.Value); err == nil {
+					term.Value = unquoted
+				}
+			}
+			terms = append(terms, term)
+		}
+	}
+	return terms, nil
+}
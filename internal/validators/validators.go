@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validators holds schema validators shared across groundcover
+// resources, so the same kind of value (a duration, a timestamp, a URL, an
+// enum) is checked the same way and produces the same plan-time error
+// wherever it appears.
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = durationStringValidator{}
+	_ validator.String = rfc3339TimeValidator{}
+	_ validator.String = httpURLValidator{}
+	_ validator.String = oneOfCaseInsensitiveValidator{}
+)
+
+// DurationString returns a validator which ensures the value is a
+// non-negative duration the groundcover API accepts: Go durations such as
+// `30s` or `1h30m`, day/week units such as `1d` or `2w4h`, and long unit
+// names such as `5 minutes`. Empty strings are left to the resource's own
+// required-field checks.
+func DurationString() validator.String {
+	return durationStringValidator{}
+}
+
+type durationStringValidator struct{}
+
+func (durationStringValidator) Description(context.Context) string {
+	return "value must be a duration such as `30s`, `5m`, `1h` or `1d`"
+}
+
+func (v durationStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (durationStringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := strings.TrimSpace(req.ConfigValue.ValueString())
+	if value == "" {
+		return
+	}
+	parsed, err := strfmt.ParseDuration(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a duration such as `30s`, `5m`, `1h` or `1d`, got %q.", req.ConfigValue.ValueString()),
+		)
+		return
+	}
+	if parsed < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Duration must not be negative, got %q.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// RFC3339Time returns a validator which ensures the value is an RFC3339
+// timestamp such as `2024-01-15T10:00:00Z`.
+func RFC3339Time() validator.String {
+	return rfc3339TimeValidator{}
+}
+
+type rfc3339TimeValidator struct{}
+
+func (rfc3339TimeValidator) Description(context.Context) string {
+	return "value must be an RFC3339 timestamp such as `2024-01-15T10:00:00Z`"
+}
+
+func (v rfc3339TimeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (rfc3339TimeValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid RFC3339 Timestamp",
+			fmt.Sprintf("Expected an RFC3339 timestamp such as `2024-01-15T10:00:00Z`, got %q: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// HTTPURL returns a validator which ensures the value is an absolute http://
+// or https:// URL with a host. Empty strings are left to the resource's own
+// required-field checks.
+func HTTPURL() validator.String {
+	return httpURLValidator{}
+}
+
+type httpURLValidator struct{}
+
+func (httpURLValidator) Description(context.Context) string {
+	return "value must be an absolute URL starting with `http://` or `https://`"
+}
+
+func (v httpURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (httpURLValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	if value == "" {
+		return
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("Expected an absolute URL starting with http:// or https://, got %q.", value),
+		)
+	}
+}
+
+// OneOfCaseInsensitive returns a validator which ensures the value matches one
+// of the given values, ignoring case. It suits attributes the API compares
+// case-insensitively, or that were previously unvalidated and may already be
+// written in a different case in existing configurations.
+func OneOfCaseInsensitive(values ...string) validator.String {
+	return oneOfCaseInsensitiveValidator{values: values}
+}
+
+type oneOfCaseInsensitiveValidator struct {
+	values []string
+}
+
+func (v oneOfCaseInsensitiveValidator) Description(context.Context) string {
+	return fmt.Sprintf("value must be one of (case-insensitive): %q", v.values)
+}
+
+func (v oneOfCaseInsensitiveValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfCaseInsensitiveValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	for _, allowed := range v.values {
+		if strings.EqualFold(value, allowed) {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Expected one of %s (case-insensitive), got %q.", strings.Join(v.values, ", "), value),
+	)
+}
@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runStringValidator(v validator.String, value types.String) bool {
+	req := validator.StringRequest{Path: path.Root("test"), ConfigValue: value}
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), req, resp)
+	return resp.Diagnostics.HasError()
+}
+
+func TestDurationString(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{types.StringNull(), false},
+		{types.StringUnknown(), false},
+		{types.StringValue(""), false},
+		{types.StringValue("30s"), false},
+		{types.StringValue("500ms"), false},
+		{types.StringValue("1h30m"), false},
+		{types.StringValue("1d"), false},
+		{types.StringValue("2w4h"), false},
+		{types.StringValue("5 minutes"), false},
+		{types.StringValue("0s"), false},
+		{types.StringValue("soon"), true},
+		{types.StringValue("10"), true},
+		{types.StringValue("-5m"), true},
+	}
+	for _, tt := range tests {
+		if got := runStringValidator(DurationString(), tt.value); got != tt.wantErr {
+			t.Errorf("DurationString(%s) error = %v, want %v", tt.value, got, tt.wantErr)
+		}
+	}
+}
+
+func TestRFC3339Time(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{types.StringNull(), false},
+		{types.StringUnknown(), false},
+		{types.StringValue("2024-01-15T10:00:00Z"), false},
+		{types.StringValue("2024-01-15T10:00:00+02:00"), false},
+		{types.StringValue("2024-01-15 10:00:00"), true},
+		{types.StringValue("2024-01-15"), true},
+		{types.StringValue(""), true},
+	}
+	for _, tt := range tests {
+		if got := runStringValidator(RFC3339Time(), tt.value); got != tt.wantErr {
+			t.Errorf("RFC3339Time(%s) error = %v, want %v", tt.value, got, tt.wantErr)
+		}
+	}
+}
+
+func TestHTTPURL(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{types.StringNull(), false},
+		{types.StringUnknown(), false},
+		{types.StringValue(""), false},
+		{types.StringValue("https://example.com/health"), false},
+		{types.StringValue("http://10.0.0.1:8080"), false},
+		{types.StringValue("example.com"), true},
+		{types.StringValue("ftp://example.com"), true},
+		{types.StringValue("https://"), true},
+		{types.StringValue("https://exa mple.com"), true},
+	}
+	for _, tt := range tests {
+		if got := runStringValidator(HTTPURL(), tt.value); got != tt.wantErr {
+			t.Errorf("HTTPURL(%s) error = %v, want %v", tt.value, got, tt.wantErr)
+		}
+	}
+}
+
+func TestOneOfCaseInsensitive(t *testing.T) {
+	v := OneOfCaseInsensitive("Alerting", "Resolved")
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{types.StringNull(), false},
+		{types.StringUnknown(), false},
+		{types.StringValue("Alerting"), false},
+		{types.StringValue("resolved"), false},
+		{types.StringValue("ALERTING"), false},
+		{types.StringValue("Pending"), true},
+		{types.StringValue(""), true},
+	}
+	for _, tt := range tests {
+		if got := runStringValidator(v, tt.value); got != tt.wantErr {
+			t.Errorf("OneOfCaseInsensitive(%s) error = %v, want %v", tt.value, got, tt.wantErr)
+		}
+	}
+}
+
+func TestValidateGcQLQuery(t *testing.T) {
+	tests := []struct {
+		query   string
+		wantErr string
+	}{
+		{query: "*"},
+		{query: "severity:critical"},
+		{query: `workload:"payments api" AND env:prod`},
+		{query: "severity:critical AND (env:prod OR env:staging)"},
+		{query: "env:(prod OR staging) NOT namespace:kube-system"},
+		{query: "-env:dev !cluster:test workload:api-*"},
+		{query: `message:"say \"hi\"" workload:o'brien`},
+		{query: "severity:critical AND", wantErr: "no right-hand operand"},
+		{query: "OR env:prod", wantErr: "no left-hand operand"},
+		{query: "env:prod AND OR env:dev", wantErr: "no left-hand operand"},
+		{query: "(env:prod", wantErr: "unmatched `(`"},
+		{query: "env:prod)", wantErr: "unmatched `)`"},
+		{query: "env:prod AND ()", wantErr: "empty parentheses"},
+		{query: "(env:prod NOT)", wantErr: "no right-hand operand"},
+		{query: `workload:"payments`, wantErr: "unterminated quote"},
+		{query: "env:", wantErr: "no value"},
+		{query: ":prod", wantErr: "no key"},
+		{query: "env:prod -", wantErr: "negation"},
+	}
+	for _, tt := range tests {
+		err := ValidateGcQLQuery(tt.query)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("ValidateGcQLQuery(%q) unexpected error: %v", tt.query, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("ValidateGcQLQuery(%q) error = %v, want one containing %q", tt.query, err, tt.wantErr)
+		}
+	}
+}
+
+func TestGcQLQuery(t *testing.T) {
+	v := GcQLQuery()
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{types.StringNull(), false},
+		{types.StringUnknown(), false},
+		{types.StringValue("severity:critical"), false},
+		{types.StringValue("severity:critical AND"), true},
+	}
+	for _, tt := range tests {
+		if got := runStringValidator(v, tt.value); got != tt.wantErr {
+			t.Errorf("GcQLQuery(%s) error = %v, want %v", tt.value, got, tt.wantErr)
+		}
+	}
+}
+
+func TestMatchGcQLQuery(t *testing.T) {
+	labels := map[string]string{"env": "prod", "team": "payments", "service": "api/checkout"}
+	tests := []struct {
+		query string
+		want  GcQLMatch
+	}{
+		{"", GcQLMatches},
+		{"*", GcQLMatches},
+		{"env:prod", GcQLMatches},
+		{"env:staging", GcQLNoMatch},
+		{`env:"prod"`, GcQLMatches},
+		{"-env:prod", GcQLNoMatch},
+		{"NOT env:staging", GcQLMatches},
+		{"env:prod AND team:payments", GcQLMatches},
+		{"env:prod team:search", GcQLNoMatch},
+		{"env:staging OR team:payments", GcQLMatches},
+		{"env:(staging OR prod)", GcQLMatches},
+		{"env:(staging OR dev)", GcQLNoMatch},
+		{"service:api/*", GcQLMatches},
+		{"team:pay*ts", GcQLMatches},
+		{"team:*search*", GcQLNoMatch},
+		{"region:us-east-1", GcQLMaybeMatch},
+		{"env:prod AND region:us-east-1", GcQLMaybeMatch},
+		{"env:staging AND region:us-east-1", GcQLNoMatch},
+		{"env:staging OR region:us-east-1", GcQLMaybeMatch},
+		{"NOT region:us-east-1", GcQLMaybeMatch},
+		{"(env:prod OR env:dev) AND NOT (team:search)", GcQLMatches},
+		{"timeout", GcQLMaybeMatch},
+	}
+	for _, tt := range tests {
+		got, err := MatchGcQLQuery(tt.query, labels)
+		if err != nil {
+			t.Errorf("MatchGcQLQuery(%q) unexpected error: %v", tt.query, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("MatchGcQLQuery(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+
+	if _, err := MatchGcQLQuery("env:prod AND", labels); err == nil {
+		t.Error("MatchGcQLQuery accepted a malformed query")
+	}
+}
+
+func TestParseGcQLTerms(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []GcQLTerm
+	}{
+		{"", nil},
+		{"*", nil},
+		{"env:prod", []GcQLTerm{{Key: "env", Value: "prod"}}},
+		{`workload:"checkout api"`, []GcQLTerm{{Key: "workload", Value: "checkout api"}}},
+		{"-env:prod AND NOT team:search", []GcQLTerm{{Key: "env", Value: "prod", Negated: true}, {Key: "team", Value: "search", Negated: true}}},
+		{"env:(staging OR prod)", []GcQLTerm{{Key: "env", Value: "staging"}, {Key: "env", Value: "prod"}}},
+		{"(env:prod OR region:eu) timeout", []GcQLTerm{{Key: "env", Value: "prod"}, {Key: "region", Value: "eu"}, {Value: "timeout"}}},
+		{`"connection refused"`, []GcQLTerm{{Value: "connection refused"}}},
+	}
+	for _, tt := range tests {
+		got, err := ParseGcQLTerms(tt.query)
+		if err != nil {
+			t.Errorf("ParseGcQLTerms(%q) unexpected error: %v", tt.query, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseGcQLTerms(%q) = %+v, want %+v", tt.query, got, tt.want)
+		}
+	}
+
+	if _, err := ParseGcQLTerms("env:(prod"); err == nil {
+		t.Error("ParseGcQLTerms accepted a malformed query")
+	}
+}
@@ -9,7 +9,7 @@ import (
 	"log"
 
 	"github.com/groundcover-com/terraform-provider-groundcover/internal/provider"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 )
 
 var (
@@ -27,12 +27,17 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/groundcover-com/groundcover",
-		Debug:   debug,
+	providerServer, err := provider.NewProviderServer(context.Background(), version)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	err = tf6server.Serve("registry.terraform.io/groundcover-com/groundcover", providerServer, serveOpts...)
 
 	if err != nil {
 		log.Fatal(err.Error())
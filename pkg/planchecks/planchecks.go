@@ -0,0 +1,166 @@
+// Package planchecks provides terraform-plugin-testing plan checks for the groundcover
+// provider's semantic-diff behavior, so authors of modules built on the provider can assert
+// in their acceptance tests that reformatted YAML plans no changes, or that a planned value
+// matches the YAML they expect:
+//
+//	ConfigPlanChecks: resource.ConfigPlanChecks{
+//		PreApply: []plancheck.PlanCheck{
+//			planchecks.ExpectNoMonitorDrift("module.alerts.groundcover_monitor.cpu"),
+//		},
+//	},
+//
+// The checks compare YAML the same way the provider does when it decides whether a change
+// is only formatting.
+package planchecks
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	internalprovider "github.com/groundcover-com/terraform-provider-groundcover/internal/provider"
+)
+
+var (
+	_ plancheck.PlanCheck = expectNoMonitorDrift{}
+	_ plancheck.PlanCheck = expectSemanticYAMLEqual{}
+)
+
+type expectNoMonitorDrift struct {
+	resourceAddress string
+}
+
+// ExpectNoMonitorDrift returns a plan check that fails when the plan creates, updates, or
+// replaces the groundcover_monitor or groundcover_monitor_v2 at resourceAddress. The error
+// names the attributes that changed and, for monitor_yaml, whether the change is only
+// formatting, which the provider is expected to suppress.
+func ExpectNoMonitorDrift(resourceAddress string) plancheck.PlanCheck {
+	return expectNoMonitorDrift{resourceAddress: resourceAddress}
+}
+
+// CheckPlan implements the plan check logic.
+func (e expectNoMonitorDrift) CheckPlan(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	rc, err := findResourceChange(req.Plan, e.resourceAddress)
+	if err != nil {
+		resp.Error = err
+		return
+	}
+	if rc.Change.Actions.NoOp() || rc.Change.Actions.Read() {
+		return
+	}
+
+	before, _ := rc.Change.Before.(map[string]any)
+	after, _ := rc.Change.After.(map[string]any)
+	afterUnknown, _ := rc.Change.AfterUnknown.(map[string]any)
+	var changed []string
+	for name := range mergedKeys(before, after, afterUnknown) {
+		if unknown, _ := afterUnknown[name].(bool); unknown || !reflect.DeepEqual(before[name], after[name]) {
+			changed = append(changed, describeChange(ctx, name, before[name], after[name]))
+		}
+	}
+	slices.Sort(changed)
+	resp.Error = fmt.Errorf("%s - expected no monitor drift, but the plan has actions %v; changed attributes: %s",
+		e.resourceAddress, rc.Change.Actions, strings.Join(changed, ", "))
+}
+
+type expectSemanticYAMLEqual struct {
+	resourceAddress string
+	attributePath   tfjsonpath.Path
+	expected        string
+}
+
+// ExpectSemanticYAMLEqual returns a plan check that fails unless the planned value of the
+// string attribute at attributePath of resourceAddress is the same YAML as expected, once
+// both are normalized the way the provider normalizes monitor YAML: keys sorted, durations
+// such as "1d" and "24h" treated as equal, and empty and server-managed fields ignored.
+func ExpectSemanticYAMLEqual(resourceAddress string, attributePath tfjsonpath.Path, expected string) plancheck.PlanCheck {
+	return expectSemanticYAMLEqual{resourceAddress: resourceAddress, attributePath: attributePath, expected: expected}
+}
+
+// CheckPlan implements the plan check logic.
+func (e expectSemanticYAMLEqual) CheckPlan(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	rc, err := findResourceChange(req.Plan, e.resourceAddress)
+	if err != nil {
+		resp.Error = err
+		return
+	}
+	value, err := tfjsonpath.Traverse(rc.Change.After, e.attributePath)
+	if err != nil {
+		resp.Error = fmt.Errorf("%s.%s - %w", e.resourceAddress, e.attributePath.String(), err)
+		return
+	}
+	planned, ok := value.(string)
+	if !ok {
+		resp.Error = fmt.Errorf("%s.%s - expected a known string value, got %T", e.resourceAddress, e.attributePath.String(), value)
+		return
+	}
+
+	equal, err := semanticYAMLEqual(ctx, e.expected, planned)
+	if err != nil {
+		resp.Error = fmt.Errorf("%s.%s - %w", e.resourceAddress, e.attributePath.String(), err)
+		return
+	}
+	if !equal {
+		resp.Error = fmt.Errorf("%s.%s - planned YAML is not semantically equal to the expected YAML\n\nexpected:\n%s\n\nplanned:\n%s",
+			e.resourceAddress, e.attributePath.String(), e.expected, planned)
+	}
+}
+
+// findResourceChange returns the change of the resource at address in plan.
+func findResourceChange(plan *tfjson.Plan, address string) (*tfjson.ResourceChange, error) {
+	if plan == nil {
+		return nil, fmt.Errorf("plan is nil")
+	}
+	for _, rc := range plan.ResourceChanges {
+		if rc != nil && rc.Address == address && rc.Change != nil {
+			return rc, nil
+		}
+	}
+	return nil, fmt.Errorf("%s - Resource not found in plan", address)
+}
+
+// semanticYAMLEqual normalizes both YAML documents and compares them semantically.
+func semanticYAMLEqual(ctx context.Context, a, b string) (bool, error) {
+	normalizedA, err := internalprovider.NormalizeMonitorYaml(ctx, a)
+	if err != nil {
+		return false, fmt.Errorf("failed to normalize the expected YAML: %w", err)
+	}
+	normalizedB, err := internalprovider.NormalizeMonitorYaml(ctx, b)
+	if err != nil {
+		return false, fmt.Errorf("failed to normalize the planned YAML: %w", err)
+	}
+	return internalprovider.CompareYamlSemantically(normalizedA, normalizedB)
+}
+
+// describeChange names a changed attribute, noting when a monitor_yaml change is only
+// formatting.
+func describeChange(ctx context.Context, name string, before, after any) string {
+	if name != "monitor_yaml" {
+		return name
+	}
+	beforeYAML, beforeOK := before.(string)
+	afterYAML, afterOK := after.(string)
+	if !beforeOK || !afterOK {
+		return name
+	}
+	if equal, err := semanticYAMLEqual(ctx, beforeYAML, afterYAML); err == nil && equal {
+		return name + " (formatting only)"
+	}
+	return name
+}
+
+func mergedKeys(objects ...map[string]any) map[string]struct{} {
+	keys := map[string]struct{}{}
+	for _, object := range objects {
+		for key := range object {
+			keys[key] = struct{}{}
+		}
+	}
+	return keys
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MPL-2.0
+
+package planchecks
+
+import (
+	"context"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const monitorAddress = "groundcover_monitor.cpu"
+
+const monitorYaml = `title: High CPU
+model:
+  queries:
+    - name: threshold_input_query
+      expression: avg(cpu) > 0.9
+evaluationInterval:
+  interval: 1d
+`
+
+// reformattedMonitorYaml is monitorYaml with its keys reordered and its interval
+// written in hours.
+const reformattedMonitorYaml = `evaluationInterval:
+  interval: 24h
+model:
+  queries:
+    - expression: avg(cpu) > 0.9
+      name: threshold_input_query
+title: High CPU
+`
+
+func monitorPlan(actions tfjson.Actions, before, after map[string]any) *tfjson.Plan {
+	return &tfjson.Plan{ResourceChanges: []*tfjson.ResourceChange{{
+		Address: monitorAddress,
+		Change:  &tfjson.Change{Actions: actions, Before: before, After: after, AfterUnknown: map[string]any{}},
+	}}}
+}
+
+func checkPlan(check plancheck.PlanCheck, plan *tfjson.Plan) error {
+	resp := &plancheck.CheckPlanResponse{}
+	check.CheckPlan(context.Background(), plancheck.CheckPlanRequest{Plan: plan}, resp)
+	return resp.Error
+}
+
+func TestExpectNoMonitorDrift(t *testing.T) {
+	state := map[string]any{"id": "m1", "monitor_yaml": monitorYaml}
+	assert.NoError(t, checkPlan(ExpectNoMonitorDrift(monitorAddress), monitorPlan(tfjson.Actions{tfjson.ActionNoop}, state, state)))
+
+	err := checkPlan(ExpectNoMonitorDrift(monitorAddress), monitorPlan(
+		tfjson.Actions{tfjson.ActionUpdate},
+		state,
+		map[string]any{"id": "m1", "monitor_yaml": reformattedMonitorYaml},
+	))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "changed attributes: monitor_yaml (formatting only)")
+
+	err = checkPlan(ExpectNoMonitorDrift(monitorAddress), monitorPlan(
+		tfjson.Actions{tfjson.ActionUpdate},
+		state,
+		map[string]any{"id": "m1", "monitor_yaml": "title: Low CPU\n"},
+	))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "changed attributes: monitor_yaml")
+	assert.NotContains(t, err.Error(), "formatting only")
+
+	err = checkPlan(ExpectNoMonitorDrift("groundcover_monitor.missing"), monitorPlan(tfjson.Actions{tfjson.ActionNoop}, state, state))
+	assert.EqualError(t, err, "groundcover_monitor.missing - Resource not found in plan")
+}
+
+func TestExpectSemanticYAMLEqual(t *testing.T) {
+	plan := monitorPlan(tfjson.Actions{tfjson.ActionCreate}, nil, map[string]any{"monitor_yaml": reformattedMonitorYaml})
+	assert.NoError(t, checkPlan(ExpectSemanticYAMLEqual(monitorAddress, tfjsonpath.New("monitor_yaml"), monitorYaml), plan))
+
+	err := checkPlan(ExpectSemanticYAMLEqual(monitorAddress, tfjsonpath.New("monitor_yaml"), "title: Low CPU\n"), plan)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "planned YAML is not semantically equal to the expected YAML")
+
+	err = checkPlan(ExpectSemanticYAMLEqual(monitorAddress, tfjsonpath.New("id"), monitorYaml), plan)
+	assert.Error(t, err)
+}
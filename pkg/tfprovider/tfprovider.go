@@ -5,6 +5,8 @@
 package tfprovider
 
 import (
+	"net/http"
+
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 
 	internalprovider "github.com/groundcover-com/terraform-provider-groundcover/internal/provider"
@@ -15,3 +17,10 @@ import (
 func New(version string) func() provider.Provider {
 	return internalprovider.New(version)
 }
+
+// NewWithTransport returns the provider constructor with the API client's requests sent
+// through transport instead of the network, for integration tests that serve the groundcover
+// API in-process. It is a thin re-export of internal/provider.NewWithTransport.
+func NewWithTransport(version string, transport http.RoundTripper) func() provider.Provider {
+	return internalprovider.NewWithTransport(version, transport)
+}